@@ -0,0 +1,134 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+    modeConfig = "config"
+    modeWipe   = "wipe"
+)
+
+func deleteConfigFiles(dirPath string) error {
+    deletionFunc := func(path string, info os.FileInfo, err error) error {
+        if err != nil {
+            log.Printf("Error accessing path %q: %v", path, err)
+            return err
+        }
+        if !info.IsDir() && strings.HasPrefix(filepath.Base(path), ".ais.") {
+            // Delete file if it matches the pattern
+            if err := os.Remove(path); err != nil {
+                log.Printf("Error deleting file %q: %v", path, err)
+                return err
+            }
+            log.Printf("Deleted file: %s", path)
+        }
+        return nil
+    }
+
+    // Walk through the directory and apply the deletion logic to each file
+    if err := filepath.Walk(dirPath, deletionFunc); err != nil {
+        return err
+    }
+    return nil
+}
+
+// splitPaths parses a comma-separated list of paths
+func splitPaths(value string) []string {
+    var paths []string
+    for _, p := range strings.Split(value, ",") {
+        if p = strings.TrimSpace(p); p != "" {
+            paths = append(paths, p)
+        }
+    }
+    return paths
+}
+
+// checkWipeDir refuses to wipe anything but a real directory strictly inside one of the declared mountpaths
+func checkWipeDir(dir string, mountpaths []string) error {
+    if !filepath.IsAbs(dir) || filepath.Clean(dir) != dir {
+        return fmt.Errorf("%q is not a clean absolute path", dir)
+    }
+    inside := false
+    for _, mpath := range mountpaths {
+        if !filepath.IsAbs(mpath) || filepath.Clean(mpath) == "/" {
+            return fmt.Errorf("declared mountpath %q must be an absolute path other than /", mpath)
+        }
+        rel, err := filepath.Rel(filepath.Clean(mpath), dir)
+        if err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, "../") {
+            inside = true
+            break
+        }
+    }
+    if !inside {
+        return fmt.Errorf("%q is not inside any of the declared mountpaths %v", dir, mountpaths)
+    }
+    info, err := os.Lstat(dir)
+    if err != nil {
+        return err
+    }
+    if info.Mode()&os.ModeSymlink != 0 || !info.IsDir() {
+        return fmt.Errorf("%q is not a directory", dir)
+    }
+    return nil
+}
+
+// wipeDirs deletes the contents of each directory, keeping the directories themselves.
+// All directories are checked before anything is deleted. Symlinks are removed, never followed.
+func wipeDirs(dirs, mountpaths []string) error {
+    if len(dirs) == 0 {
+        return errors.New("no directories to wipe")
+    }
+    for _, dir := range dirs {
+        if err := checkWipeDir(dir, mountpaths); err != nil {
+            return err
+        }
+    }
+    for _, dir := range dirs {
+        entries, err := os.ReadDir(dir)
+        if err != nil {
+            return err
+        }
+        for _, entry := range entries {
+            if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+                log.Printf("Error deleting %q: %v", filepath.Join(dir, entry.Name()), err)
+                return err
+            }
+        }
+        log.Printf("Wiped directory: %s (%d entries)", dir, len(entries))
+    }
+    return nil
+}
+
+func main() {
+    var mode, dirPath, wipe, mountpaths string
+    flag.StringVar(&mode, "mode", modeConfig, "Cleanup mode: 'config' deletes AIS config files, 'wipe' deletes all data in -wipe_dirs")
+    flag.StringVar(&dirPath, "dir", "/etc/ais/", "Directory path to delete files from")
+    flag.StringVar(&wipe, "wipe_dirs", "", "Comma-separated directories whose contents are deleted in wipe mode")
+    flag.StringVar(&mountpaths, "mountpaths", "", "Comma-separated declared mountpaths; wipe mode refuses directories outside of them")
+    flag.Parse()
+
+    log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+
+    switch mode {
+    case modeConfig:
+        // Delete matching files in the specified directory
+        if err := deleteConfigFiles(dirPath); err != nil {
+            log.Fatalf("Failed to delete files: %v", err)
+        }
+        log.Println("File deletion process completed successfully.")
+    case modeWipe:
+        if err := wipeDirs(splitPaths(wipe), splitPaths(mountpaths)); err != nil {
+            log.Fatalf("Failed to wipe directories: %v", err)
+        }
+        log.Println("Wipe process completed successfully.")
+    default:
+        log.Fatalf("Unknown mode %q", mode)
+    }
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	modeDiscover  = "discover"
+	modeConfigure = "configure"
+	modeLabel     = "label"
+
+	// Read by the operator to configure the mountpaths of targets on the node
+	mountpathsAnnotation = "storage.aistore.nvidia.com/mountpaths"
+
+	serviceAccountDir     = "/var/run/secrets/kubernetes.io/serviceaccount"
+	configurePollInterval = 5 * time.Second
+)
+
+// filesystem is an entry of the mountpaths node annotation
+type filesystem struct {
+	Path   string `json:"path"`
+	Device string `json:"device,omitempty"`
+	FSType string `json:"fsType,omitempty"`
+}
+
+// unescapeMountinfo decodes the octal escapes (e.g. `\040` for a space) used in mountinfo paths
+func unescapeMountinfo(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) {
+			if v, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// parseMountinfo returns the filesystems of a /proc/<pid>/mountinfo file whose mount point matches the pattern
+// and whose type is one of fsTypes (any type when empty). A mount point mounted several times keeps the last mount.
+func parseMountinfo(data []byte, pattern string, fsTypes []string) ([]filesystem, error) {
+	byPath := map[string]filesystem{}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		// <id> <parent> <major:minor> <root> <mount point> <options> [<optional>...] - <type> <source> <super options>
+		sep := -1
+		for i := 6; i < len(fields); i++ {
+			if fields[i] == "-" {
+				sep = i
+				break
+			}
+		}
+		if sep < 0 || sep+2 >= len(fields) {
+			continue
+		}
+		fs := filesystem{
+			Path:   path.Clean(unescapeMountinfo(fields[4])),
+			FSType: fields[sep+1],
+			Device: unescapeMountinfo(fields[sep+2]),
+		}
+		matched, err := path.Match(pattern, fs.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !matched || (len(fsTypes) > 0 && !contains(fsTypes, fs.FSType)) {
+			continue
+		}
+		byPath[fs.Path] = fs
+	}
+	filesystems := make([]filesystem, 0, len(byPath))
+	for _, fs := range byPath {
+		filesystems = append(filesystems, fs)
+	}
+	sort.Slice(filesystems, func(i, j int) bool { return filesystems[i].Path < filesystems[j].Path })
+	return filesystems, nil
+}
+
+// patchNodeAnnotation sets the mountpaths annotation of the node with the in-cluster service account credentials
+func patchNodeAnnotation(client *http.Client, node, value string) error {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return errors.New("not running in a Kubernetes cluster")
+	}
+	token, err := os.ReadFile(path.Join(serviceAccountDir, "token"))
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"annotations": map[string]string{mountpathsAnnotation: value}},
+	})
+	if err != nil {
+		return err
+	}
+	url := "https://" + net.JoinHostPort(host, port) + "/api/v1/nodes/" + node
+	req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("patching node %q: %s: %s", node, resp.Status, msg)
+	}
+	return nil
+}
+
+func newAPIClient() (*http.Client, error) {
+	ca, err := os.ReadFile(path.Join(serviceAccountDir, "ca.crt"))
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("no CA certificates in the service account")
+	}
+	return &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}},
+	}, nil
+}
+
+// discover reports the filesystems mounted on the node in its annotation, updating it whenever they change
+func discover(node, mountinfo, pattern string, fsTypes []string, interval time.Duration) {
+	client, err := newAPIClient()
+	if err != nil {
+		log.Fatalf("Failed to create API client: %v", err)
+	}
+	var reported string
+	for {
+		data, err := os.ReadFile(mountinfo)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", mountinfo, err)
+		}
+		filesystems, err := parseMountinfo(data, pattern, fsTypes)
+		if err != nil {
+			log.Fatalf("Invalid pattern %q: %v", pattern, err)
+		}
+		value, err := json.Marshal(filesystems)
+		if err != nil {
+			log.Fatalf("Failed to encode filesystems: %v", err)
+		}
+		if string(value) != reported {
+			if err := patchNodeAnnotation(client, node, string(value)); err != nil {
+				log.Printf("Failed to report filesystems: %v", err)
+			} else {
+				reported = string(value)
+				log.Printf("Reported %d filesystems on node %q: %s", len(filesystems), node, reported)
+			}
+		}
+		time.Sleep(interval)
+	}
+}
+
+// addMountpaths adds the mountpaths with the label to fspaths of the AIS local config,
+// keeping the mountpaths and all other fields already set
+func addMountpaths(localConfig string, mpaths []string, label string) error {
+	return updateFspaths(localConfig, func(fspaths map[string]any) {
+		for _, mpath := range mpaths {
+			if _, ok := fspaths[mpath]; !ok {
+				fspaths[mpath] = label
+			}
+		}
+	})
+}
+
+// labelMountpaths sets the label of the mountpaths without one in fspaths of the AIS local config
+func labelMountpaths(localConfig, label string) error {
+	return updateFspaths(localConfig, func(fspaths map[string]any) {
+		for mpath, value := range fspaths {
+			if value == nil || value == "" {
+				fspaths[mpath] = label
+			}
+		}
+	})
+}
+
+// updateFspaths applies update to fspaths of the AIS local config, keeping all other fields
+func updateFspaths(localConfig string, update func(fspaths map[string]any)) error {
+	info, err := os.Stat(localConfig)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(localConfig)
+	if err != nil {
+		return err
+	}
+	var conf map[string]any
+	if err := json.Unmarshal(data, &conf); err != nil {
+		return err
+	}
+	fspaths, _ := conf["fspaths"].(map[string]any)
+	if fspaths == nil {
+		fspaths = map[string]any{}
+	}
+	update(fspaths)
+	conf["fspaths"] = fspaths
+	if data, err = json.Marshal(conf); err != nil {
+		return err
+	}
+	return os.WriteFile(localConfig, data, info.Mode().Perm())
+}
+
+func readNodeMountpaths(file, node string) ([]string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var nodeMountpaths map[string][]string
+	if err := json.Unmarshal(data, &nodeMountpaths); err != nil {
+		return nil, err
+	}
+	return nodeMountpaths[node], nil
+}
+
+// configure waits until the operator publishes the mountpaths of the node, then adds them to the local config
+func configure(node, nodeMountpathsFile, localConfig, label string, timeout time.Duration) {
+	if localConfig == "" || nodeMountpathsFile == "" {
+		log.Fatal("-local_config and -node_mountpaths are required")
+	}
+	// ConfigMap volumes are refreshed periodically, so mountpaths reported after the pod started show up later
+	deadline := time.Now().Add(timeout)
+	for {
+		mpaths, err := readNodeMountpaths(nodeMountpathsFile, node)
+		if err != nil {
+			log.Printf("Failed to read node mountpaths: %v", err)
+		} else if len(mpaths) > 0 {
+			if err := addMountpaths(localConfig, mpaths, label); err != nil {
+				log.Fatalf("Failed to update local config: %v", err)
+			}
+			log.Printf("Configured mountpaths %v", mpaths)
+			return
+		} else {
+			log.Printf("Waiting for mountpaths of node %q", node)
+		}
+		if time.Now().After(deadline) {
+			log.Fatalf("Timed out after %v waiting for mountpaths of node %q", timeout, node)
+		}
+		time.Sleep(configurePollInterval)
+	}
+}
+
+func readNodeTopology(file, node string) (string, bool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", false, err
+	}
+	var nodeTopology map[string]string
+	if err := json.Unmarshal(data, &nodeTopology); err != nil {
+		return "", false, err
+	}
+	label, ok := nodeTopology[node]
+	return label, ok, nil
+}
+
+// labelDomain waits until the operator publishes the domain of the node, then labels the mountpaths without a label
+func labelDomain(node, nodeTopologyFile, localConfig string, timeout time.Duration) {
+	if localConfig == "" || nodeTopologyFile == "" {
+		log.Fatal("-local_config and -node_topology are required")
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		label, ok, err := readNodeTopology(nodeTopologyFile, node)
+		switch {
+		case err != nil:
+			log.Printf("Failed to read node topology: %v", err)
+		case !ok:
+			log.Printf("Waiting for topology of node %q", node)
+		case label == "":
+			log.Printf("Node %q has no topology labels, leaving mountpaths unlabeled", node)
+			return
+		default:
+			if err := labelMountpaths(localConfig, label); err != nil {
+				log.Fatalf("Failed to update local config: %v", err)
+			}
+			log.Printf("Labeled mountpaths with %q", label)
+			return
+		}
+		if time.Now().After(deadline) {
+			log.Fatalf("Timed out after %v waiting for topology of node %q", timeout, node)
+		}
+		time.Sleep(configurePollInterval)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// splitList parses a comma-separated list
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func main() {
+	var (
+		mode, node, mountinfo, pattern, fsTypes string
+		localConfig, nodeMountpaths, label      string
+		nodeTopology                            string
+		interval, timeout                       time.Duration
+	)
+	flag.StringVar(&mode, "mode", modeDiscover, "Mode of operation: 'discover' to report the filesystems of the node, 'configure' to add the mountpaths of the node to the AIS local config, or 'label' to label them with the topology of the node")
+	flag.StringVar(&node, "node", os.Getenv("NODE_NAME"), "Name of the node")
+	flag.StringVar(&mountinfo, "mountinfo", "/proc/1/mountinfo", "Mount table of the host, read in 'discover' mode")
+	flag.StringVar(&pattern, "pattern", "/ais/*", "Glob matched against mount points in 'discover' mode")
+	flag.StringVar(&fsTypes, "fs_types", "xfs,ext4", "Comma-separated filesystem types reported in 'discover' mode, or empty for all")
+	flag.DurationVar(&interval, "interval", time.Minute, "How often to check the filesystems in 'discover' mode")
+	flag.StringVar(&localConfig, "local_config", "", "AIS local config written by the config init container, in 'configure' and 'label' modes")
+	flag.StringVar(&nodeMountpaths, "node_mountpaths", "", "File mapping node names to their mountpaths, in 'configure' mode")
+	flag.StringVar(&label, "label", "", "Label of the mountpaths added in 'configure' mode")
+	flag.StringVar(&nodeTopology, "node_topology", "", "File mapping node names to the label of their topology domain, in 'label' mode")
+	flag.DurationVar(&timeout, "timeout", 10*time.Minute, "How long to wait for the mountpaths or topology of the node in 'configure' and 'label' modes")
+	flag.Parse()
+
+	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+	if node == "" {
+		log.Fatal("-node or NODE_NAME is required")
+	}
+	switch mode {
+	case modeDiscover:
+		discover(node, mountinfo, pattern, splitList(fsTypes), interval)
+	case modeConfigure:
+		configure(node, nodeMountpaths, localConfig, label, timeout)
+	case modeLabel:
+		labelDomain(node, nodeTopology, localConfig, timeout)
+	default:
+		log.Fatalf("Unknown mode %q", mode)
+	}
+}
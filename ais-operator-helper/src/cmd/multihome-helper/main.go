@@ -0,0 +1,187 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+// networkStatus is an entry of the k8s.v1.cni.cncf.io/network-status pod annotation set by multus
+type networkStatus struct {
+	Name      string   `json:"name"`
+	Interface string   `json:"interface,omitempty"`
+	IPs       []string `json:"ips,omitempty"`
+}
+
+// parseRefs parses comma-separated attachment references in the `<namespace>/<name>[@<interface>]` form
+func parseRefs(value string) []string {
+	var refs []string
+	for _, ref := range strings.Split(value, ",") {
+		if ref = strings.TrimSpace(ref); ref != "" {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// attachmentIPs returns the addresses of the given family assigned on the referenced attachment,
+// or nil if multus has not reported them yet
+func attachmentIPs(statuses []networkStatus, ref string, ipv6 bool) []string {
+	name, iface, _ := strings.Cut(ref, "@")
+	for _, status := range statuses {
+		if status.Name != name || (iface != "" && status.Interface != iface) {
+			continue
+		}
+		var ips []string
+		for _, ip := range status.IPs {
+			if parsed := net.ParseIP(ip); parsed != nil && (parsed.To4() == nil) == ipv6 {
+				ips = append(ips, parsed.String())
+			}
+		}
+		return ips
+	}
+	return nil
+}
+
+func readNetworkStatus(path string) ([]networkStatus, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var statuses []networkStatus
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil, nil
+	}
+	err = json.Unmarshal(data, &statuses)
+	return statuses, err
+}
+
+type addresses struct {
+	public       []string
+	intraControl string
+	intraData    string
+}
+
+// resolveAddresses returns the addresses for each AIS network, or false while an attachment has none
+func resolveAddresses(statuses []networkStatus, public, intraControl, intraData []string, ipv6 bool) (addrs addresses, ok bool) {
+	for _, ref := range public {
+		ips := attachmentIPs(statuses, ref, ipv6)
+		if len(ips) == 0 {
+			log.Printf("Waiting for an address on %q", ref)
+			return addrs, false
+		}
+		addrs.public = append(addrs.public, ips...)
+	}
+	for _, intra := range []struct {
+		refs []string
+		addr *string
+	}{{intraControl, &addrs.intraControl}, {intraData, &addrs.intraData}} {
+		if len(intra.refs) == 0 {
+			continue
+		}
+		ips := attachmentIPs(statuses, intra.refs[0], ipv6)
+		if len(ips) == 0 {
+			log.Printf("Waiting for an address on %q", intra.refs[0])
+			return addrs, false
+		}
+		*intra.addr = ips[0]
+	}
+	return addrs, true
+}
+
+// updateLocalConfig adds the public addresses to host_net.hostname and sets the intra-cluster hostnames
+// in the AIS local config, keeping all other fields as written by the config init container
+func updateLocalConfig(path string, addrs addresses) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var conf map[string]any
+	if err := json.Unmarshal(data, &conf); err != nil {
+		return err
+	}
+	hostNet, ok := conf["host_net"].(map[string]any)
+	if !ok {
+		return errors.New("local config has no host_net section")
+	}
+	if len(addrs.public) > 0 {
+		hostname, _ := hostNet["hostname"].(string)
+		hosts := parseRefs(hostname)
+		for _, addr := range addrs.public {
+			if !contains(hosts, addr) {
+				hosts = append(hosts, addr)
+			}
+		}
+		hostNet["hostname"] = strings.Join(hosts, ",")
+	}
+	if addrs.intraControl != "" {
+		hostNet["hostname_intra_control"] = addrs.intraControl
+	}
+	if addrs.intraData != "" {
+		hostNet["hostname_intra_data"] = addrs.intraData
+	}
+	if data, err = json.Marshal(conf); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, info.Mode().Perm())
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	var (
+		localConfig, networkStatusFile              string
+		publicRefs, intraControlRefs, intraDataRefs string
+		ipv6                                        bool
+		timeout, interval                           time.Duration
+	)
+	flag.StringVar(&localConfig, "local_config", "", "AIS local config written by the config init container")
+	flag.StringVar(&networkStatusFile, "network_status", "", "File exposing the network-status annotation of the pod")
+	flag.StringVar(&publicRefs, "public", "", "Attachments whose addresses are added to the public network")
+	flag.StringVar(&intraControlRefs, "intra_control", "", "Attachment carrying the intra-cluster control network")
+	flag.StringVar(&intraDataRefs, "intra_data", "", "Attachment carrying the intra-cluster data network")
+	flag.BoolVar(&ipv6, "ipv6", false, "Use IPv6 addresses instead of IPv4")
+	flag.DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for multus to report the addresses")
+	flag.DurationVar(&interval, "interval", 2*time.Second, "How often to read the network status")
+	flag.Parse()
+
+	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+	if localConfig == "" || networkStatusFile == "" {
+		log.Fatal("-local_config and -network_status are required")
+	}
+
+	// The downward API refreshes the file periodically, so the annotation may show up after the container starts
+	deadline := time.Now().Add(timeout)
+	for {
+		statuses, err := readNetworkStatus(networkStatusFile)
+		if err != nil {
+			log.Printf("Failed to read network status: %v", err)
+		} else if addrs, ok := resolveAddresses(statuses, parseRefs(publicRefs), parseRefs(intraControlRefs), parseRefs(intraDataRefs), ipv6); ok {
+			if err := updateLocalConfig(localConfig, addrs); err != nil {
+				log.Fatalf("Failed to update local config: %v", err)
+			}
+			log.Printf("Configured public %v, intra-control %q, intra-data %q", addrs.public, addrs.intraControl, addrs.intraData)
+			return
+		}
+		if time.Now().After(deadline) {
+			log.Fatalf("Timed out after %v waiting for multihome addresses", timeout)
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// copyFile copies a regular file, replacing the destination if it exists
+func copyFile(src, dst string, mode fs.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	// Write next to the destination and rename, so an interrupted copy never leaves a truncated file behind
+	tmp := dst + ".state-copy"
+	out, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
+
+// copyOwnership keeps the owner of the source, so the AIS container can still write its state
+func copyOwnership(dst string, info fs.FileInfo) error {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	return os.Lchown(dst, int(st.Uid), int(st.Gid))
+}
+
+// copyTree copies the contents of src into dst, keeping modes, owners, and symlinks.
+// Files already in dst are replaced, other entries of dst are kept.
+func copyTree(src, dst string) (copied int, err error) {
+	err = filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		switch {
+		case d.IsDir():
+			if err := os.MkdirAll(target, info.Mode().Perm()); err != nil {
+				return err
+			}
+			if rel == "." {
+				return nil
+			}
+			if err := os.Chmod(target, info.Mode().Perm()); err != nil {
+				return err
+			}
+		case info.Mode()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(p)
+			if err != nil {
+				return err
+			}
+			if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+			if err := os.Symlink(link, target); err != nil {
+				return err
+			}
+		case info.Mode().IsRegular():
+			if err := copyFile(p, target, info.Mode()); err != nil {
+				return err
+			}
+			if err := os.Chtimes(target, info.ModTime(), info.ModTime()); err != nil {
+				return err
+			}
+		default:
+			log.Printf("Skipping %q: not a regular file, directory, or symlink", p)
+			return nil
+		}
+		copied++
+		return copyOwnership(target, info)
+	})
+	return copied, err
+}
+
+func main() {
+	var src, dst string
+	flag.StringVar(&src, "src", "", "Directory with the current state of the AIS pod")
+	flag.StringVar(&dst, "dst", "", "Directory the state is copied into")
+	flag.Parse()
+
+	log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+	if src == "" || dst == "" {
+		log.Fatal("-src and -dst are required")
+	}
+	copied, err := copyTree(src, dst)
+	if err != nil {
+		log.Fatalf("Failed to copy state from %q to %q: %v", src, dst, err)
+	}
+	log.Printf("Copied %d entries from %q to %q", copied, src, dst)
+}
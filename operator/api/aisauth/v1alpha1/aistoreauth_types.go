@@ -15,12 +15,14 @@ import (
 const (
 	defaultListenPort      int32 = 52001
 	defaultPersistenceSize       = "256Mi"
+	defaultBackupImage           = "docker.io/library/busybox:1.37"
+	defaultBackupRetention       = 7
 )
 
 type (
-	// ConditionType is a valid value for Condition.Type on AIStoreAuth status.
+	// ConditionType is a valid value for Condition.Type on AIStoreAuth and AIStoreAuthProfile status.
 	ConditionType string
-	// ConditionReason is a valid value for Condition.Reason on AIStoreAuth status.
+	// ConditionReason is a valid value for Condition.Reason on AIStoreAuth and AIStoreAuthProfile status.
 	ConditionReason string
 )
 
@@ -29,6 +31,9 @@ const (
 	// ConditionReady is the aggregate state of the resource: the observed generation is applied
 	// and the AuthN Deployment is available.
 	ConditionReady ConditionType = "Ready"
+	// ConditionBackupSucceeded reports the outcome of the most recent AuthN database backup.
+	// It is only set when spec.backup is configured.
+	ConditionBackupSucceeded ConditionType = "BackupSucceeded"
 )
 
 // AIStoreAuth status condition reasons.
@@ -47,6 +52,16 @@ const (
 	// ReasonCleanupFailed is set when finalizer-driven cleanup failed. The resource stays in
 	// deletion, and the operator keeps retrying.
 	ReasonCleanupFailed ConditionReason = "CleanupFailed"
+	// ReasonBackupCompleted is set once the most recent backup is ready to restore from.
+	ReasonBackupCompleted ConditionReason = "BackupCompleted"
+	// ReasonBackupInProgress is set while the most recent backup is still being taken.
+	ReasonBackupInProgress ConditionReason = "BackupInProgress"
+	// ReasonBackupFailed is set when the most recent backup failed. The backup is attempted again
+	// after a short delay.
+	ReasonBackupFailed ConditionReason = "BackupFailed"
+	// ReasonBackupUnavailable is set when no configured backup method can be used, for example
+	// when only volumeSnapshot is set and the cluster does not serve the snapshot API.
+	ReasonBackupUnavailable ConditionReason = "BackupUnavailable"
 )
 
 // ServerConfSpec configures token issuance, signing, and user storage.
@@ -216,6 +231,8 @@ type TLSCertificateConfig struct {
 // Exactly one of storageClass (dynamic PVC) or volumeName (bind a pre-provisioned PV)
 // must be set. PersistentVolumes must be pre-provisioned outside the operator (for example by Helm).
 // +kubebuilder:validation:XValidation:rule="[has(self.storageClass), has(self.volumeName)].filter(x, x).size() == 1",message="specify exactly one of storageClass or volumeName"
+// +kubebuilder:validation:XValidation:rule="!has(self.restoreFrom) || !has(self.restoreFrom.volumeSnapshotName) || has(self.storageClass)",message="restoreFrom.volumeSnapshotName requires storageClass"
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.restoreFrom) == has(self.restoreFrom) && (!has(self.restoreFrom) || self.restoreFrom == oldSelf.restoreFrom)",message="restoreFrom can only be set when the AIStoreAuth is created"
 type PersistenceSpec struct {
 	// Size requested for the PVC. Defaults to 256Mi.
 	// Size in bytes. Specify with a unit suffix like "Mi" or "Gi" (e.g., 256Mi, 1Gi), or as a plain integer for bytes (e.g., 268435456).
@@ -241,6 +258,147 @@ type PersistenceSpec struct {
 	// +kubebuilder:default=Retain
 	// +optional
 	DeletionPolicy PersistenceDeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// RestoreFrom seeds a new AuthN data volume from a backup. It can only be set when the
+	// AIStoreAuth is created, and an existing database on the volume is never overwritten.
+	// +optional
+	RestoreFrom *RestoreSpec `json:"restoreFrom,omitempty"`
+}
+
+// RestoreSpec selects the backup a new AuthN data volume is seeded from.
+// +kubebuilder:validation:XValidation:rule="[has(self.volumeSnapshotName), has(self.pvc)].filter(x, x).size() == 1",message="specify exactly one of volumeSnapshotName or pvc"
+type RestoreSpec struct {
+	// VolumeSnapshotName provisions the data PVC from a VolumeSnapshot in the CR namespace.
+	// Requires persistence.storageClass.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	VolumeSnapshotName *string `json:"volumeSnapshotName,omitempty"`
+
+	// PVC copies a database file from a backup PVC before AuthN starts for the first time.
+	// +optional
+	PVC *PVCRestoreSpec `json:"pvc,omitempty"`
+}
+
+// PVCRestoreSpec references a database file on a backup PVC.
+type PVCRestoreSpec struct {
+	// ClaimName names the PVC in the CR namespace holding the backup.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ClaimName string `json:"claimName"`
+
+	// Path is the database file relative to the PVC root, as reported in status.backups.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="!self.startsWith('/') && !self.split('/').exists(p, p == '..')",message="must be a relative path without '..' segments"
+	Path string `json:"path"`
+
+	// Image runs the restore init container. It must provide a POSIX shell.
+	// +kubebuilder:default:="docker.io/library/busybox:1.37"
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// GetImage returns the restore init container image, defaulting when unset.
+func (r *PVCRestoreSpec) GetImage() string {
+	if r.Image != "" {
+		return r.Image
+	}
+	return defaultBackupImage
+}
+
+// BackupSpec schedules backups of the AuthN database.
+// A VolumeSnapshot of the data PVC is taken when volumeSnapshot is set and the cluster serves
+// the snapshot.storage.k8s.io API. Otherwise a Job copies the database file into the pvc target.
+// Secrets are not offered as a target, as their 1 MiB size limit does not fit a growing database.
+// +kubebuilder:validation:XValidation:rule="has(self.volumeSnapshot) || has(self.pvc)",message="specify at least one of volumeSnapshot or pvc"
+type BackupSpec struct {
+	// Interval between two backups, for example 24h.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="must be at least 1m"
+	Interval metav1.Duration `json:"interval"`
+
+	// Retention is the number of backups kept. Older backups are deleted. Defaults to 7.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default:=7
+	// +optional
+	Retention int32 `json:"retention,omitempty"`
+
+	// VolumeSnapshot takes CSI snapshots of the AuthN data PVC.
+	// +optional
+	VolumeSnapshot *VolumeSnapshotBackupSpec `json:"volumeSnapshot,omitempty"`
+
+	// PVC copies the database file into an existing PVC. Used when volume snapshots are not
+	// configured or not available in the cluster.
+	// +optional
+	PVC *PVCBackupSpec `json:"pvc,omitempty"`
+}
+
+// VolumeSnapshotBackupSpec configures VolumeSnapshot backups.
+type VolumeSnapshotBackupSpec struct {
+	// ClassName is the VolumeSnapshotClass to use. Defaults to the cluster default class.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ClassName *string `json:"className,omitempty"`
+}
+
+// PVCBackupSpec configures database copies into a PVC.
+type PVCBackupSpec struct {
+	// ClaimName names an existing PVC in the CR namespace that receives the copies.
+	// Copies are written under a directory named after the AIStoreAuth.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ClaimName string `json:"claimName"`
+
+	// Image runs the copy Job. It must provide a POSIX shell.
+	// +kubebuilder:default:="docker.io/library/busybox:1.37"
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// GetImage returns the copy Job image, defaulting when unset.
+func (b *PVCBackupSpec) GetImage() string {
+	if b.Image != "" {
+		return b.Image
+	}
+	return defaultBackupImage
+}
+
+// GetRetention returns the number of backups kept, defaulting when unset.
+func (b *BackupSpec) GetRetention() int {
+	if b.Retention > 0 {
+		return int(b.Retention)
+	}
+	return defaultBackupRetention
+}
+
+// BackupMethod names how an AuthN database backup was taken.
+type BackupMethod string
+
+const (
+	// BackupMethodVolumeSnapshot backups are VolumeSnapshots of the AuthN data PVC.
+	BackupMethodVolumeSnapshot BackupMethod = "VolumeSnapshot"
+	// BackupMethodPVC backups are copies of the database file in the backup PVC.
+	BackupMethodPVC BackupMethod = "PVC"
+)
+
+// BackupStatus describes one retained AuthN database backup.
+type BackupStatus struct {
+	// Name is the VolumeSnapshot name, or the database file path in the backup PVC.
+	// Use it as restoreFrom.volumeSnapshotName or restoreFrom.pvc.path respectively.
+	Name string `json:"name"`
+
+	// Method that produced the backup.
+	Method BackupMethod `json:"method"`
+
+	// Time the backup was taken.
+	Time metav1.Time `json:"time"`
+
+	// ReadyToUse reports whether the backup can be restored from.
+	ReadyToUse bool `json:"readyToUse"`
 }
 
 // PersistenceDeletionPolicy names what happens to the AuthN data when the AIStoreAuth resource
@@ -404,6 +562,10 @@ type AIStoreAuthSpec struct {
 	// Deployment configures the AuthN Deployment.
 	// +kubebuilder:validation:Required
 	Deployment DeploymentSpec `json:"deployment"`
+
+	// Backup schedules backups of the AuthN database.
+	// +optional
+	Backup *BackupSpec `json:"backup,omitempty"`
 }
 
 // AIStoreAuthStatus defines the observed state of the AuthN server.
@@ -424,6 +586,10 @@ type AIStoreAuthStatus struct {
 	// Example: https://ais-authn.ais.svc.cluster.local:52001
 	// +optional
 	ServiceURL string `json:"serviceURL,omitempty"`
+
+	// Backups lists the retained AuthN database backups, newest first.
+	// +optional
+	Backups []BackupStatus `json:"backups,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -485,6 +651,21 @@ func (authn *AIStoreAuth) GetTLSSecretName() string {
 	return ""
 }
 
+// UseRestoreFromPVC returns true if a new AuthN data volume is seeded from a backup PVC.
+func (authn *AIStoreAuth) UseRestoreFromPVC() bool {
+	restore := authn.Spec.Persistence.RestoreFrom
+	return restore != nil && restore.PVC != nil
+}
+
+// GetRestoreSnapshotName returns the VolumeSnapshot the data PVC is provisioned from, if any.
+func (authn *AIStoreAuth) GetRestoreSnapshotName() string {
+	restore := authn.Spec.Persistence.RestoreFrom
+	if restore == nil || restore.VolumeSnapshotName == nil {
+		return ""
+	}
+	return *restore.VolumeSnapshotName
+}
+
 // ListenPort returns the AuthN HTTP(S) listen port.
 func (authn *AIStoreAuth) ListenPort() int32 {
 	cfg := authn.Spec.Config
@@ -11,14 +11,76 @@ const (
 	DefaultAuthProfilePassKey = "SU-PASS"
 )
 
+// AIStoreAuthProfile status condition types. ConditionReady is set when both hold.
+const (
+	// ConditionReachable reports whether the authentication provider answered the last check.
+	ConditionReachable ConditionType = "Reachable"
+	// ConditionCredentialsValid reports whether the authentication provider issued a token for
+	// the configured credentials on the last check.
+	ConditionCredentialsValid ConditionType = "CredentialsValid"
+)
+
+// AIStoreAuthProfile status condition reasons.
+const (
+	// ReasonTokenIssued is set once the authentication provider issued a token.
+	ReasonTokenIssued ConditionReason = "TokenIssued"
+	// ReasonResponseReceived is set on Reachable when the authentication provider answered,
+	// whether or not it accepted the credentials.
+	ReasonResponseReceived ConditionReason = "ResponseReceived"
+	// ReasonTLSConfigInvalid is set when the TLS configuration, such as the CA ConfigMap, could
+	// not be resolved.
+	ReasonTLSConfigInvalid ConditionReason = "TLSConfigInvalid"
+	// ReasonConnectionFailed is set when the authentication provider could not be reached.
+	ReasonConnectionFailed ConditionReason = "ConnectionFailed"
+	// ReasonCredentialsUnavailable is set when the credentials could not be read from the Secret
+	// or minted for token exchange.
+	ReasonCredentialsUnavailable ConditionReason = "CredentialsUnavailable"
+	// ReasonTokenRejected is set when the authentication provider answered without issuing a token.
+	ReasonTokenRejected ConditionReason = "TokenRejected"
+	// ReasonNotChecked is set when an earlier failure prevented the condition from being checked.
+	ReasonNotChecked ConditionReason = "NotChecked"
+)
+
 // AIStoreAuthProfile defines trusted authentication provider endpoint configuration.
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName=aisauthprofile
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",priority=1
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.serviceURL"
+// +kubebuilder:printcolumn:name="Last Token",type="date",JSONPath=".status.lastTokenTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type AIStoreAuthProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec AIStoreAuthProfileSpec `json:"spec,omitempty"`
+	Spec   AIStoreAuthProfileSpec   `json:"spec,omitempty"`
+	Status AIStoreAuthProfileStatus `json:"status,omitempty"`
+}
+
+// AIStoreAuthProfileStatus reports the health of the authentication provider, as last checked by
+// the operator.
+type AIStoreAuthProfileStatus struct {
+	// Conditions describe the result of the last check.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent spec generation the operator has checked.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastTokenTime is when the operator last obtained a token from the authentication provider.
+	// +optional
+	LastTokenTime *metav1.Time `json:"lastTokenTime,omitempty"`
+
+	// ReferencedBy lists the AIStore clusters referencing this profile, as namespace/name.
+	// +listType=set
+	// +optional
+	ReferencedBy []string `json:"referencedBy,omitempty"`
 }
 
 // AIStoreAuthProfileList is a list of AIStoreAuthProfile resources.
@@ -163,6 +225,12 @@ func (p *AIStoreAuthProfile) LoginEndpoint() string {
 	return ""
 }
 
+// GrantSubject identifies the profile in AuthReferenceGrants allowing it to reference Secrets and
+// ConfigMaps.
+func (p *AIStoreAuthProfile) GrantSubject() ReferenceGrantFrom {
+	return ReferenceGrantFrom{Kind: ReferenceGrantFromAIStoreAuthProfile, Name: p.Name}
+}
+
 // UserKeyOrDefault returns the configured username Secret key, or the default if unset.
 func (s *AuthProfileSecret) UserKeyOrDefault() string {
 	if s.UserKey != "" {
@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type (
+	// ReferenceGrantFromKind is a kind of resource that may be granted references.
+	ReferenceGrantFromKind string
+	// ReferenceGrantToKind is a kind of object that may be referenced through a grant.
+	ReferenceGrantToKind string
+)
+
+const (
+	ReferenceGrantFromAIStore            ReferenceGrantFromKind = "AIStore"
+	ReferenceGrantFromAIStoreAuthProfile ReferenceGrantFromKind = "AIStoreAuthProfile"
+
+	ReferenceGrantToSecret    ReferenceGrantToKind = "Secret"
+	ReferenceGrantToConfigMap ReferenceGrantToKind = "ConfigMap"
+)
+
+// AuthReferenceGrant allows AIStore and AIStoreAuthProfile resources to reference Secrets and
+// ConfigMaps in the grant's namespace. It is created by the owner of that namespace.
+//
+// References from an AIStore to objects in its own namespace need no grant. AIStoreAuthProfile is
+// cluster-scoped, so every Secret and ConfigMap it references must be granted.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=aisrefgrant
+type AuthReferenceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AuthReferenceGrantSpec `json:"spec,omitempty"`
+}
+
+// AuthReferenceGrantList is a list of AuthReferenceGrant resources.
+// +kubebuilder:object:root=true
+type AuthReferenceGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuthReferenceGrant `json:"items"`
+}
+
+// AuthReferenceGrantSpec lists the resources allowed to reference objects in the grant's namespace,
+// and the objects they may reference.
+type AuthReferenceGrantSpec struct {
+	// From lists the resources allowed to reference the objects in To.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	From []ReferenceGrantFrom `json:"from"`
+
+	// To lists the objects in this namespace that may be referenced.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	To []ReferenceGrantTo `json:"to"`
+}
+
+// ReferenceGrantFrom identifies the resources allowed to reference objects through a grant.
+// +kubebuilder:validation:XValidation:rule="self.kind == 'AIStore' ? has(self.__namespace__) : !has(self.__namespace__)",message="namespace must be set for AIStore and unset for the cluster-scoped AIStoreAuthProfile"
+type ReferenceGrantFrom struct {
+	// Kind is the kind of the referencing resource.
+	// +kubebuilder:validation:Enum=AIStore;AIStoreAuthProfile
+	Kind ReferenceGrantFromKind `json:"kind"`
+
+	// Namespace of the referencing AIStore. Must be unset for AIStoreAuthProfile.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the referencing resource. When unset, every resource of the kind (and namespace) is allowed.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// ReferenceGrantTo identifies the objects in the grant's namespace that may be referenced.
+type ReferenceGrantTo struct {
+	// Kind is the kind of the referenced object.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	Kind ReferenceGrantToKind `json:"kind"`
+
+	// Name of the referenced object. When unset, every object of the kind is allowed.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// String describes the referencing resource for messages, e.g. "AIStore ais/prod".
+func (f ReferenceGrantFrom) String() string {
+	if f.Namespace == "" {
+		return string(f.Kind) + " " + f.Name
+	}
+	return string(f.Kind) + " " + types.NamespacedName{Namespace: f.Namespace, Name: f.Name}.String()
+}
+
+// NeedsGrant reports whether referencing an object in the given namespace requires a grant.
+// References within the referencing resource's own namespace do not.
+func (f ReferenceGrantFrom) NeedsGrant(namespace string) bool {
+	return f.Namespace != namespace
+}
+
+// Allows reports whether any grant in the list lets from reference the named object of the given kind.
+func (l *AuthReferenceGrantList) Allows(from ReferenceGrantFrom, toKind ReferenceGrantToKind, toName string) bool {
+	for i := range l.Items {
+		if l.Items[i].Allows(from, toKind, toName) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows reports whether the grant lets from reference the named object of the given kind.
+func (g *AuthReferenceGrant) Allows(from ReferenceGrantFrom, toKind ReferenceGrantToKind, toName string) bool {
+	fromAllowed := false
+	for _, f := range g.Spec.From {
+		if f.Kind == from.Kind && f.Namespace == from.Namespace && (f.Name == "" || f.Name == from.Name) {
+			fromAllowed = true
+			break
+		}
+	}
+	if !fromAllowed {
+		return false
+	}
+	for _, to := range g.Spec.To {
+		if to.Kind == toKind && (to.Name == "" || to.Name == toName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReferenceGrantFromNeedsGrant(t *testing.T) {
+	g := NewWithT(t)
+	cluster := ReferenceGrantFrom{Kind: ReferenceGrantFromAIStore, Namespace: "ais", Name: "prod"}
+	g.Expect(cluster.NeedsGrant("ais")).To(BeFalse())
+	g.Expect(cluster.NeedsGrant("auth-config")).To(BeTrue())
+
+	profile := (&AIStoreAuthProfile{ObjectMeta: metav1.ObjectMeta{Name: "prod-authn"}}).GrantSubject()
+	g.Expect(profile.NeedsGrant("ais")).To(BeTrue())
+	g.Expect(profile.String()).To(Equal("AIStoreAuthProfile prod-authn"))
+	g.Expect(cluster.String()).To(Equal("AIStore ais/prod"))
+}
+
+func TestAuthReferenceGrantAllows(t *testing.T) {
+	cluster := ReferenceGrantFrom{Kind: ReferenceGrantFromAIStore, Namespace: "ais", Name: "prod"}
+	profile := ReferenceGrantFrom{Kind: ReferenceGrantFromAIStoreAuthProfile, Name: "prod-authn"}
+
+	tests := []struct {
+		name   string
+		spec   AuthReferenceGrantSpec
+		from   ReferenceGrantFrom
+		toKind ReferenceGrantToKind
+		toName string
+		allows bool
+	}{
+		{
+			name: "named source and target",
+			spec: AuthReferenceGrantSpec{
+				From: []ReferenceGrantFrom{cluster},
+				To:   []ReferenceGrantTo{{Kind: ReferenceGrantToSecret, Name: "admin"}},
+			},
+			from: cluster, toKind: ReferenceGrantToSecret, toName: "admin",
+			allows: true,
+		},
+		{
+			name: "unnamed source and target allow any",
+			spec: AuthReferenceGrantSpec{
+				From: []ReferenceGrantFrom{{Kind: ReferenceGrantFromAIStore, Namespace: "ais"}},
+				To:   []ReferenceGrantTo{{Kind: ReferenceGrantToSecret}},
+			},
+			from: cluster, toKind: ReferenceGrantToSecret, toName: "admin",
+			allows: true,
+		},
+		{
+			name: "other target name",
+			spec: AuthReferenceGrantSpec{
+				From: []ReferenceGrantFrom{cluster},
+				To:   []ReferenceGrantTo{{Kind: ReferenceGrantToSecret, Name: "other"}},
+			},
+			from: cluster, toKind: ReferenceGrantToSecret, toName: "admin",
+		},
+		{
+			name: "other target kind",
+			spec: AuthReferenceGrantSpec{
+				From: []ReferenceGrantFrom{cluster},
+				To:   []ReferenceGrantTo{{Kind: ReferenceGrantToConfigMap}},
+			},
+			from: cluster, toKind: ReferenceGrantToSecret, toName: "admin",
+		},
+		{
+			name: "source in another namespace",
+			spec: AuthReferenceGrantSpec{
+				From: []ReferenceGrantFrom{{Kind: ReferenceGrantFromAIStore, Namespace: "staging"}},
+				To:   []ReferenceGrantTo{{Kind: ReferenceGrantToSecret}},
+			},
+			from: cluster, toKind: ReferenceGrantToSecret, toName: "admin",
+		},
+		{
+			name: "source of another kind",
+			spec: AuthReferenceGrantSpec{
+				From: []ReferenceGrantFrom{{Kind: ReferenceGrantFromAIStore, Namespace: "ais"}},
+				To:   []ReferenceGrantTo{{Kind: ReferenceGrantToConfigMap}},
+			},
+			from: profile, toKind: ReferenceGrantToConfigMap, toName: "ca",
+		},
+		{
+			name: "profile",
+			spec: AuthReferenceGrantSpec{
+				From: []ReferenceGrantFrom{{Kind: ReferenceGrantFromAIStoreAuthProfile}},
+				To:   []ReferenceGrantTo{{Kind: ReferenceGrantToConfigMap}},
+			},
+			from: profile, toKind: ReferenceGrantToConfigMap, toName: "ca",
+			allows: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			grant := AuthReferenceGrant{Spec: tt.spec}
+			g.Expect(grant.Allows(tt.from, tt.toKind, tt.toName)).To(Equal(tt.allows))
+
+			list := &AuthReferenceGrantList{Items: []AuthReferenceGrant{{}, grant}}
+			g.Expect(list.Allows(tt.from, tt.toKind, tt.toName)).To(Equal(tt.allows))
+		})
+	}
+}
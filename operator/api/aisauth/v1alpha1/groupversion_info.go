@@ -31,6 +31,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&AIStoreAuthList{},
 		&AIStoreAuthProfile{},
 		&AIStoreAuthProfileList{},
+		&AuthReferenceGrant{},
+		&AuthReferenceGrantList{},
 	)
 	metav1.AddToGroupVersion(scheme, GroupVersion)
 	return nil
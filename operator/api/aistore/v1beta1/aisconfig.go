@@ -5,6 +5,8 @@
 package v1beta1
 
 import (
+	"slices"
+
 	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aiscmn "github.com/NVIDIA/aistore/cmn"
 	aiscos "github.com/NVIDIA/aistore/cmn/cos"
@@ -176,7 +178,8 @@ type (
 		Sync            *bool `json:"synchronize,omitempty"`
 	}
 	NetConfToUpdate struct {
-		HTTP *HTTPConfToUpdate `json:"http,omitempty"`
+		HTTP    *HTTPConfToUpdate `json:"http,omitempty"`
+		UseIPv6 *bool             `json:"use_ipv6,omitempty"`
 	}
 
 	HTTPConfToUpdate struct {
@@ -368,6 +371,19 @@ func (c *ConfigToUpdate) ConfigureAuth(authSpec *AuthSpec, issuerCAPath string)
 		}
 		c.Auth.OIDC.IssuerCA = &issuerCAPath
 	}
+
+	if authSpec.OIDC != nil {
+		if c.Auth.OIDC == nil {
+			c.Auth.OIDC = &OIDCConfToUpdate{}
+		}
+		c.Auth.OIDC.AllowedIssuers = aisapc.Ptr(slices.Clone(authSpec.OIDC.Issuers))
+		if len(authSpec.OIDC.Audiences) > 0 {
+			if c.Auth.RequiredClaims == nil {
+				c.Auth.RequiredClaims = &RequiredClaimsConfToUpdate{}
+			}
+			c.Auth.RequiredClaims.Aud = aisapc.Ptr(slices.Clone(authSpec.OIDC.Audiences))
+		}
+	}
 }
 
 func (c *ConfigToUpdate) Convert() (toUpdate *aiscmn.ConfigToSet, err error) {
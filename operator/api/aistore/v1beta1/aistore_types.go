@@ -7,12 +7,16 @@ package v1beta1
 import (
 	"crypto/tls"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	aisapc "github.com/NVIDIA/aistore/api/apc"
 	"gopkg.in/inf.v0"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -69,6 +73,10 @@ const (
 	ConditionReady ClusterConditionType = "Ready"
 	// ConditionReadyRebalance indicates whether the cluster should allow rebalance as determined by spec or default config.
 	ConditionReadyRebalance ClusterConditionType = "ReadyRebalance"
+	// ConditionOIDCIssuersDiscovered indicates the discovery documents of all issuers in spec.auth.oidc were fetched.
+	ConditionOIDCIssuersDiscovered ClusterConditionType = "OIDCIssuersDiscovered"
+	// ConditionTLSCertificateServed indicates every AIS pod serves the certificate currently in the TLS Secret.
+	ConditionTLSCertificateServed ClusterConditionType = "TLSCertificateServed"
 )
 
 // These are reasons for a AIStore's transition to a condition.
@@ -76,6 +84,12 @@ const (
 	ReasonUpgrading ClusterConditionReason = "Upgrading"
 	ReasonScaling   ClusterConditionReason = "Scaling"
 	ReasonShutdown  ClusterConditionReason = "Shutdown"
+
+	ReasonOIDCDiscoveryFailed ClusterConditionReason = "DiscoveryFailed"
+
+	ReasonStaleCertificate     ClusterConditionReason = "StaleCertificate"
+	ReasonCertificateReloading ClusterConditionReason = "Reloading"
+	ReasonCertificateRestart   ClusterConditionReason = "Restarting"
 )
 
 // Helper constants.
@@ -85,13 +99,180 @@ const (
 	aisAzureURL         = "AIS_AZURE_URL"
 )
 
+// ExternalAccessType selects how a component is exposed outside the K8s cluster.
+type ExternalAccessType string
+
+const (
+	// ExternalAccessLoadBalancer creates LoadBalancer Services.
+	ExternalAccessLoadBalancer ExternalAccessType = "LoadBalancer"
+	// ExternalAccessGateway creates Gateway API routes attached to existing Gateways.
+	ExternalAccessGateway ExternalAccessType = "Gateway"
+	// ExternalAccessIngress creates Ingress resources.
+	ExternalAccessIngress ExternalAccessType = "Ingress"
+	// ExternalAccessNodePort creates NodePort Services.
+	ExternalAccessNodePort ExternalAccessType = "NodePort"
+)
+
+// GatewayRouteKind is the kind of Gateway API route created for external access.
+type GatewayRouteKind string
+
+const (
+	GatewayRouteKindHTTP GatewayRouteKind = "HTTPRoute"
+	GatewayRouteKindTLS  GatewayRouteKind = "TLSRoute"
+)
+
+// Placeholders replaced in ExternalAccessSpec.HostnameTemplate.
+const (
+	HostnamePlaceholderCluster   = "{cluster}"
+	HostnamePlaceholderNamespace = "{namespace}"
+	HostnamePlaceholderIndex     = "{index}"
+)
+
 // ExternalAccessSpec configures services for external client access.
-// On proxies this creates one shared LoadBalancer; on targets one LoadBalancer per pod ordinal.
+// With the LoadBalancer type, proxies share one LoadBalancer and targets get one LoadBalancer per pod ordinal.
+// With the Gateway and Ingress types, the same layout is built from ClusterIP Services and routes,
+// and targets advertise their routed hostnames as AIS public addresses so redirects reach them.
+// With ExternalDNS set, LoadBalancer Services are annotated for external-dns with the hostnames from HostnameTemplate,
+// which targets advertise instead of LoadBalancer addresses.
+// With the NodePort type, proxies and targets each share one NodePort Service on a fixed port; target traffic
+// stays on the receiving node, and targets advertise the IP of their node with that port.
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type in ['LoadBalancer', 'NodePort'] || has(self.hostnameTemplate)",message="hostnameTemplate is required with the Gateway and Ingress types"
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'Gateway' || has(self.gateway)",message="gateway is required with the Gateway type"
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'NodePort' || has(self.nodePort)",message="nodePort is required with the NodePort type"
+// +kubebuilder:validation:XValidation:rule="!has(self.externalDNS) || !has(self.type) || self.type == 'LoadBalancer'",message="externalDNS is only supported with the LoadBalancer type"
+// +kubebuilder:validation:XValidation:rule="!has(self.externalDNS) || has(self.hostnameTemplate)",message="hostnameTemplate is required with externalDNS"
 type ExternalAccessSpec struct {
-	// Annotations are merged onto the created service(s)
+	// Annotations are merged onto the created service(s), routes and Ingresses
 	// (for example cloud provider or external-dns annotations).
 	// +optional
 	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Type selects how the component is exposed. Defaults to LoadBalancer.
+	// +kubebuilder:validation:Enum=LoadBalancer;Gateway;Ingress;NodePort
+	// +optional
+	Type *ExternalAccessType `json:"type,omitempty"`
+
+	// HostnameTemplate is the external hostname routed to the component, required with the Gateway and Ingress types
+	// and with ExternalDNS.
+	// "{cluster}", "{namespace}" and "{index}" are replaced with the cluster name, its namespace and the pod ordinal.
+	// Target hostnames must contain "{index}"; proxies share a single hostname and must not.
+	// +optional
+	HostnameTemplate *string `json:"hostnameTemplate,omitempty"`
+
+	// Gateway configures the Gateway API routes created with the Gateway type.
+	// +optional
+	Gateway *GatewayAccessSpec `json:"gateway,omitempty"`
+
+	// Ingress configures the Ingresses created with the Ingress type.
+	// +optional
+	Ingress *IngressAccessSpec `json:"ingress,omitempty"`
+
+	// ExternalDNS publishes a stable DNS name for each LoadBalancer Service through external-dns.
+	// +optional
+	ExternalDNS *ExternalDNSSpec `json:"externalDNS,omitempty"`
+
+	// NodePort configures the NodePort Service created with the NodePort type.
+	// +optional
+	NodePort *NodePortAccessSpec `json:"nodePort,omitempty"`
+}
+
+// ExternalDNSSpec configures stable DNS names for LoadBalancer Services, published by external-dns.
+type ExternalDNSSpec struct {
+	// Zone is the DNS zone managed by external-dns. Hostnames rendered from HostnameTemplate must be within it.
+	// +kubebuilder:validation:MinLength=1
+	Zone string `json:"zone"`
+	// TTL of the published DNS records, in seconds. Defaults to the external-dns default.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TTL *int32 `json:"ttl,omitempty"`
+}
+
+// GatewayAccessSpec configures Gateway API routes for external access.
+type GatewayAccessSpec struct {
+	// ParentRefs are the Gateways, or Gateway listeners, the routes attach to.
+	// Listeners must serve the AIS public port, since AIS includes it in redirects.
+	// +kubebuilder:validation:MinItems=1
+	ParentRefs []GatewayParentRef `json:"parentRefs"`
+
+	// RouteKind is the kind of route to create.
+	// Defaults to TLSRoute, passing TLS through to AIS, on TLS clusters and to HTTPRoute otherwise.
+	// +kubebuilder:validation:Enum=HTTPRoute;TLSRoute
+	// +optional
+	RouteKind *GatewayRouteKind `json:"routeKind,omitempty"`
+}
+
+// GatewayParentRef references a Gateway, or one of its listeners.
+type GatewayParentRef struct {
+	// Name of the Gateway.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace of the Gateway. Defaults to the AIStore namespace.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// SectionName is the name of the listener to attach to.
+	// +optional
+	SectionName *string `json:"sectionName,omitempty"`
+}
+
+// IngressAccessSpec configures Ingresses for external access.
+type IngressAccessSpec struct {
+	// ClassName is the IngressClass of the created Ingresses.
+	// +optional
+	ClassName *string `json:"className,omitempty"`
+	// TLSSecretName is a Secret in the AIStore namespace with the certificate the Ingress controller serves.
+	// Ingress controllers that terminate TLS need backend annotations to reach a TLS cluster.
+	// +optional
+	TLSSecretName *string `json:"tlsSecretName,omitempty"`
+}
+
+// NodePortAccessSpec configures the NodePort Service for external access.
+type NodePortAccessSpec struct {
+	// Port is the node port of the Service. It must be within the service node port range of the K8s cluster
+	// and is fixed so that the addresses advertised by AIS do not change when the Service is recreated.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// GetType returns the external access type, defaulting to ExternalAccessLoadBalancer.
+func (ea *ExternalAccessSpec) GetType() ExternalAccessType {
+	if ea.Type == nil {
+		return ExternalAccessLoadBalancer
+	}
+	return *ea.Type
+}
+
+// UsesRoutes reports whether the component is exposed through Gateway API routes or Ingresses.
+func (ea *ExternalAccessSpec) UsesRoutes() bool {
+	return ea != nil && (ea.GetType() == ExternalAccessGateway || ea.GetType() == ExternalAccessIngress)
+}
+
+// UsesExternalDNS reports whether the LoadBalancer Services of the component are published with external-dns.
+func (ea *ExternalAccessSpec) UsesExternalDNS() bool {
+	return ea != nil && ea.ExternalDNS != nil && ea.GetType() == ExternalAccessLoadBalancer
+}
+
+// AdvertisesHostname reports whether pods of the component advertise the hostname rendered from
+// HostnameTemplate as their AIS public address.
+func (ea *ExternalAccessSpec) AdvertisesHostname() bool {
+	return ea.UsesRoutes() || ea.UsesExternalDNS()
+}
+
+// UsesNodePort reports whether the component is exposed through a NodePort Service.
+func (ea *ExternalAccessSpec) UsesNodePort() bool {
+	return ea != nil && ea.GetType() == ExternalAccessNodePort
+}
+
+// GetRouteKind returns the configured route kind, defaulting to TLSRoute when AIS serves HTTPS.
+func (g *GatewayAccessSpec) GetRouteKind(useHTTPS bool) GatewayRouteKind {
+	switch {
+	case g.RouteKind != nil:
+		return *g.RouteKind
+	case useHTTPS:
+		return GatewayRouteKindTLS
+	default:
+		return GatewayRouteKindHTTP
+	}
 }
 
 // PubNetDNSMode defines allowed values for publicNetDNSMode spec option
@@ -103,6 +284,15 @@ const (
 	PubNetDNSModePod  PubNetDNSMode = "Pod"
 )
 
+// IPFamilyPolicy defines allowed values for ipFamilyPolicy spec option
+type IPFamilyPolicy string
+
+const (
+	IPFamilyPolicyIPv4      IPFamilyPolicy = "IPv4"
+	IPFamilyPolicyIPv6      IPFamilyPolicy = "IPv6"
+	IPFamilyPolicyDualStack IPFamilyPolicy = "DualStack"
+)
+
 // NOTE: json tags are required. Any new fields you add must have json tags for the fields to be serialized.
 // IMPORTANT: Run "make" to regenerate code after modifying this file
 
@@ -143,6 +333,38 @@ type AuthSpec struct {
 	// TLS configuration for secure connections with Auth service
 	// +optional
 	TLS *AuthTLSConfig `json:"tls,omitempty"`
+
+	// OIDC configures the AIS cluster to accept tokens issued by external OIDC identity providers
+	// Unlike the fields above, it configures token validation in AIS rather than how the operator
+	// obtains tokens, so it applies together with profileRef
+	// +optional
+	OIDC *OIDCAuthSpec `json:"oidc,omitempty"`
+}
+
+// OIDCAuthSpec configures AIS to validate tokens from external OIDC issuers
+// It is translated into configToUpdate.auth.oidc and configToUpdate.auth.required_claims
+// There is no claim-to-role mapping, as AIS only reads permissions from the "admin", "clusters" and "buckets"
+// claims and has no config to map other claims to them. The identity provider must issue these claims
+type OIDCAuthSpec struct {
+	// Issuers lists the issuer URLs AIS accepts tokens from, matched against the token "iss" claim
+	// Each issuer must serve an OpenID Connect discovery document, which the operator fetches to validate it
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^https://[^?#]+$`
+	Issuers []string `json:"issuers"`
+
+	// Audiences lists the values AIS requires in the token "aud" claim
+	// A token is accepted when it names at least one of them. If not specified, the audience is not checked
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+
+	// CAConfigMap is the name of a ConfigMap in the cluster namespace holding the CA bundle for verifying
+	// issuer certificates under the key "ca.crt"
+	// It is mounted to proxy pods at /etc/ais/oidc-ca, and is used by the operator for discovery
+	// Cannot be set together with spec.issuerCAConfigMap
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CAConfigMap *string `json:"caConfigMap,omitempty"`
 }
 
 // AuthProfileRef references a cluster-scoped AIStoreAuthProfile
@@ -208,6 +430,101 @@ type TokenExchangeAuth struct {
 	TokenExchangeEndpoint *string `json:"tokenExchangeEndpoint,omitempty"`
 }
 
+// NetworkUsage is the AIS network carried by a multihome attachment.
+// +kubebuilder:validation:Enum=Public;IntraControl;IntraData
+type NetworkUsage string
+
+const (
+	// NetworkUsagePublic adds the addresses of the attachment to the public network of AIS, next to the primary address
+	NetworkUsagePublic NetworkUsage = "Public"
+	// NetworkUsageIntraControl moves the intra-cluster control network of AIS to the attachment
+	NetworkUsageIntraControl NetworkUsage = "IntraControl"
+	// NetworkUsageIntraData moves the intra-cluster data network of AIS to the attachment
+	NetworkUsageIntraData NetworkUsage = "IntraData"
+)
+
+// MultihomeSpec attaches additional networks to AIS pods with multus.
+type MultihomeSpec struct {
+	// Attachments lists the NetworkAttachmentDefinitions attached to every proxy and target pod.
+	// +kubebuilder:validation:MinItems=1
+	Attachments []NetworkAttachment `json:"attachments"`
+}
+
+// NetworkAttachment references a multus NetworkAttachmentDefinition and the AIS networks it carries.
+type NetworkAttachment struct {
+	// Name of the NetworkAttachmentDefinition.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace of the NetworkAttachmentDefinition. Defaults to the namespace of the cluster.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// Interface is the name of the interface in the pod. Assigned by multus when unset.
+	// +optional
+	Interface *string `json:"interface,omitempty"`
+	// Usage lists the AIS networks using the addresses assigned on the attachment.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Usage []NetworkUsage `json:"usage"`
+}
+
+// GetNamespace returns the namespace of the NetworkAttachmentDefinition, defaulting to the given one.
+func (na *NetworkAttachment) GetNamespace(defaultNamespace string) string {
+	if na.Namespace == nil {
+		return defaultNamespace
+	}
+	return *na.Namespace
+}
+
+// HasUsage reports whether the attachment carries the given AIS network.
+func (na *NetworkAttachment) HasUsage(usage NetworkUsage) bool {
+	return slices.Contains(na.Usage, usage)
+}
+
+// NetworkPolicySpec configures the NetworkPolicies generated for proxy and target pods.
+// The policies restrict ingress only, and do not apply to pods using host networking.
+type NetworkPolicySpec struct {
+	// Enabled controls whether the NetworkPolicies are created.
+	// When NetworkPolicy is specified without this field, it defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// PublicAccess lists the peers allowed to reach the public port of proxies and targets,
+	// in addition to the pods of the cluster, its admin client, and the operator.
+	// The public port of a component configured with external access is reachable from any peer.
+	// +optional
+	PublicAccess []networkingv1.NetworkPolicyPeer `json:"publicAccess,omitempty"`
+
+	// OperatorAccess lists the peers matching the operator pods.
+	// Defaults to pods labeled `control-plane: controller-manager` in the namespace of the operator.
+	// +optional
+	OperatorAccess []networkingv1.NetworkPolicyPeer `json:"operatorAccess,omitempty"`
+}
+
+// DefaultGarbageCollectionInterval is the time between garbage collection passes when unset
+const DefaultGarbageCollectionInterval = time.Hour
+
+// GarbageCollectionSpec configures the removal of orphaned resources: PVCs and per-ordinal target LoadBalancer
+// Services of ordinals beyond the cluster size, finished cleanup Jobs, and the resources of deleted clusters.
+type GarbageCollectionSpec struct {
+	// Interval between garbage collection passes (default: 1h, minimum: 1m).
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// PVCRetention is how long an orphaned PVC is kept before it is deleted, counted from the pass that first
+	// found it. Orphaned PVCs are only reported when unset.
+	// Services and finished Jobs hold no data, and are deleted as soon as they are found.
+	// +optional
+	PVCRetention *metav1.Duration `json:"pvcRetention,omitempty"`
+}
+
+// GetInterval returns the time between garbage collection passes
+func (gc *GarbageCollectionSpec) GetInterval() time.Duration {
+	if gc.Interval == nil {
+		return DefaultGarbageCollectionInterval
+	}
+	return gc.Interval.Duration
+}
+
 // AdminClientSpec defines the optional admin client
 type AdminClientSpec struct {
 	// Enabled controls whether the admin client deployment is created.
@@ -289,6 +606,68 @@ type StateStorage struct {
 	EmptyDir *StateEmptyDirConfig `json:"emptyDir,omitempty"`
 }
 
+// DefaultTrustSourceKey is the ConfigMap or Secret key read for CA certificates when unset
+const DefaultTrustSourceKey = "ca.crt"
+
+// TrustSpec lists the sources of CA certificates trusted for the cluster
+type TrustSpec struct {
+	// Sources of PEM CA certificates. Certificates are deduplicated and kept in source order.
+	// +kubebuilder:validation:MinItems=1
+	Sources []TrustSource `json:"sources"`
+
+	// UseSystemCAs controls whether the operator's clients for the cluster also trust the operator's system
+	// CA certificates. AIS pods and the admin client always trust the system CAs of their images.
+	// Defaults to true.
+	// +optional
+	UseSystemCAs *bool `json:"useSystemCAs,omitempty"`
+}
+
+// TrustSource is a single source of CA certificates in the cluster namespace
+// +kubebuilder:validation:XValidation:rule="[has(self.configMap), has(self.secret), has(self.certificate)].filter(x, x).size() == 1",message="exactly one of configMap, secret, or certificate must be set"
+type TrustSource struct {
+	// ConfigMap holding PEM CA certificates
+	// +optional
+	ConfigMap *TrustSourceKeySelector `json:"configMap,omitempty"`
+
+	// Secret holding PEM CA certificates
+	// +optional
+	Secret *TrustSourceKeySelector `json:"secret,omitempty"`
+
+	// Certificate is the name of a cert-manager Certificate. The issuing CA published under `ca.crt`
+	// in the Certificate's Secret is trusted.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Certificate *string `json:"certificate,omitempty"`
+}
+
+// TrustSourceKeySelector selects a key of a ConfigMap or Secret holding PEM CA certificates
+type TrustSourceKeySelector struct {
+	// Name of the ConfigMap or Secret
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key holding the certificates. Defaults to "ca.crt".
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// Optional skips the source while it does not exist, instead of failing the bundle
+	// +optional
+	Optional *bool `json:"optional,omitempty"`
+}
+
+// GetKey returns the key holding the certificates, applying the default
+func (s *TrustSourceKeySelector) GetKey() string {
+	if s.Key != nil {
+		return *s.Key
+	}
+	return DefaultTrustSourceKey
+}
+
+// IsOptional returns true if the source may be missing
+func (s *TrustSourceKeySelector) IsOptional() bool {
+	return s.Optional != nil && *s.Optional
+}
+
 // CAConfigMapRef references a ConfigMap containing a CA certificate bundle
 type CAConfigMapRef struct {
 	// Name of the ConfigMap containing the CA bundle
@@ -313,8 +692,12 @@ const (
 	TLSCertificateModeCSI TLSCertificateMode = "csi"
 )
 
+// DefaultSelfSignedCertDuration is the lifetime of operator-issued certificates when unset
+const DefaultSelfSignedCertDuration = 90 * 24 * time.Hour
+
 // TLSSpec configures TLS certificate provisioning
-// +kubebuilder:validation:XValidation:rule="[has(self.secretName), has(self.certificate)].filter(x, x).size() <= 1",message="specify only one: secretName or certificate"
+// +kubebuilder:validation:XValidation:rule="[has(self.secretName), has(self.certificate), has(self.selfSigned)].filter(x, x).size() <= 1",message="specify only one: secretName, certificate or selfSigned"
+// +kubebuilder:validation:XValidation:rule="!has(self.operatorClientCertificate) || has(self.secretName) || has(self.certificate) || has(self.selfSigned)",message="operatorClientCertificate requires secretName, certificate or selfSigned"
 type TLSSpec struct {
 	// SecretName references an existing TLS secret
 	// +optional
@@ -323,6 +706,52 @@ type TLSSpec struct {
 	// Certificate configures cert-manager certificate generation
 	// +optional
 	Certificate *TLSCertificateConfig `json:"certificate,omitempty"`
+
+	// SelfSigned configures certificates issued by a CA the operator generates and stores itself,
+	// for clusters without cert-manager. The CA bundle is published in the `<name>-ca-bundle` ConfigMap.
+	// +optional
+	SelfSigned *TLSSelfSignedConfig `json:"selfSigned,omitempty"`
+
+	// OperatorClientCertificate has the operator issue itself a client certificate for this cluster, for mutual
+	// TLS between the operator and AIS. AIS requires client certificates once configToUpdate.net.http.client_auth_tls is set.
+	// The certificate is stored in the `<name>-operator-client-tls` Secret and renewed before it expires.
+	// +optional
+	OperatorClientCertificate *TLSOperatorClientCertificate `json:"operatorClientCertificate,omitempty"`
+}
+
+// TLSOperatorClientCertificate configures the client certificate the operator presents to AIS
+type TLSOperatorClientCertificate struct {
+	// IssuerRef references the cert-manager issuer of the client certificate, which must be issued by a CA
+	// in the cluster's ca.crt. When unset, the certificate is issued by the CA of spec.tls.selfSigned.
+	// +optional
+	IssuerRef *CertIssuerRef `json:"issuerRef,omitempty"`
+
+	// Duration is the lifetime of the certificate.
+	// When unset, cert-manager's default applies, or the duration of spec.tls.selfSigned.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBefore is how long before expiry the certificate is renewed.
+	// When unset, cert-manager's default applies, or the renewBefore of spec.tls.selfSigned.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// TLSSelfSignedConfig configures certificates issued by the operator's own CA
+type TLSSelfSignedConfig struct {
+	// AdditionalDNSNames are extra DNS names to include in the certificate
+	// +optional
+	AdditionalDNSNames []string `json:"additionalDNSNames,omitempty"`
+
+	// Duration is the lifetime of the certificate (default: 2160h, 90 days, minimum: 24h).
+	// The CA is valid for ten times as long.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBefore is how long before expiry the certificate is renewed (default: a third of duration,
+	// minimum: 1h). The CA is renewed the same fraction of its own lifetime before it expires.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
 }
 
 // TLSCertificateConfig configures cert-manager certificate generation
@@ -367,6 +796,7 @@ type CertIssuerRef struct {
 // AIStoreSpec defines the desired state of AIStore
 // +kubebuilder:validation:XValidation:rule="(has(self.targetSpec.size) && has(self.proxySpec.size)) || has(self.size)",message="Invalid cluster size, it is either not specified or value is not valid"
 // +kubebuilder:validation:XValidation:rule="!has(self.cleanupData) || !self.cleanupData || (has(self.cleanupMetadata) && self.cleanupMetadata)",message="cleanupData requires cleanupMetadata to be enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.cleanupHostData) || (has(self.cleanupData) && self.cleanupData)",message="cleanupHostData requires cleanupData to be enabled"
 type AIStoreSpec struct {
 	// Size of the cluster i.e. number of proxies and number of targets.
 	// This can be changed by specifying size in either `proxySpec` or `targetSpec`.
@@ -383,6 +813,8 @@ type AIStoreSpec struct {
 	// +kubebuilder:validation:Optional
 	LogSidecar *LogSidecarSpec `json:"logSidecar"`
 
+	// StateStorage configures where AIS pods keep their state. Changing it on an existing cluster migrates
+	// the state of each pod to the new storage, except that the storage class of a PVC cannot be changed.
 	// +optional
 	StateStorage *StateStorage `json:"stateStorage,omitempty"`
 	// Deprecated: use stateStorage.hostPath.prefix.
@@ -398,6 +830,7 @@ type AIStoreSpec struct {
 	// to proxy pods at /etc/ais/oidc-ca and spec.configToUpdate.auth.oidc.issuer_ca_bundle
 	// will be automatically configured to reference it.
 	// The ConfigMap must contain a key named "ca.crt" with the CA bundle in PEM format.
+	// Prefer spec.auth.oidc.caConfigMap, which also lets the operator verify the issuers.
 	// +kubebuilder:validation:MinLength=1
 	// +optional
 	IssuerCAConfigMap *string `json:"issuerCAConfigMap,omitempty"`
@@ -410,13 +843,24 @@ type AIStoreSpec struct {
 	// +optional
 	APIMode *string `json:"apiMode,omitempty"`
 	// Commma-separated list of names of additional network attachment definitions to attach to each pod
+	// Prefer spec.multihome, which also configures AIS with the addresses assigned on the attachments.
 	// +optional
 	NetAttachment *string `json:"networkAttachment,omitempty"`
+	// Multihome attaches additional networks to AIS pods and configures AIS to use the addresses assigned on them
+	// for its public, intra-control and intra-data networks. Cannot be combined with networkAttachment.
+	// +optional
+	Multihome *MultihomeSpec `json:"multihome,omitempty"`
 
 	// Proxy deployment specification.
 	ProxySpec DaemonSpec `json:"proxySpec"`
 	// Target deployment specification.
 	TargetSpec TargetSpec `json:"targetSpec"`
+	// TargetPools are additional groups of targets joining the cluster, each deployed as its own StatefulSet from
+	// the target spec with the size, mounts, resources and scheduling of the pool, e.g. for nodes with other disks.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	TargetPools []TargetPoolSpec `json:"targetPools,omitempty"`
 
 	// ShutdownCluster can be set true if the desired state of the cluster is shutdown with a future restart expected
 	// When enabled, the operator will gracefully shut down the AIS cluster and scale cluster size to 0
@@ -443,6 +887,13 @@ type AIStoreSpec struct {
 	// +optional
 	CleanupData *bool `json:"cleanupData,omitempty"`
 
+	// CleanupHostData confirms that the contents of target mountpaths using `useHostPath` are deleted on every
+	// target node by operator-managed jobs when the cluster is decommissioned with CleanupData.
+	// Must be set to the name of the AIStore resource; any other value is rejected.
+	// The jobs only delete the directories of this cluster under each mountpath.
+	// +optional
+	CleanupHostData *string `json:"cleanupHostData,omitempty"`
+
 	// Defines the cluster domain name for DNS.
 	// If set, will override the cluster domain the operator is configured with or discovers at startup.
 	//
@@ -470,6 +921,11 @@ type AIStoreSpec struct {
 	// +optional
 	TLS *TLSSpec `json:"tls,omitempty"`
 
+	// Trust merges CA certificates from several sources into the `<name>-trust-bundle` ConfigMap, trusted by
+	// AIS pods, the admin client, and the operator's own clients for the cluster
+	// +optional
+	Trust *TrustSpec `json:"trust,omitempty"`
+
 	// Secret name containing OTEL trace-exporter token.
 	TracingTokenSecretName *string `json:"tracingTokenSecretName,omitempty"`
 
@@ -511,11 +967,29 @@ type AIStoreSpec struct {
 	// +optional
 	PublicNetDNSMode *PubNetDNSMode `json:"publicNetDNSMode,omitempty"`
 
+	// IPFamilyPolicy declares the IP families of the K8s cluster network used by AIS.
+	//  'IPv4' or 'IPv6' create single-stack Services of that family and advertise node addresses of that family.
+	//  'DualStack' requires dual-stack Services, IPv4 first, and includes node addresses of both families in certificates.
+	// When unset, Services use the K8s cluster default and the first node address is used.
+	// +kubebuilder:validation:Enum=IPv4;IPv6;DualStack
+	// +optional
+	IPFamilyPolicy *IPFamilyPolicy `json:"ipFamilyPolicy,omitempty"`
+
 	// AdminClient specifies the optional admin client deployment
 	// The deployment is automatically configured to connect to this AIS cluster
 	// +optional
 	AdminClient *AdminClientSpec `json:"adminClient,omitempty"`
 
+	// NetworkPolicy enables operator-managed NetworkPolicies restricting which pods can reach proxies and targets.
+	// Intra-cluster ports only accept AIS pods of this cluster.
+	// +optional
+	NetworkPolicy *NetworkPolicySpec `json:"networkPolicy,omitempty"`
+
+	// GarbageCollection enables a periodic pass deleting the resources left behind by scale-downs, failed
+	// decommissions, and clusters of the namespace deleted without cleanup.
+	// +optional
+	GarbageCollection *GarbageCollectionSpec `json:"garbageCollection,omitempty"`
+
 	// PriorityClassName specifies the priority class name for AIS daemon pods (proxy and target).
 	// Setting a high priority class prevents pods from being evicted during node pressure events.
 	// See: https://kubernetes.io/docs/concepts/scheduling-eviction/pod-priority-preemption/
@@ -542,6 +1016,39 @@ type AIStoreStatus struct {
 	// ClusterID is a unique identifier for the cluster.
 	// +optional
 	ClusterID string `json:"clusterID,omitempty"`
+	// TLS reports the certificate in the cluster's TLS Secret and whether AIS pods serve it.
+	// Not set for clusters without HTTPS or using the cert-manager CSI driver.
+	// +optional
+	TLS *TLSStatus `json:"tls"`
+	// TrustBundle reports the CA bundle merged from spec.trust.sources.
+	// +optional
+	TrustBundle *TrustBundleStatus `json:"trustBundle"`
+
+	// ExternalEndpoints lists the addresses clients outside the K8s cluster use to reach AIS.
+	// +optional
+	ExternalEndpoints []ExternalEndpoint `json:"externalEndpoints"`
+	// MultihomeAddresses lists the addresses assigned to each pod on the attachments of spec.multihome.
+	// +optional
+	MultihomeAddresses []MultihomeAddresses `json:"multihomeAddresses"`
+	// HostCleanup reports the result of the cleanup job run on each node when the cluster is decommissioned.
+	// +optional
+	HostCleanup []HostCleanupResult `json:"hostCleanup"`
+	// StateMigration reports the pods moving to the state storage in the spec. Not set when no pod is migrating.
+	// +optional
+	StateMigration *StateMigrationStatus `json:"stateMigration"`
+	// GarbageCollection reports the last garbage collection pass and the orphaned resources it kept.
+	// +optional
+	GarbageCollection *GarbageCollectionStatus `json:"garbageCollection"`
+	// TargetDrains lists the targets put into maintenance because their node is being drained.
+	// +optional
+	TargetDrains []TargetDrainStatus `json:"targetDrains"`
+	// TargetDomains counts the targets scheduled in each zone and rack of spec.targetSpec.topologySpread.
+	// +optional
+	TargetDomains []TopologyDomainStatus `json:"targetDomains"`
+	// ReleasedLocalPVs lists the local PVs released by their claim that still hold its data, kept until an admin
+	// wipes the disk and deletes them.
+	// +optional
+	ReleasedLocalPVs []ReleasedLocalPV `json:"releasedLocalPVs"`
 	// Represents the observations of a AIStores's current state.
 	// Known condition types are: "Initialized", "Created", and "Ready".
 	// +patchMergeKey=type
@@ -551,6 +1058,178 @@ type AIStoreStatus struct {
 	Conditions []metav1.Condition `json:"conditions"`
 }
 
+// TLSStatus tracks the rotation of the certificate in the cluster's TLS Secret.
+// Fields are not omitted when empty, so status merge patches clear them.
+type TLSStatus struct {
+	// SecretName is the Secret holding the certificate mounted by AIS pods.
+	SecretName string `json:"secretName"`
+	// Fingerprint is the SHA-256 fingerprint of the certificate in the Secret.
+	Fingerprint string `json:"fingerprint"`
+	// NotAfter is the expiry of the certificate in the Secret.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter"`
+	// LastRotationTime is when the operator first observed the certificate in the Secret.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime"`
+	// LastReloadTime is when the operator last asked AIS to reload the certificate from disk.
+	// +optional
+	LastReloadTime *metav1.Time `json:"lastReloadTime"`
+	// StalePods lists the pods serving a certificate other than the one in the Secret.
+	// +optional
+	StalePods []string `json:"stalePods"`
+}
+
+// ExternalEndpoint is an address exposing a proxy or target outside the K8s cluster.
+type ExternalEndpoint struct {
+	// Component is "proxy" or "target".
+	Component string `json:"component"`
+	// Pod is the target pod reached through the endpoint. It is empty for the endpoint shared by proxies.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+	// Address is the external IP or hostname, empty until a LoadBalancer is allocated one.
+	// +optional
+	Address string `json:"address,omitempty"`
+	// Ready reports whether the LoadBalancer has an address, the route is accepted by its Gateways,
+	// or the Ingress is admitted by its controller.
+	Ready bool `json:"ready"`
+}
+
+// HostCleanupPhase is the progress of a host cleanup job.
+// +kubebuilder:validation:Enum=Running;Succeeded;Failed
+type HostCleanupPhase string
+
+const (
+	HostCleanupRunning   HostCleanupPhase = "Running"
+	HostCleanupSucceeded HostCleanupPhase = "Succeeded"
+	HostCleanupFailed    HostCleanupPhase = "Failed"
+)
+
+// HostCleanupResult is the result of the cleanup job on a node.
+type HostCleanupResult struct {
+	// Node is the K8s node cleaned up by the job.
+	Node string `json:"node"`
+	// Job is the name of the cleanup Job.
+	Job string `json:"job"`
+	// Phase is Running until the job completes, fails, or exceeds its deadline.
+	Phase HostCleanupPhase `json:"phase"`
+	// Message describes why the job failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// StateMigrationStatus tracks the migration of AIS pods to a new state storage.
+// Fields are not omitted when empty, so status merge patches clear them.
+type StateMigrationStatus struct {
+	// PendingPods lists the proxy and target pods still using a previous state storage.
+	PendingPods []string `json:"pendingPods"`
+	// FailedJob is the state copy Job that failed, blocking the migration until it is deleted.
+	// +optional
+	FailedJob string `json:"failedJob"`
+	// Message describes why the state copy failed.
+	// +optional
+	Message string `json:"message"`
+}
+
+// ReleasedLocalPV is a local PV released by its claim that still holds the data of the claim.
+type ReleasedLocalPV struct {
+	// Name is the name of the PV.
+	Name string `json:"name"`
+	// Node is the node whose disk backs the PV.
+	Node string `json:"node"`
+}
+
+// TargetDrainStatus tracks a target put into maintenance while its node is drained.
+type TargetDrainStatus struct {
+	// Pod is the name of the target pod.
+	Pod string `json:"pod"`
+	// Node is the K8s node being drained.
+	Node string `json:"node"`
+	// DaemonID is the ID of the target in the cluster map.
+	DaemonID string `json:"daemonID"`
+	// RebalanceID is the ID of the rebalance moving data off the target, empty when none was started.
+	// +optional
+	RebalanceID string `json:"rebalanceID"`
+	// RebalanceCompleted reports whether the target pod can be evicted without losing access to its data.
+	RebalanceCompleted bool `json:"rebalanceCompleted"`
+	// Since is when the target was put into maintenance.
+	Since metav1.Time `json:"since"`
+}
+
+// TopologyDomainStatus reports the targets in a failure domain.
+type TopologyDomainStatus struct {
+	// Key is the node label of the domain, e.g. topology.kubernetes.io/zone.
+	Key string `json:"key"`
+	// Value is the value of the node label.
+	Value string `json:"value"`
+	// Targets is the number of target pods scheduled on nodes of the domain.
+	Targets int32 `json:"targets"`
+	// ReadyTargets is the number of those target pods that are ready.
+	ReadyTargets int32 `json:"readyTargets"`
+}
+
+// GarbageCollectionStatus reports the last garbage collection pass.
+type GarbageCollectionStatus struct {
+	// LastRunTime is when the last pass ran.
+	LastRunTime metav1.Time `json:"lastRunTime"`
+	// OrphanedResources lists the orphaned PVCs kept by the retention policy.
+	// +optional
+	OrphanedResources []OrphanedResource `json:"orphanedResources"`
+}
+
+// OrphanReason explains why a resource is no longer used
+type OrphanReason string
+
+const (
+	// OrphanReasonScaledDown marks resources of ordinals beyond the cluster size
+	OrphanReasonScaledDown OrphanReason = "ScaledDown"
+	// OrphanReasonClusterDeleted marks resources of an AIStore cluster that no longer exists in the namespace
+	OrphanReasonClusterDeleted OrphanReason = "ClusterDeleted"
+)
+
+// OrphanedResource is a resource created for an AIStore cluster that no longer uses it.
+type OrphanedResource struct {
+	// Kind of the resource.
+	Kind string `json:"kind"`
+	// Name of the resource.
+	Name string `json:"name"`
+	// Cluster is the name of the AIStore cluster the resource was created for.
+	Cluster string `json:"cluster"`
+	// Reason the resource is no longer used.
+	Reason OrphanReason `json:"reason"`
+	// Since is when a pass first found the resource orphaned.
+	Since metav1.Time `json:"since"`
+	// DeleteAfter is when the resource is deleted. Not set when orphaned resources are only reported.
+	// +optional
+	DeleteAfter *metav1.Time `json:"deleteAfter"`
+}
+
+// MultihomeAddresses lists the addresses assigned to a pod on its multihome attachments, by AIS network.
+type MultihomeAddresses struct {
+	// Pod is the name of the proxy or target pod.
+	Pod string `json:"pod"`
+	// Public lists the addresses added to the public network.
+	// +optional
+	Public []string `json:"public,omitempty"`
+	// IntraControl is the address of the intra-cluster control network.
+	// +optional
+	IntraControl string `json:"intraControl,omitempty"`
+	// IntraData is the address of the intra-cluster data network.
+	// +optional
+	IntraData string `json:"intraData,omitempty"`
+}
+
+// TrustBundleStatus describes the bundle in the `<name>-trust-bundle` ConfigMap.
+// Fields are not omitted when empty, so status merge patches clear them.
+type TrustBundleStatus struct {
+	// Hash is the SHA-256 digest of the bundle. Consumers that only load trust at startup are restarted when it changes.
+	Hash string `json:"hash"`
+	// Certificates is the number of distinct certificates in the bundle.
+	Certificates int `json:"certificates"`
+	// LastUpdateTime is when the bundle last changed.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime"`
+}
+
 type AutoScaleStatus struct {
 	// ProxyNodes is a list of nodes that have matched the node selector
 	// this is only used for auto-scaling clusters
@@ -561,6 +1240,30 @@ type AutoScaleStatus struct {
 	// this is only used for auto-scaling clusters
 	// +optional
 	ExpectedTargetNodes []string `json:"expectedTargetNodes"`
+
+	// ProxyMetric reports the number of proxies derived from spec.proxySpec.autoScale.metric.
+	// +optional
+	ProxyMetric *MetricScalingStatus `json:"proxyMetric"`
+
+	// TargetSchedules lists the schedules of spec.targetSpec.autoScale.schedules in effect.
+	// +optional
+	TargetSchedules []string `json:"targetSchedules"`
+
+	// TargetScheduleSize is the target size set by the schedules in effect.
+	// +optional
+	TargetScheduleSize *int32 `json:"targetScheduleSize"`
+}
+
+// MetricScalingStatus reports the last evaluation of a scaling metric.
+type MetricScalingStatus struct {
+	// Size is the number of pods needed for the observed load.
+	Size int32 `json:"size"`
+	// Value is the observed load.
+	Value resource.Quantity `json:"value"`
+	// LastEvaluationTime is when the metric was last read.
+	LastEvaluationTime metav1.Time `json:"lastEvaluationTime"`
+	// LastScaleTime is when the size last changed, or when the metric was first read.
+	LastScaleTime metav1.Time `json:"lastScaleTime"`
 }
 
 // ServiceSpec defines the specs of AIS Gateways
@@ -717,6 +1420,107 @@ type TargetSpec struct {
 	// +kubebuilder:default:=safe_decommission
 	// +optional
 	ScaleDownMode ScaleDownMode `json:"scaleDownMode,omitempty"`
+
+	// MountDiscovery configures the mountpaths of each target from the filesystems reported on its node by the
+	// mount-discovery agent, so nodes with different disks need no per-node mounts.
+	// Discovered mountpaths are used in addition to mounts.
+	// +optional
+	MountDiscovery *MountDiscoverySpec `json:"mountDiscovery,omitempty"`
+
+	// NodeDrain coordinates drains of the K8s nodes hosting targets with AIS maintenance mode.
+	// Targets on cordoned nodes are put into maintenance, and the target PDB blocks their eviction until the
+	// rebalance moving their data completes. The target PDB allows no other eviction while enabled, so targets
+	// are only evicted once released this way. Targets leave maintenance once their node is uncordoned.
+	// +optional
+	NodeDrain *NodeDrainSpec `json:"nodeDrain,omitempty"`
+
+	// TopologySpread spreads targets evenly across the zones and racks of their nodes, and can label their
+	// mountpaths with the failure domain of their node.
+	// +optional
+	TopologySpread *TopologySpreadSpec `json:"topologySpread,omitempty"`
+
+	// Pool is the name of the target pool this spec was derived from by ForTargetPool, empty for targetSpec itself.
+	Pool string `json:"-"`
+	// ClusterTargetSize is the number of targets of targetSpec and all pools, set by ForTargetPool.
+	ClusterTargetSize int32 `json:"-"`
+}
+
+// TargetPoolSpec defines a group of targets with its own hardware. Fields left unset are taken from targetSpec.
+type TargetPoolSpec struct {
+	// Name of the pool, appended to the names of its StatefulSet, services, PDB, ConfigMap and pods.
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=24
+	Name string `json:"name"`
+	// Size is the number of targets in the pool. A pool must be scaled to 0 before it is removed.
+	// +kubebuilder:validation:Minimum=0
+	Size int32 `json:"size"`
+	// Mounts replaces the mounts of targetSpec. Mounts with localPV are not supported in pools.
+	// +optional
+	Mounts []Mount `json:"mounts,omitempty"`
+	// Resources replaces the compute resources of targetSpec.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// NodeSelector replaces the node selector of targetSpec.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations replaces the tolerations of targetSpec.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// NodeDrainSpec selects the nodes being drained, in addition to cordoned nodes.
+type NodeDrainSpec struct {
+	// Taints are the keys of taints set on nodes about to be drained, e.g. by a cluster autoscaler.
+	// +kubebuilder:validation:items:MinLength=1
+	// +optional
+	Taints []string `json:"taints,omitempty"`
+}
+
+// TopologySpreadSpec defines the failure domains targets are spread across, as node labels.
+type TopologySpreadSpec struct {
+	// ZoneKey is the node label holding the zone of a node, e.g. topology.kubernetes.io/zone.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ZoneKey *string `json:"zoneKey,omitempty"`
+	// RackKey is the node label holding the rack of a node.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	RackKey *string `json:"rackKey,omitempty"`
+	// MaxSkew is the maximum difference between the number of targets in two domains. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxSkew *int32 `json:"maxSkew,omitempty"`
+	// WhenUnsatisfiable is `DoNotSchedule` (default) to keep targets pending rather than exceed maxSkew,
+	// or `ScheduleAnyway` to only prefer the least used domains.
+	// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+	// +optional
+	WhenUnsatisfiable *corev1.UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+	// LabelMountpaths sets the label of every mountpath without a label to the domain of the node of its target,
+	// e.g. `zone=us-east-1a,rack=r12`, so the mountpaths reported by AIS show their failure domain.
+	// Labeled mountpaths may share a filesystem and are mapped to the disks whose name contains the label,
+	// so the domain values must not be part of a disk name.
+	// +optional
+	LabelMountpaths bool `json:"labelMountpaths,omitempty"`
+}
+
+// MountDiscoverySpec selects the mountpaths of a target among the filesystems that the mount-discovery agent of
+// the ais-operator-helper image reports in the `storage.aistore.nvidia.com/mountpaths` annotation of its node.
+type MountDiscoverySpec struct {
+	// Root is the host directory under which the filesystems are mounted, e.g. /ais.
+	// Only filesystems mounted directly under root are used. Root is mounted into target pods with
+	// HostToContainer propagation, so each mountpath keeps its host path.
+	// +kubebuilder:validation:MinLength=2
+	Root string `json:"root"`
+	// Pattern is a glob matched against the name of each filesystem under root, e.g. nvme*.
+	// Defaults to every filesystem under root.
+	// +optional
+	Pattern *string `json:"pattern,omitempty"`
+	// FSTypes restricts the mountpaths to filesystems of these types, e.g. xfs.
+	// +optional
+	FSTypes []string `json:"fsTypes,omitempty"`
+	// Label is set on every discovered mountpath, as with the label of a mount.
+	// +optional
+	Label *string `json:"label,omitempty"`
 }
 
 // LogSidecarSpec defines a sidecar container to expose AIS logs to K8s
@@ -742,14 +1546,34 @@ type PDBSpec struct {
 	// +optional
 	Enabled bool `json:"enabled,omitempty"`
 
+	// Mode selects how the maximum number of unavailable targets is set.
+	// `Manual` (default) uses maxUnavailable.
+	// `Auto` derives it from the erasure coding parity slices of the cluster config, as each object remains
+	// readable with up to that many targets down, and also creates a proxy PDB keeping a majority of proxies
+	// available for primary election. Mirror copies are kept on the mountpaths of a single target, so they do
+	// not allow any target to be unavailable.
+	// +kubebuilder:validation:Enum=Manual;Auto
+	// +optional
+	Mode *PDBMode `json:"mode,omitempty"`
+
 	// MaxUnavailable specifies the maximum number of target pods that can be unavailable
 	// during voluntary disruptions. It can be represented as an absolute number (e.g. 1)
 	// or a percentage (e.g. "10%"). Setting to 0 prevents any voluntary evictions.
-	// Defaults to 0 if not specified.
+	// Defaults to 0 if not specified. In `Auto` mode, it caps the derived value.
 	// +optional
 	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
+// PDBMode selects how the target PodDisruptionBudget is computed
+type PDBMode string
+
+const (
+	// PDBModeManual uses the maxUnavailable of the PDB spec
+	PDBModeManual PDBMode = "Manual"
+	// PDBModeAuto derives maxUnavailable from the data redundancy of the cluster
+	PDBModeAuto PDBMode = "Auto"
+)
+
 type Mount struct {
 	Path string `json:"path"`
 	// +optional
@@ -759,6 +1583,12 @@ type Mount struct {
 	// +optional
 	UseHostPath *bool                 `json:"useHostPath,omitempty"` // skip PVs and mount directly on the host
 	Selector    *metav1.LabelSelector `json:"selector,omitempty"`    // selector for choosing PVs
+	// LocalPV has the operator create a local PersistentVolume for this path on every node matching the target
+	// node selector, and delete the ones left unbound on nodes that no longer match.
+	// Requires size and storageClass; the storage class should use volumeBindingMode WaitForFirstConsumer.
+	// PVs are labeled for the PVCs of this mount, and also carry the matchLabels of selector when it is set.
+	// +optional
+	LocalPV *bool `json:"localPV,omitempty"`
 	// Mountpath labels can be used for mapping mountpaths to disks, enabling disk sharing,
 	// defining storage classes for bucket-specific storage, and allowing user-defined mountpath
 	// grouping for capacity and storage class differentiation
@@ -766,15 +1596,73 @@ type Mount struct {
 }
 
 type AutoScaleConf struct {
-	// Maximum size of a given node type when auto-scaling is enabled
+	// Maximum size of a given node type when auto-scaling is enabled, or when sized by a metric or schedule
 	// +kubebuilder:validation:Minimum=1
 	// +optional
 	SizeLimit *int32 `json:"sizeLimit,omitempty"`
 
-	// Maximum number of pods that can be unavailable when auto-scaling is enabled (defaults to 0 if not specified).
+	// Maximum number of pods that can be unavailable when auto-scaling is enabled, or when sized by a metric or
+	// schedule (defaults to 0 if not specified).
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+
+	// Metric scales proxies with their load, up to the size they would have otherwise.
+	// Only supported for proxies.
+	// +optional
+	Metric *MetricScalingSpec `json:"metric,omitempty"`
+
+	// Schedules set the size during time windows, e.g. to add capacity during a training campaign.
+	// When windows overlap, the largest size applies. Only supported for targets.
+	// +optional
+	Schedules []ScalingSchedule `json:"schedules,omitempty"`
+}
+
+// DefaultScaleDownDelay is the minimum time between scaling by metric and scaling down when unset
+const DefaultScaleDownDelay = 5 * time.Minute
+
+// MetricScalingSpec sizes proxies so that each handles at most a target load, reading either the CPU usage of
+// proxy pods from the K8s metrics API or the result of a Prometheus query.
+type MetricScalingSpec struct {
+	// MinSize is the minimum number of proxies.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinSize *int32 `json:"minSize,omitempty"`
+	// CPU is the CPU usage each proxy should handle, e.g. 500m, compared with the total CPU usage of proxy pods.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+	// Prometheus reads the total load of the proxies from a Prometheus query.
+	// +optional
+	Prometheus *PrometheusMetricSpec `json:"prometheus,omitempty"`
+	// ScaleDownDelay is the minimum time between scaling proxies and scaling them down. Defaults to 5m.
+	// +optional
+	ScaleDownDelay *metav1.Duration `json:"scaleDownDelay,omitempty"`
+}
+
+// PrometheusMetricSpec is a Prometheus query returning the total load of the proxies.
+type PrometheusMetricSpec struct {
+	// URL of the Prometheus HTTP API, e.g. http://prometheus.monitoring:9090
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+	// Query returning a single value, e.g. sum(rate(ais_proxy_get_n[2m])).
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+	// TargetValue is the part of the query result each proxy should handle.
+	TargetValue resource.Quantity `json:"targetValue"`
+}
+
+// ScalingSchedule sets the size of a daemon type between two times.
+type ScalingSchedule struct {
+	// Name identifies the schedule in the status.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Start of the window.
+	Start metav1.Time `json:"start"`
+	// End of the window.
+	End metav1.Time `json:"end"`
+	// Size during the window, capped by the matching nodes when auto-scaling is enabled.
+	// +kubebuilder:validation:Minimum=1
+	Size int32 `json:"size"`
 }
 
 // +kubebuilder:object:root=true
@@ -814,6 +1702,10 @@ func (ais *AIStore) SetCondition(conditionType ClusterConditionType) {
 		msg = "Cluster is ready"
 	case ConditionReadyRebalance:
 		msg = "Cluster is ready to rebalance"
+	case ConditionOIDCIssuersDiscovered:
+		msg = "Fetched the discovery documents of all OIDC issuers"
+	case ConditionTLSCertificateServed:
+		msg = "All pods serve the current TLS certificate"
 	}
 	ais.AddOrUpdateCondition(&metav1.Condition{
 		Type:    string(conditionType),
@@ -859,6 +1751,16 @@ func (ais *AIStore) DefaultPrimaryName() string {
 }
 
 func (ais *AIStore) GetProxySize() int32 {
+	size := ais.GetProxyMaxSize()
+	if metric := ais.Status.AutoScaleStatus.ProxyMetric; ais.Spec.ProxySpec.hasMetric() && metric != nil {
+		return min(metric.Size, size)
+	}
+	return size
+}
+
+// GetProxyMaxSize returns the number of proxies without scaling by metric: the size in the spec, or the number
+// of matching nodes when auto-scaling, capped by the size limit.
+func (ais *AIStore) GetProxyMaxSize() int32 {
 	if ais.IsProxyAutoScaling() {
 		proxyNodes := int32(len(ais.Status.AutoScaleStatus.ExpectedProxyNodes))
 		autoScaleConf := ais.Spec.ProxySpec.AutoScaleConf
@@ -867,15 +1769,16 @@ func (ais *AIStore) GetProxySize() int32 {
 		}
 		return proxyNodes
 	}
+	size := *ais.Spec.Size
 	if ais.Spec.ProxySpec.Size != nil {
-		return *ais.Spec.ProxySpec.Size
+		size = *ais.Spec.ProxySpec.Size
 	}
-	return *ais.Spec.Size
+	return ais.Spec.ProxySpec.capScaledSize(size)
 }
 
 func (ais *AIStore) GetMinReadyProxies() int32 {
 	proxySize := ais.GetProxySize()
-	if ais.IsProxyAutoScaling() {
+	if ais.IsProxyDynamicScaling() {
 		return max(1, proxySize-ais.GetProxyMaxUnavailable())
 	}
 	return proxySize
@@ -886,23 +1789,85 @@ func (ais *AIStore) GetProxyMaxUnavailable() int32 {
 }
 
 func (ais *AIStore) GetTargetSize() int32 {
+	scheduled := ais.Status.AutoScaleStatus.TargetScheduleSize
+	if !ais.Spec.TargetSpec.hasSchedules() {
+		scheduled = nil
+	}
 	if ais.IsTargetAutoScaling() {
 		targetNodes := int32(len(ais.Status.AutoScaleStatus.ExpectedTargetNodes))
+		if scheduled != nil {
+			targetNodes = min(targetNodes, *scheduled)
+		}
 		autoScaleConf := ais.Spec.TargetSpec.AutoScaleConf
 		if autoScaleConf != nil && autoScaleConf.SizeLimit != nil {
 			return min(targetNodes, *autoScaleConf.SizeLimit)
 		}
 		return targetNodes
 	}
+	if scheduled != nil {
+		return ais.Spec.TargetSpec.capScaledSize(*scheduled)
+	}
 	if ais.Spec.TargetSpec.Size != nil {
 		return *ais.Spec.TargetSpec.Size
 	}
 	return *ais.Spec.Size
 }
 
+// GetTargetPoolsSize returns the number of targets in the target pools.
+func (ais *AIStore) GetTargetPoolsSize() (size int32) {
+	for i := range ais.Spec.TargetPools {
+		size += ais.Spec.TargetPools[i].Size
+	}
+	return size
+}
+
+// GetClusterTargetSize returns the number of targets of targetSpec and of all target pools, also for the view of a pool.
+func (ais *AIStore) GetClusterTargetSize() int32 {
+	if ais.Spec.TargetSpec.Pool != "" {
+		return ais.Spec.TargetSpec.ClusterTargetSize
+	}
+	return ais.GetTargetSize() + ais.GetTargetPoolsSize()
+}
+
+// ForTargetPool returns a copy of the cluster whose target spec is the one of the pool, so the target resources
+// and reconciliation of targetSpec apply to the pool. The pool has a fixed size and no other pools.
+// The copy is only meant for targets: spec.size is replaced by the pool size, so it no longer sizes proxies.
+func (ais *AIStore) ForTargetPool(pool *TargetPoolSpec) *AIStore {
+	view := ais.DeepCopy()
+	view.Spec.TargetSpec.ClusterTargetSize = ais.GetClusterTargetSize()
+	view.Spec.TargetPools = nil
+	view.Spec.Size = aisapc.Ptr(pool.Size)
+	spec := &view.Spec.TargetSpec
+	spec.Pool = pool.Name
+	spec.Size = aisapc.Ptr(pool.Size)
+	spec.AutoScaleConf = nil
+	if pool.Mounts != nil {
+		spec.Mounts = pool.Mounts
+	}
+	if pool.Resources != nil {
+		spec.Resources = *pool.Resources
+	}
+	if pool.NodeSelector != nil {
+		spec.NodeSelector = pool.NodeSelector
+	}
+	if pool.Tolerations != nil {
+		spec.Tolerations = pool.Tolerations
+	}
+	return view
+}
+
+// TargetPoolViews returns the cluster as seen by each target pool, see ForTargetPool.
+func (ais *AIStore) TargetPoolViews() []*AIStore {
+	views := make([]*AIStore, 0, len(ais.Spec.TargetPools))
+	for i := range ais.Spec.TargetPools {
+		views = append(views, ais.ForTargetPool(&ais.Spec.TargetPools[i]))
+	}
+	return views
+}
+
 func (ais *AIStore) GetMinReadyTargets() int32 {
 	targetSize := ais.GetTargetSize()
-	if ais.IsTargetAutoScaling() {
+	if ais.IsTargetDynamicScaling() {
 		return max(1, targetSize-ais.GetTargetMaxUnavailable())
 	}
 	return targetSize
@@ -921,6 +1886,35 @@ func (ais *AIStore) GetPublicNetDNSMode() PubNetDNSMode {
 	return *ais.Spec.PublicNetDNSMode
 }
 
+// IPFamilies returns the IP families of the cluster network in order of preference,
+// or nil to use the K8s cluster default.
+func (ais *AIStore) IPFamilies() []corev1.IPFamily {
+	if ais.Spec.IPFamilyPolicy == nil {
+		return nil
+	}
+	switch *ais.Spec.IPFamilyPolicy {
+	case IPFamilyPolicyIPv6:
+		return []corev1.IPFamily{corev1.IPv6Protocol}
+	case IPFamilyPolicyDualStack:
+		return []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+	default:
+		return []corev1.IPFamily{corev1.IPv4Protocol}
+	}
+}
+
+// PreferredIPFamily returns the first IP family of the cluster network, or "" to use any family.
+func (ais *AIStore) PreferredIPFamily() corev1.IPFamily {
+	if families := ais.IPFamilies(); len(families) > 0 {
+		return families[0]
+	}
+	return ""
+}
+
+// UseIPv6 reports whether AIS daemons should select IPv6 addresses for their networks.
+func (ais *AIStore) UseIPv6() bool {
+	return ais.Spec.IPFamilyPolicy != nil && *ais.Spec.IPFamilyPolicy == IPFamilyPolicyIPv6
+}
+
 func (ais *AIStore) UseNodeNameForPublicNet() bool {
 	return ais.GetPublicNetDNSMode() == PubNetDNSModeNode
 }
@@ -939,6 +1933,36 @@ func (ais *AIStore) TargetExternalAccessEnabled() bool {
 	return ais.Spec.EnableExternalLB
 }
 
+// ProxyLoadBalancerEnabled reports whether proxies are exposed through a LoadBalancer Service.
+func (ais *AIStore) ProxyLoadBalancerEnabled() bool {
+	ea := ais.Spec.ProxySpec.ExternalAccess
+	return ais.ProxyExternalAccessEnabled() && !ea.UsesRoutes() && !ea.UsesNodePort()
+}
+
+// TargetLoadBalancerEnabled reports whether targets are exposed through LoadBalancer Services.
+func (ais *AIStore) TargetLoadBalancerEnabled() bool {
+	ea := ais.Spec.TargetSpec.ExternalAccess
+	return ais.TargetExternalAccessEnabled() && !ea.UsesRoutes() && !ea.UsesNodePort()
+}
+
+// ExternalHostname renders the hostname template of the given external access spec for a pod ordinal.
+func (ais *AIStore) ExternalHostname(ea *ExternalAccessSpec, index int32) string {
+	return ais.ExternalHostnameWithIndex(ea, strconv.Itoa(int(index)))
+}
+
+// ExternalHostnameWithIndex renders the hostname template with "{index}" replaced by the given string,
+// such as a reference to an environment variable holding the ordinal.
+func (ais *AIStore) ExternalHostnameWithIndex(ea *ExternalAccessSpec, index string) string {
+	if ea == nil || ea.HostnameTemplate == nil {
+		return ""
+	}
+	return strings.NewReplacer(
+		HostnamePlaceholderCluster, ais.Name,
+		HostnamePlaceholderNamespace, ais.Namespace,
+		HostnamePlaceholderIndex, index,
+	).Replace(*ea.HostnameTemplate)
+}
+
 func (ais *AIStore) ShouldStartShutdown() bool {
 	return ais.ShouldBeShutdown() && ais.HasState(ClusterReady)
 }
@@ -952,13 +1976,31 @@ func (ais *AIStore) UseHostNetwork() bool {
 }
 
 func (ais *AIStore) ShouldIncludeClientCert() bool {
+	if ais.UseOperatorClientCertificate() {
+		return true
+	}
+	clientAuth := ais.clientAuthTLS()
+	return clientAuth != nil && tls.ClientAuthType(*clientAuth) > tls.NoClientCert
+}
+
+// RequiresClientCert returns true if AIS is configured to reject clients without a certificate
+func (ais *AIStore) RequiresClientCert() bool {
+	clientAuth := ais.clientAuthTLS()
+	if clientAuth == nil {
+		return false
+	}
+	authType := tls.ClientAuthType(*clientAuth)
+	return authType == tls.RequireAnyClientCert || authType == tls.RequireAndVerifyClientCert
+}
+
+// clientAuthTLS returns configToUpdate.net.http.client_auth_tls if set
+func (ais *AIStore) clientAuthTLS() *int {
 	if ais.Spec.ConfigToUpdate == nil ||
 		ais.Spec.ConfigToUpdate.Net == nil ||
-		ais.Spec.ConfigToUpdate.Net.HTTP == nil ||
-		ais.Spec.ConfigToUpdate.Net.HTTP.ClientAuthTLS == nil {
-		return false
+		ais.Spec.ConfigToUpdate.Net.HTTP == nil {
+		return nil
 	}
-	return tls.ClientAuthType(*ais.Spec.ConfigToUpdate.Net.HTTP.ClientAuthTLS) > tls.NoClientCert
+	return ais.Spec.ConfigToUpdate.Net.HTTP.ClientAuthTLS
 }
 
 func (ais *AIStore) IsTargetAutoScaling() bool {
@@ -985,6 +2027,18 @@ func (ais *AIStore) IsProxyAutoScaling() bool {
 	return false
 }
 
+// IsTargetDynamicScaling reports whether the number of targets follows the matching nodes or schedules, so some
+// targets may be unavailable while scaling, up to autoScale.maxUnavailable.
+func (ais *AIStore) IsTargetDynamicScaling() bool {
+	return ais.IsTargetAutoScaling() || ais.Spec.TargetSpec.hasSchedules()
+}
+
+// IsProxyDynamicScaling reports whether the number of proxies follows the matching nodes or their load, so some
+// proxies may be unavailable while scaling, up to autoScale.maxUnavailable.
+func (ais *AIStore) IsProxyDynamicScaling() bool {
+	return ais.IsProxyAutoScaling() || ais.Spec.ProxySpec.hasMetric()
+}
+
 func (ais *AIStore) GetLogSidecarImage() string {
 	if ais.Spec.LogSidecar == nil {
 		return ""
@@ -1035,6 +2089,10 @@ func (m *Mount) IsHostPath() bool {
 	return m.UseHostPath != nil && *m.UseHostPath
 }
 
+func (m *Mount) IsLocalPV() bool {
+	return m.LocalPV != nil && *m.LocalPV
+}
+
 // GetPVCName returns the associated PVC name we expect to mount for a given MountPath
 // This must follow the same convention as our existing automation for PVC creation
 func (m *Mount) GetPVCName(aisName string) string {
@@ -1109,9 +2167,56 @@ func (ais *AIStore) ShouldCleanupMetadata() bool {
 	return ais.Spec.CleanupMetadata != nil && *ais.Spec.CleanupMetadata
 }
 
-// GetAllTolerations returns tolerations for all proxy and target pods
+// ShouldCleanupData determines if user data is deleted on decommission
+func (ais *AIStore) ShouldCleanupData() bool {
+	return ais.ShouldCleanupMetadata() && ais.Spec.CleanupData != nil && *ais.Spec.CleanupData
+}
+
+// ShouldCleanupHostData determines if the cleanup jobs delete the contents of target hostPath mountpaths,
+// which requires CleanupHostData to confirm the name of the cluster.
+func (ais *AIStore) ShouldCleanupHostData() bool {
+	return ais.ShouldCleanupData() && ais.Spec.CleanupHostData != nil && *ais.Spec.CleanupHostData == ais.Name &&
+		len(ais.HostPathMounts()) > 0
+}
+
+// LocalPVMounts returns the target mounts backed by operator-created local PVs.
+func (ais *AIStore) LocalPVMounts() (mounts []Mount) {
+	for i := range ais.Spec.TargetSpec.Mounts {
+		if ais.Spec.TargetSpec.Mounts[i].IsLocalPV() {
+			mounts = append(mounts, ais.Spec.TargetSpec.Mounts[i])
+		}
+	}
+	return mounts
+}
+
+// HostPathMounts returns the target mounts using `useHostPath`, including those of target pools,
+// with each path listed once.
+func (ais *AIStore) HostPathMounts() (mounts []Mount) {
+	paths := make(map[string]struct{})
+	collect := func(specMounts []Mount) {
+		for i := range specMounts {
+			if _, ok := paths[specMounts[i].Path]; ok || !specMounts[i].IsHostPath() {
+				continue
+			}
+			paths[specMounts[i].Path] = struct{}{}
+			mounts = append(mounts, specMounts[i])
+		}
+	}
+	collect(ais.Spec.TargetSpec.Mounts)
+	// Pools without their own mounts use those of targetSpec, see ForTargetPool
+	for i := range ais.Spec.TargetPools {
+		collect(ais.Spec.TargetPools[i].Mounts)
+	}
+	return mounts
+}
+
+// GetAllTolerations returns tolerations for all proxy and target pods, including the targets of pools
 func (ais *AIStore) GetAllTolerations() []corev1.Toleration {
-	return mergeTolerationsUnique(ais.Spec.ProxySpec.Tolerations, ais.Spec.TargetSpec.Tolerations)
+	tolerations := mergeTolerationsUnique(ais.Spec.ProxySpec.Tolerations, ais.Spec.TargetSpec.Tolerations)
+	for i := range ais.Spec.TargetPools {
+		tolerations = mergeTolerationsUnique(tolerations, ais.Spec.TargetPools[i].Tolerations)
+	}
+	return tolerations
 }
 
 func mergeTolerationsUnique(a, b []corev1.Toleration) []corev1.Toleration {
@@ -1143,6 +2248,13 @@ func (ais *AIStore) TargetPDBEnabled() bool {
 	return pdb != nil && pdb.Enabled
 }
 
+// TargetPDBAuto reports whether the target PDB is derived from the data redundancy of the cluster, along with
+// a proxy PDB.
+func (ais *AIStore) TargetPDBAuto() bool {
+	pdb := ais.Spec.TargetSpec.PodDisruptionBudget
+	return ais.TargetPDBEnabled() && pdb.Mode != nil && *pdb.Mode == PDBModeAuto
+}
+
 func (ais *AIStore) GetTargetPDBMaxUnavailable() intstr.IntOrString {
 	pdb := ais.Spec.TargetSpec.PodDisruptionBudget
 	if pdb != nil && pdb.MaxUnavailable != nil {
@@ -1160,11 +2272,69 @@ func (ais *AIStore) AdminClientEnabled() bool {
 	return ais.Spec.AdminClient.Enabled == nil || *ais.Spec.AdminClient.Enabled
 }
 
+// MultihomeAttachments returns the attachments of spec.multihome, if any.
+func (ais *AIStore) MultihomeAttachments() []NetworkAttachment {
+	if ais.Spec.Multihome == nil {
+		return nil
+	}
+	return ais.Spec.Multihome.Attachments
+}
+
+// NetworkPolicyEnabled reports whether the operator manages NetworkPolicies for the cluster.
+func (ais *AIStore) NetworkPolicyEnabled() bool {
+	if ais.Spec.NetworkPolicy == nil {
+		return false
+	}
+	return ais.Spec.NetworkPolicy.Enabled == nil || *ais.Spec.NetworkPolicy.Enabled
+}
+
+// GarbageCollectionEnabled reports whether the operator periodically deletes orphaned resources of the cluster.
+func (ais *AIStore) GarbageCollectionEnabled() bool {
+	return ais.Spec.GarbageCollection != nil
+}
+
+// NodeDrainEnabled reports whether drains of nodes hosting targets are coordinated with AIS maintenance mode.
+func (ais *AIStore) NodeDrainEnabled() bool {
+	return ais.Spec.TargetSpec.NodeDrain != nil
+}
+
+// TopologyKeys returns the node labels of the failure domains targets are spread across, zone first.
+func (ais *AIStore) TopologyKeys() []string {
+	spread := ais.Spec.TargetSpec.TopologySpread
+	if spread == nil {
+		return nil
+	}
+	var keys []string
+	if spread.ZoneKey != nil {
+		keys = append(keys, *spread.ZoneKey)
+	}
+	if spread.RackKey != nil {
+		keys = append(keys, *spread.RackKey)
+	}
+	return keys
+}
+
+// TargetDrainsRebalancing returns the nodes drained while the rebalance moving data off their targets is running.
+func (ais *AIStore) TargetDrainsRebalancing() []string {
+	var nodes []string
+	for _, drain := range ais.Status.TargetDrains {
+		if !drain.RebalanceCompleted && !slices.Contains(nodes, drain.Node) {
+			nodes = append(nodes, drain.Node)
+		}
+	}
+	return nodes
+}
+
 // AdminClientName returns the name for the admin client deployment
 func (ais *AIStore) AdminClientName() string {
 	return ais.Name + "-client"
 }
 
+// AdminTokenSecretName returns the name of the Secret caching the operator's admin token
+func (ais *AIStore) AdminTokenSecretName() string {
+	return ais.Name + "-operator-token"
+}
+
 func (s *AIStoreSpec) hasAWSBackend() bool {
 	return s.AWSSecretName != nil || s.isProviderInConf(aisapc.AWS)
 }
@@ -1220,6 +2390,23 @@ func (ais *AIStore) UseHTTPS() bool {
 	return ais.Spec.ConfigToUpdate != nil && ais.Spec.ConfigToUpdate.Net != nil && ais.Spec.ConfigToUpdate.Net.HTTP != nil && ais.Spec.ConfigToUpdate.Net.HTTP.UseHTTPS != nil && *ais.Spec.ConfigToUpdate.Net.HTTP.UseHTTPS
 }
 
+// GetOIDC returns the OIDC issuer config if present
+func (ais *AIStore) GetOIDC() *OIDCAuthSpec {
+	if ais.Spec.Auth != nil {
+		return ais.Spec.Auth.OIDC
+	}
+	return nil
+}
+
+// OIDCIssuerCAConfigMap returns the name of the ConfigMap holding the OIDC issuer CA bundle,
+// set by either spec.auth.oidc.caConfigMap or spec.issuerCAConfigMap
+func (ais *AIStore) OIDCIssuerCAConfigMap() *string {
+	if oidc := ais.GetOIDC(); oidc != nil && oidc.CAConfigMap != nil {
+		return oidc.CAConfigMap
+	}
+	return ais.Spec.IssuerCAConfigMap
+}
+
 // HasTLSEnabled returns true if any TLS configuration is specified
 func (ais *AIStore) HasTLSEnabled() bool {
 	return ais.Spec.TLS != nil
@@ -1245,6 +2432,87 @@ func (ais *AIStore) UseTLSCertificate() bool {
 	return certConfig.Mode != TLSCertificateModeCSI
 }
 
+// GetDuration returns the certificate lifetime, applying the default
+func (c *TLSSelfSignedConfig) GetDuration() time.Duration {
+	if c.Duration != nil {
+		return c.Duration.Duration
+	}
+	return DefaultSelfSignedCertDuration
+}
+
+// GetRenewBefore returns how long before expiry the certificate is renewed, applying the default
+func (c *TLSSelfSignedConfig) GetRenewBefore() time.Duration {
+	if c.RenewBefore != nil {
+		return c.RenewBefore.Duration
+	}
+	return c.GetDuration() / 3
+}
+
+// UseTLSSelfSigned returns true if the operator issues the TLS certificate from its own CA
+func (ais *AIStore) UseTLSSelfSigned() bool {
+	return ais.Spec.TLS != nil && ais.Spec.TLS.SelfSigned != nil
+}
+
+// UseTrustBundle returns true if the cluster's trust is merged from spec.trust.sources
+func (ais *AIStore) UseTrustBundle() bool {
+	return ais.Spec.Trust != nil && len(ais.Spec.Trust.Sources) > 0
+}
+
+// TrustBundleConfigMapName returns the name of the ConfigMap holding the merged CA bundle
+func (ais *AIStore) TrustBundleConfigMapName() string {
+	return ais.Name + "-trust-bundle"
+}
+
+// TrustUsesSystemCAs returns true if the operator's clients trust the system CAs along with the bundle
+func (ais *AIStore) TrustUsesSystemCAs() bool {
+	return ais.Spec.Trust == nil || ais.Spec.Trust.UseSystemCAs == nil || *ais.Spec.Trust.UseSystemCAs
+}
+
+// TrustBundleHash returns the hash of the last merged CA bundle, or an empty string
+func (ais *AIStore) TrustBundleHash() string {
+	if ais.Status.TrustBundle == nil {
+		return ""
+	}
+	return ais.Status.TrustBundle.Hash
+}
+
+// UseOperatorClientCertificate returns true if the operator issues its own client certificate for mTLS with the cluster
+func (ais *AIStore) UseOperatorClientCertificate() bool {
+	return ais.Spec.TLS != nil && ais.Spec.TLS.OperatorClientCertificate != nil
+}
+
+// OperatorClientCertSecretName returns the name of the Secret holding the operator's client certificate
+func (ais *AIStore) OperatorClientCertSecretName() string {
+	return ais.Name + "-operator-client-tls"
+}
+
+// OperatorClientCertLifetime returns the lifetime and renewal window of an operator client certificate
+// issued by the operator's own CA, defaulting to those of spec.tls.selfSigned
+func (ais *AIStore) OperatorClientCertLifetime() (duration, renewBefore time.Duration) {
+	config := ais.Spec.TLS.OperatorClientCertificate
+	selfSigned := &TLSSelfSignedConfig{Duration: config.Duration, RenewBefore: config.RenewBefore}
+	if ais.UseTLSSelfSigned() {
+		if selfSigned.Duration == nil {
+			selfSigned.Duration = ais.Spec.TLS.SelfSigned.Duration
+		}
+		if selfSigned.RenewBefore == nil {
+			selfSigned.RenewBefore = ais.Spec.TLS.SelfSigned.RenewBefore
+		}
+	}
+	return selfSigned.GetDuration(), selfSigned.GetRenewBefore()
+}
+
+// TLSAdditionalDNSNames returns the user-specified extra DNS names for an operator-managed certificate
+func (ais *AIStore) TLSAdditionalDNSNames() []string {
+	if certConfig := ais.GetTLSCertificate(); certConfig != nil {
+		return certConfig.AdditionalDNSNames
+	}
+	if ais.UseTLSSelfSigned() {
+		return ais.Spec.TLS.SelfSigned.AdditionalDNSNames
+	}
+	return nil
+}
+
 func (ais *AIStore) UseTLSCSI() bool {
 	certConfig := ais.GetTLSCertificate()
 	if certConfig == nil {
@@ -1257,7 +2525,7 @@ func (ais *AIStore) GetTLSSecretName() string {
 	if ais.UseTLSSecret() {
 		return *ais.Spec.TLS.SecretName
 	}
-	if ais.UseTLSCertificate() {
+	if ais.UseTLSCertificate() || ais.UseTLSSelfSigned() {
 		return fmt.Sprintf("%s-tls", ais.Name)
 	}
 	return ""
@@ -1289,6 +2557,71 @@ func (ais *AIStore) GetRequiredAudiences() []string {
 	return *ais.Spec.ConfigToUpdate.Auth.RequiredClaims.Aud
 }
 
+func (s *DaemonSpec) hasMetric() bool {
+	return s.AutoScaleConf != nil && s.AutoScaleConf.Metric != nil
+}
+
+func (s *DaemonSpec) hasSchedules() bool {
+	return s.AutoScaleConf != nil && len(s.AutoScaleConf.Schedules) > 0
+}
+
+// capScaledSize caps the size of a fixed-size daemon type sized by a metric or schedule to the size limit
+func (s *DaemonSpec) capScaledSize(size int32) int32 {
+	if (s.hasMetric() || s.hasSchedules()) && s.AutoScaleConf.SizeLimit != nil {
+		return min(size, *s.AutoScaleConf.SizeLimit)
+	}
+	return size
+}
+
+// GetScaleDownDelay returns the minimum time between scaling by metric and scaling down.
+func (m *MetricScalingSpec) GetScaleDownDelay() time.Duration {
+	if m.ScaleDownDelay != nil {
+		return m.ScaleDownDelay.Duration
+	}
+	return DefaultScaleDownDelay
+}
+
+// GetMinSize returns the minimum number of pods when scaling by metric.
+func (m *MetricScalingSpec) GetMinSize() int32 {
+	if m.MinSize != nil {
+		return *m.MinSize
+	}
+	return 1
+}
+
+// ActiveSchedules returns the names of the schedules whose window contains the given time, and the largest size
+// they set, or nil when none does.
+func (c *AutoScaleConf) ActiveSchedules(now time.Time) ([]string, *int32) {
+	var (
+		names []string
+		size  *int32
+	)
+	for i := range c.Schedules {
+		schedule := &c.Schedules[i]
+		if now.Before(schedule.Start.Time) || !now.Before(schedule.End.Time) {
+			continue
+		}
+		names = append(names, schedule.Name)
+		if size == nil || schedule.Size > *size {
+			size = aisapc.Ptr(schedule.Size)
+		}
+	}
+	return names, size
+}
+
+// NextScheduleChange returns the first start or end of a schedule after the given time, or the zero time.
+func (c *AutoScaleConf) NextScheduleChange(now time.Time) time.Time {
+	var next time.Time
+	for i := range c.Schedules {
+		for _, t := range []time.Time{c.Schedules[i].Start.Time, c.Schedules[i].End.Time} {
+			if t.After(now) && (next.IsZero() || t.Before(next)) {
+				next = t
+			}
+		}
+	}
+	return next
+}
+
 func (s *DaemonSpec) autoScaleMaxUnavailable() int32 {
 	if s.AutoScaleConf == nil || s.AutoScaleConf.MaxUnavailable == nil {
 		return 0
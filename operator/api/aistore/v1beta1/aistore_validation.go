@@ -6,8 +6,12 @@ package v1beta1
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"path"
 	"strings"
+	"time"
 
 	aisapc "github.com/NVIDIA/aistore/api/apc"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -24,10 +28,21 @@ func (ais *AIStore) ValidateSpec(_ context.Context) (admission.Warnings, error)
 		ais.validateStateStorage,
 		ais.validateShutdownWithEmptyDir,
 		ais.validateAutoScaling,
+		ais.validateScalingPolicies,
 		ais.validateServiceSpec,
 		ais.validateCleanupConfig,
 		ais.validateTLSCertPaths,
+		ais.validateSelfSignedTLS,
+		ais.validateOperatorClientCertificate,
+		ais.validateOIDC,
 		ais.validateSafeDecommission,
+		ais.validateExternalAccess,
+		ais.validateMultihome,
+		ais.validateLocalPVs,
+		ais.validateMountDiscovery,
+		ais.validateTopologySpread,
+		ais.validateTargetPools,
+		ais.validateGarbageCollection,
 	}
 
 	// Run each validation function, aggregate warnings, exit on error
@@ -125,6 +140,57 @@ func (ais *AIStore) validateAutoScaling() (admission.Warnings, error) {
 	return warns, nil
 }
 
+// validateScalingPolicies checks the proxy scaling metric and the target schedules.
+func (ais *AIStore) validateScalingPolicies() (admission.Warnings, error) {
+	if conf := ais.Spec.ProxySpec.AutoScaleConf; conf != nil {
+		if len(conf.Schedules) > 0 {
+			return nil, errors.New("spec.proxySpec.autoScale.schedules is not supported, schedules only apply to targets")
+		}
+		if err := validateScalingMetric(conf.Metric); err != nil {
+			return nil, err
+		}
+	}
+	conf := ais.Spec.TargetSpec.AutoScaleConf
+	if conf == nil {
+		return nil, nil
+	}
+	if conf.Metric != nil {
+		return nil, errors.New("spec.targetSpec.autoScale.metric is not supported, metrics only apply to proxies")
+	}
+	names := make(map[string]bool, len(conf.Schedules))
+	for i := range conf.Schedules {
+		schedule := &conf.Schedules[i]
+		if names[schedule.Name] {
+			return nil, fmt.Errorf("spec.targetSpec.autoScale.schedules[%d]: duplicate name %q", i, schedule.Name)
+		}
+		names[schedule.Name] = true
+		if !schedule.End.After(schedule.Start.Time) {
+			return nil, fmt.Errorf("spec.targetSpec.autoScale.schedules[%d]: end must be after start", i)
+		}
+	}
+	return nil, nil
+}
+
+func validateScalingMetric(metric *MetricScalingSpec) error {
+	if metric == nil {
+		return nil
+	}
+	if (metric.CPU == nil) == (metric.Prometheus == nil) {
+		return errors.New("spec.proxySpec.autoScale.metric must set exactly one of cpu or prometheus")
+	}
+	if metric.CPU != nil && metric.CPU.Sign() <= 0 {
+		return fmt.Errorf("spec.proxySpec.autoScale.metric.cpu must be positive, got %s", metric.CPU.String())
+	}
+	if metric.Prometheus != nil && metric.Prometheus.TargetValue.Sign() <= 0 {
+		return fmt.Errorf("spec.proxySpec.autoScale.metric.prometheus.targetValue must be positive, got %s",
+			metric.Prometheus.TargetValue.String())
+	}
+	if metric.ScaleDownDelay != nil && metric.ScaleDownDelay.Duration < 0 {
+		return fmt.Errorf("spec.proxySpec.autoScale.metric.scaleDownDelay must not be negative, got %s", metric.ScaleDownDelay.Duration)
+	}
+	return nil
+}
+
 // validateSafeDecommission warns when rebalance is disabled while using scaleDownMode safe_decommission.
 func (ais *AIStore) validateSafeDecommission() (admission.Warnings, error) {
 	if !ais.Spec.TargetSpec.SafeDecommissionOnScaleDown() {
@@ -226,21 +292,102 @@ func (ais *AIStore) validateTLSCertPaths() (admission.Warnings, error) {
 	return nil, fmt.Errorf("configToUpdate.net.http.[%s] cannot be set together with spec.tls; the operator manages cert paths under /var/certs", strings.Join(conflicts, ","))
 }
 
-func (ais *AIStore) validateCleanupConfig() (admission.Warnings, error) {
-	if !ais.ShouldCleanupMetadata() {
+// validateSelfSignedTLS rejects certificate lifetimes too short for the operator to renew in time
+func (ais *AIStore) validateSelfSignedTLS() (admission.Warnings, error) {
+	if !ais.UseTLSSelfSigned() {
 		return nil, nil
 	}
-	if !ais.Spec.UsesStateHostPath() {
+	selfSigned := ais.Spec.TLS.SelfSigned
+	duration, renewBefore := selfSigned.GetDuration(), selfSigned.GetRenewBefore()
+	if duration < 24*time.Hour {
+		return nil, fmt.Errorf("spec.tls.selfSigned.duration must be at least 24h, got %s", duration)
+	}
+	if renewBefore < time.Hour || renewBefore >= duration {
+		return nil, fmt.Errorf("spec.tls.selfSigned.renewBefore must be at least 1h and shorter than duration (%s), got %s", duration, renewBefore)
+	}
+	return nil, nil
+}
+
+// validateOperatorClientCertificate requires an issuer for the operator's client certificate, either
+// cert-manager or the operator's own CA, and warns when AIS is configured not to verify client certificates
+func (ais *AIStore) validateOperatorClientCertificate() (admission.Warnings, error) {
+	if !ais.UseOperatorClientCertificate() {
 		return nil, nil
 	}
-	if len(ais.Spec.TargetSpec.NodeSelector) == 0 || len(ais.Spec.ProxySpec.NodeSelector) == 0 {
-		return admission.Warnings{
-			"cleanupMetadata is enabled with hostpath state and empty nodeSelector; host cleanup jobs will run on ALL nodes in the cluster",
-		}, nil
+	config := ais.Spec.TLS.OperatorClientCertificate
+	if config.IssuerRef == nil {
+		if !ais.UseTLSSelfSigned() {
+			return nil, errors.New("spec.tls.operatorClientCertificate.issuerRef is required unless spec.tls.selfSigned is set")
+		}
+		duration, renewBefore := ais.OperatorClientCertLifetime()
+		if duration < 24*time.Hour {
+			return nil, fmt.Errorf("spec.tls.operatorClientCertificate.duration must be at least 24h, got %s", duration)
+		}
+		if renewBefore < time.Hour || renewBefore >= duration {
+			return nil, fmt.Errorf("spec.tls.operatorClientCertificate.renewBefore must be at least 1h and shorter than duration (%s), got %s", duration, renewBefore)
+		}
+	}
+	if clientAuth := ais.clientAuthTLS(); clientAuth == nil || tls.ClientAuthType(*clientAuth) != tls.RequireAndVerifyClientCert {
+		return admission.Warnings{"AIS accepts requests without a verified client certificate unless configToUpdate.net.http.client_auth_tls is set to 4 (RequireAndVerifyClientCert)"}, nil
 	}
 	return nil, nil
 }
 
+// validateOIDC rejects specs that set spec.auth.oidc together with the config it is translated into,
+// or together with spec.issuerCAConfigMap, since one would silently override the other.
+func (ais *AIStore) validateOIDC() (admission.Warnings, error) {
+	oidc := ais.GetOIDC()
+	if oidc == nil {
+		return nil, nil
+	}
+	var conflicts []string
+	if oidc.CAConfigMap != nil && ais.Spec.IssuerCAConfigMap != nil {
+		conflicts = append(conflicts, "spec.issuerCAConfigMap")
+	}
+	if ais.Spec.ConfigToUpdate != nil && ais.Spec.ConfigToUpdate.Auth != nil {
+		auth := ais.Spec.ConfigToUpdate.Auth
+		if auth.OIDC != nil && auth.OIDC.AllowedIssuers != nil {
+			conflicts = append(conflicts, "configToUpdate.auth.oidc.allowed_iss")
+		}
+		if len(oidc.Audiences) > 0 && auth.RequiredClaims != nil && auth.RequiredClaims.Aud != nil {
+			conflicts = append(conflicts, "configToUpdate.auth.required_claims.aud")
+		}
+	}
+	if len(conflicts) == 0 {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("[%s] cannot be set together with spec.auth.oidc; the operator configures them from it", strings.Join(conflicts, ","))
+}
+
+func (ais *AIStore) validateCleanupConfig() (admission.Warnings, error) {
+	var warnings admission.Warnings
+	if confirm := ais.Spec.CleanupHostData; confirm != nil {
+		if *confirm != ais.Name {
+			return nil, fmt.Errorf("spec.cleanupHostData must be set to the cluster name %q to confirm deleting target data on hosts", ais.Name)
+		}
+		for _, mnt := range ais.HostPathMounts() {
+			if !path.IsAbs(mnt.Path) || path.Clean(mnt.Path) == "/" {
+				return nil, fmt.Errorf("spec.cleanupHostData requires absolute target mount paths other than \"/\", got %q", mnt.Path)
+			}
+		}
+		if len(ais.HostPathMounts()) == 0 {
+			warnings = append(warnings, "spec.cleanupHostData has no effect without target mounts using useHostPath")
+		}
+	}
+	if !ais.ShouldCleanupMetadata() {
+		return warnings, nil
+	}
+	if !ais.Spec.UsesStateHostPath() && !ais.ShouldCleanupHostData() {
+		return warnings, nil
+	}
+	if len(ais.Spec.TargetSpec.NodeSelector) == 0 || len(ais.Spec.ProxySpec.NodeSelector) == 0 {
+		warnings = append(warnings,
+			"cleanupMetadata is enabled with hostpath state or host data cleanup and empty nodeSelector; host cleanup jobs will run on ALL nodes in the cluster",
+		)
+	}
+	return warnings, nil
+}
+
 // errors
 func errInvalidClusterSize(size *int32) error {
 	if size == nil {
@@ -264,3 +411,216 @@ func errInvalidStateStorage() error {
 func errUndefinedNodeSelector(spec string) error {
 	return fmt.Errorf("missing nodeSelector for %s; nodeSelector is required when autoScale is enabled", spec)
 }
+
+// validateExternalAccess checks the hostname templates of routed or external-dns published external access,
+// since every target needs its own hostname while proxies share one.
+func (ais *AIStore) validateExternalAccess() (admission.Warnings, error) {
+	if err := ais.validateNodePortAccess(); err != nil {
+		return nil, err
+	}
+	components := []struct {
+		ea        *ExternalAccessSpec
+		path      string
+		wantIndex bool
+	}{
+		{ais.Spec.ProxySpec.ExternalAccess, "spec.proxySpec.externalAccess", false},
+		{ais.Spec.TargetSpec.ExternalAccess, "spec.targetSpec.externalAccess", true},
+	}
+	for _, c := range components {
+		if c.ea != nil && c.ea.ExternalDNS != nil && !c.ea.UsesExternalDNS() {
+			return nil, fmt.Errorf("%s.externalDNS is only supported with type %s", c.path, ExternalAccessLoadBalancer)
+		}
+		if !c.ea.AdvertisesHostname() {
+			continue
+		}
+		if c.ea.HostnameTemplate == nil {
+			if c.ea.UsesExternalDNS() {
+				return nil, fmt.Errorf("%s.hostnameTemplate is required with externalDNS", c.path)
+			}
+			return nil, fmt.Errorf("%s.hostnameTemplate is required with type %s", c.path, c.ea.GetType())
+		}
+		if strings.Contains(*c.ea.HostnameTemplate, HostnamePlaceholderIndex) != c.wantIndex {
+			if c.wantIndex {
+				return nil, fmt.Errorf("%s.hostnameTemplate must contain %s", c.path, HostnamePlaceholderIndex)
+			}
+			return nil, fmt.Errorf("%s.hostnameTemplate must not contain %s", c.path, HostnamePlaceholderIndex)
+		}
+		hostname := ais.ExternalHostname(c.ea, 0)
+		if msgs := validation.IsDNS1123Subdomain(hostname); len(msgs) > 0 {
+			return nil, fmt.Errorf("%s.hostnameTemplate renders invalid hostname %q: %s", c.path, hostname, strings.Join(msgs, "; "))
+		}
+		if dns := c.ea.ExternalDNS; c.ea.UsesExternalDNS() && hostname != dns.Zone && !strings.HasSuffix(hostname, "."+dns.Zone) {
+			return nil, fmt.Errorf("%s.hostnameTemplate renders hostname %q outside of externalDNS zone %q", c.path, hostname, dns.Zone)
+		}
+		// The init container only advertises the given hostname outside of the Pod DNS mode
+		if c.wantIndex && ais.GetPublicNetDNSMode() == PubNetDNSModePod {
+			return nil, fmt.Errorf("%s advertises hostnames from hostnameTemplate, which requires publicNetDNSMode %s or %s", c.path, PubNetDNSModeIP, PubNetDNSModeNode)
+		}
+		if c.ea.GetType() == ExternalAccessGateway && c.ea.Gateway == nil {
+			return nil, fmt.Errorf("%s.gateway is required with type %s", c.path, ExternalAccessGateway)
+		}
+	}
+	return nil, nil
+}
+
+// validateNodePortAccess checks NodePort external access. Targets share one node port and advertise
+// the IP of their node with it, so each node may run a single target.
+func (ais *AIStore) validateNodePortAccess() error {
+	proxyEA, targetEA := ais.Spec.ProxySpec.ExternalAccess, ais.Spec.TargetSpec.ExternalAccess
+	if proxyEA.UsesNodePort() && proxyEA.NodePort == nil {
+		return fmt.Errorf("spec.proxySpec.externalAccess.nodePort is required with type %s", ExternalAccessNodePort)
+	}
+	if !targetEA.UsesNodePort() {
+		return nil
+	}
+	if targetEA.NodePort == nil {
+		return fmt.Errorf("spec.targetSpec.externalAccess.nodePort is required with type %s", ExternalAccessNodePort)
+	}
+	if mode := ais.GetPublicNetDNSMode(); mode != PubNetDNSModeIP {
+		return fmt.Errorf("spec.targetSpec.externalAccess type %s requires publicNetDNSMode %s, got %s", ExternalAccessNodePort, PubNetDNSModeIP, mode)
+	}
+	if ais.AllowTargetSharedNodes() {
+		return fmt.Errorf("spec.targetSpec.externalAccess type %s cannot be combined with spec.targetSpec.disablePodAntiAffinity", ExternalAccessNodePort)
+	}
+	if proxyEA.UsesNodePort() && proxyEA.NodePort.Port == targetEA.NodePort.Port {
+		return fmt.Errorf("proxy and target external access must use different node ports, got %d for both", targetEA.NodePort.Port)
+	}
+	return nil
+}
+
+// validateMultihome checks spec.multihome. AIS has a single intra-control and a single intra-data address,
+// and multus does not attach networks to pods using host networking.
+func (ais *AIStore) validateMultihome() (admission.Warnings, error) {
+	attachments := ais.MultihomeAttachments()
+	if len(attachments) == 0 {
+		return nil, nil
+	}
+	if ais.Spec.NetAttachment != nil {
+		return nil, errors.New("spec.multihome cannot be combined with spec.networkAttachment")
+	}
+	if ais.UseHostNetwork() {
+		return nil, errors.New("spec.multihome cannot be combined with spec.targetSpec.hostNetwork")
+	}
+	seen := make(map[string]struct{}, len(attachments))
+	usedBy := map[NetworkUsage]string{}
+	for i := range attachments {
+		na := &attachments[i]
+		key := na.GetNamespace(ais.Namespace) + "/" + na.Name
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("spec.multihome.attachments references NetworkAttachmentDefinition %q more than once", key)
+		}
+		seen[key] = struct{}{}
+		for _, usage := range []NetworkUsage{NetworkUsageIntraControl, NetworkUsageIntraData} {
+			if !na.HasUsage(usage) {
+				continue
+			}
+			if other, ok := usedBy[usage]; ok {
+				return nil, fmt.Errorf("spec.multihome.attachments %q and %q both carry %s, only one attachment may", other, key, usage)
+			}
+			usedBy[usage] = key
+		}
+	}
+	return nil, nil
+}
+
+// validateLocalPVs checks the target mounts backed by operator-created local PVs,
+// which need a size and storage class to create the PVs and an absolute path on the host.
+func (ais *AIStore) validateLocalPVs() (admission.Warnings, error) {
+	for _, mnt := range ais.LocalPVMounts() {
+		switch {
+		case mnt.IsHostPath():
+			return nil, fmt.Errorf("target mount %q cannot set both localPV and useHostPath", mnt.Path)
+		case mnt.Size == nil || mnt.Size.IsZero():
+			return nil, fmt.Errorf("target mount %q requires size with localPV", mnt.Path)
+		case mnt.StorageClass == nil || *mnt.StorageClass == "":
+			return nil, fmt.Errorf("target mount %q requires storageClass with localPV", mnt.Path)
+		case !path.IsAbs(mnt.Path):
+			return nil, fmt.Errorf("target mount %q must be an absolute path with localPV", mnt.Path)
+		}
+	}
+	return nil, nil
+}
+
+// validateMountDiscovery checks that discovered mountpaths are confined to a host directory other than `/`,
+// which must not overlap the static target mounts.
+func (ais *AIStore) validateMountDiscovery() (admission.Warnings, error) {
+	md := ais.Spec.TargetSpec.MountDiscovery
+	if md == nil {
+		return nil, nil
+	}
+	if !path.IsAbs(md.Root) || path.Clean(md.Root) != md.Root || md.Root == "/" {
+		return nil, fmt.Errorf("mountDiscovery root %q must be a clean absolute path other than /", md.Root)
+	}
+	if md.Pattern != nil {
+		if _, err := path.Match(*md.Pattern, ""); err != nil || strings.Contains(*md.Pattern, "/") {
+			return nil, fmt.Errorf("mountDiscovery pattern %q must be a valid glob without /", *md.Pattern)
+		}
+	}
+	for i := range ais.Spec.TargetSpec.Mounts {
+		mpath := path.Clean(ais.Spec.TargetSpec.Mounts[i].Path)
+		if mpath == md.Root || strings.HasPrefix(mpath, md.Root+"/") || strings.HasPrefix(md.Root, mpath+"/") {
+			return nil, fmt.Errorf("target mount %q overlaps mountDiscovery root %q", mpath, md.Root)
+		}
+	}
+	return nil, nil
+}
+
+// validateTopologySpread requires at least one distinct, valid node label key for the failure domains
+func (ais *AIStore) validateTopologySpread() (admission.Warnings, error) {
+	spread := ais.Spec.TargetSpec.TopologySpread
+	if spread == nil {
+		return nil, nil
+	}
+	keys := ais.TopologyKeys()
+	if len(keys) == 0 {
+		return nil, errors.New("spec.targetSpec.topologySpread must set zoneKey or rackKey")
+	}
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("spec.targetSpec.topologySpread key %q is not a valid label key: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	if len(keys) == 2 && keys[0] == keys[1] {
+		return nil, fmt.Errorf("spec.targetSpec.topologySpread zoneKey and rackKey must differ, both are %q", keys[0])
+	}
+	if spread.LabelMountpaths && ais.Spec.TargetSpec.MountDiscovery != nil && ais.Spec.TargetSpec.MountDiscovery.Label != nil {
+		return admission.Warnings{"spec.targetSpec.topologySpread.labelMountpaths does not change discovered mountpaths, which are labeled with spec.targetSpec.mountDiscovery.label"}, nil
+	}
+	return nil, nil
+}
+
+// validateTargetPools checks that every pool has mounts of its own or inherited from targetSpec, and that pools
+// are not combined with the features managing resources of targetSpec alone.
+func (ais *AIStore) validateTargetPools() (admission.Warnings, error) {
+	if len(ais.Spec.TargetPools) == 0 {
+		return nil, nil
+	}
+	if ais.TargetExternalAccessEnabled() {
+		return nil, errors.New("spec.targetPools cannot be combined with external access to targets")
+	}
+	for _, view := range ais.TargetPoolViews() {
+		pool := view.Spec.TargetSpec.Pool
+		if len(view.Spec.TargetSpec.Mounts) == 0 && view.Spec.TargetSpec.MountDiscovery == nil {
+			return nil, fmt.Errorf("target pool %q has no mounts", pool)
+		}
+		if mounts := view.LocalPVMounts(); len(mounts) > 0 {
+			return nil, fmt.Errorf("target pool %q cannot use localPV mount %q", pool, mounts[0].Path)
+		}
+	}
+	return nil, nil
+}
+
+// validateGarbageCollection rejects intervals short enough for passes to load the API server
+func (ais *AIStore) validateGarbageCollection() (admission.Warnings, error) {
+	gc := ais.Spec.GarbageCollection
+	if gc == nil {
+		return nil, nil
+	}
+	if interval := gc.GetInterval(); interval < time.Minute {
+		return nil, fmt.Errorf("spec.garbageCollection.interval must be at least 1m, got %s", interval)
+	}
+	if gc.PVCRetention != nil && gc.PVCRetention.Duration < 0 {
+		return nil, fmt.Errorf("spec.garbageCollection.pvcRetention must not be negative, got %s", gc.PVCRetention.Duration)
+	}
+	return nil, nil
+}
@@ -6,9 +6,12 @@ package v1beta1
 
 import (
 	"testing"
+	"time"
 
 	aisapc "github.com/NVIDIA/aistore/api/apc"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
@@ -313,3 +316,610 @@ func TestAIStoreValidateSize(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateOIDC(t *testing.T) {
+	const issuer = "https://keycloak.example.com/realms/aistore"
+	tests := []struct {
+		name    string
+		spec    AIStoreSpec
+		wantErr string
+	}{
+		{
+			name: "no oidc",
+			spec: AIStoreSpec{IssuerCAConfigMap: aisapc.Ptr("issuer-ca")},
+		},
+		{
+			name: "oidc only",
+			spec: AIStoreSpec{Auth: &AuthSpec{OIDC: &OIDCAuthSpec{
+				Issuers: []string{issuer}, Audiences: []string{"AIStore"}, CAConfigMap: aisapc.Ptr("oidc-ca"),
+			}}},
+		},
+		{
+			name: "unrelated auth config",
+			spec: AIStoreSpec{
+				Auth: &AuthSpec{OIDC: &OIDCAuthSpec{Issuers: []string{issuer}}},
+				ConfigToUpdate: &ConfigToUpdate{Auth: &AuthConfToUpdate{
+					RequiredClaims: &RequiredClaimsConfToUpdate{Aud: &[]string{"ais"}},
+				}},
+			},
+		},
+		{
+			name: "both CA ConfigMaps",
+			spec: AIStoreSpec{
+				IssuerCAConfigMap: aisapc.Ptr("issuer-ca"),
+				Auth:              &AuthSpec{OIDC: &OIDCAuthSpec{Issuers: []string{issuer}, CAConfigMap: aisapc.Ptr("oidc-ca")}},
+			},
+			wantErr: "[spec.issuerCAConfigMap]",
+		},
+		{
+			name: "allowed issuers and audiences in configToUpdate",
+			spec: AIStoreSpec{
+				Auth: &AuthSpec{OIDC: &OIDCAuthSpec{Issuers: []string{issuer}, Audiences: []string{"AIStore"}}},
+				ConfigToUpdate: &ConfigToUpdate{Auth: &AuthConfToUpdate{
+					OIDC:           &OIDCConfToUpdate{AllowedIssuers: &[]string{issuer}},
+					RequiredClaims: &RequiredClaimsConfToUpdate{Aud: &[]string{"ais"}},
+				}},
+			},
+			wantErr: "[configToUpdate.auth.oidc.allowed_iss,configToUpdate.auth.required_claims.aud]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{Spec: tt.spec}
+			_, err := ais.validateOIDC()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestValidateSelfSignedTLS(t *testing.T) {
+	hours := func(h int) *metav1.Duration { return &metav1.Duration{Duration: time.Duration(h) * time.Hour} }
+	tests := []struct {
+		name       string
+		selfSigned *TLSSelfSignedConfig
+		wantErr    string
+	}{
+		{name: "defaults", selfSigned: &TLSSelfSignedConfig{}},
+		{name: "custom", selfSigned: &TLSSelfSignedConfig{Duration: hours(720), RenewBefore: hours(240)}},
+		{name: "duration too short", selfSigned: &TLSSelfSignedConfig{Duration: hours(12)}, wantErr: "duration must be at least 24h"},
+		{name: "renewBefore too short", selfSigned: &TLSSelfSignedConfig{RenewBefore: &metav1.Duration{Duration: time.Minute}}, wantErr: "renewBefore"},
+		{name: "renewBefore not shorter than duration", selfSigned: &TLSSelfSignedConfig{Duration: hours(48), RenewBefore: hours(48)}, wantErr: "renewBefore"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{Spec: AIStoreSpec{TLS: &TLSSpec{SelfSigned: tt.selfSigned}}}
+			_, err := ais.validateSelfSignedTLS()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestValidateOperatorClientCertificate(t *testing.T) {
+	hours := func(h int) *metav1.Duration { return &metav1.Duration{Duration: time.Duration(h) * time.Hour} }
+	issuer := &CertIssuerRef{Name: "ca-issuer"}
+	tests := []struct {
+		name        string
+		tls         *TLSSpec
+		clientAuth  *int
+		wantErr     string
+		wantWarning bool
+	}{
+		{name: "issuer", tls: &TLSSpec{SecretName: aisapc.Ptr("ais-tls"), OperatorClientCertificate: &TLSOperatorClientCertificate{IssuerRef: issuer}}, clientAuth: aisapc.Ptr(4)},
+		{name: "self-signed", tls: &TLSSpec{SelfSigned: &TLSSelfSignedConfig{}, OperatorClientCertificate: &TLSOperatorClientCertificate{}}, clientAuth: aisapc.Ptr(4)},
+		{name: "client auth unset", tls: &TLSSpec{SelfSigned: &TLSSelfSignedConfig{}, OperatorClientCertificate: &TLSOperatorClientCertificate{}}, wantWarning: true},
+		{name: "no issuer without self-signed", tls: &TLSSpec{SecretName: aisapc.Ptr("ais-tls"), OperatorClientCertificate: &TLSOperatorClientCertificate{}}, wantErr: "issuerRef is required"},
+		{name: "duration too short", tls: &TLSSpec{SelfSigned: &TLSSelfSignedConfig{}, OperatorClientCertificate: &TLSOperatorClientCertificate{Duration: hours(12)}}, wantErr: "duration must be at least 24h"},
+		{name: "renewBefore not shorter than duration", tls: &TLSSpec{SelfSigned: &TLSSelfSignedConfig{}, OperatorClientCertificate: &TLSOperatorClientCertificate{Duration: hours(48), RenewBefore: hours(48)}}, wantErr: "renewBefore"},
+		{name: "client auth relaxed", tls: &TLSSpec{SelfSigned: &TLSSelfSignedConfig{}, OperatorClientCertificate: &TLSOperatorClientCertificate{}}, clientAuth: aisapc.Ptr(0), wantWarning: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{Spec: AIStoreSpec{TLS: tt.tls}}
+			if tt.clientAuth != nil {
+				ais.Spec.ConfigToUpdate = &ConfigToUpdate{Net: &NetConfToUpdate{HTTP: &HTTPConfToUpdate{ClientAuthTLS: tt.clientAuth}}}
+			}
+			warnings, err := ais.validateOperatorClientCertificate()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tt.wantWarning {
+				g.Expect(warnings).To(HaveLen(1))
+			} else {
+				g.Expect(warnings).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateExternalAccess(t *testing.T) {
+	gateway := &GatewayAccessSpec{ParentRefs: []GatewayParentRef{{Name: "ais-gateway"}}}
+	routed := func(template string) *ExternalAccessSpec {
+		return &ExternalAccessSpec{Type: aisapc.Ptr(ExternalAccessGateway), HostnameTemplate: aisapc.Ptr(template), Gateway: gateway}
+	}
+	dns := &ExternalDNSSpec{Zone: "example.com"}
+	externalDNS := func(template string) *ExternalAccessSpec {
+		return &ExternalAccessSpec{HostnameTemplate: aisapc.Ptr(template), ExternalDNS: dns}
+	}
+	tests := []struct {
+		name    string
+		proxy   *ExternalAccessSpec
+		target  *ExternalAccessSpec
+		mode    *PubNetDNSMode
+		wantErr string
+	}{
+		{name: "load balancer", proxy: &ExternalAccessSpec{}, target: &ExternalAccessSpec{}},
+		{name: "routes", proxy: routed("{cluster}.example.com"), target: routed("{cluster}-t{index}.{namespace}.example.com")},
+		{name: "target without index", target: routed("{cluster}.example.com"), wantErr: "must contain {index}"},
+		{name: "proxy with index", proxy: routed("{cluster}-{index}.example.com"), wantErr: "must not contain {index}"},
+		{name: "missing template", proxy: &ExternalAccessSpec{Type: aisapc.Ptr(ExternalAccessIngress)}, wantErr: "hostnameTemplate is required"},
+		{name: "invalid hostname", proxy: routed("AIS_{cluster}.example.com"), wantErr: "invalid hostname"},
+		{name: "missing gateway", proxy: &ExternalAccessSpec{Type: aisapc.Ptr(ExternalAccessGateway), HostnameTemplate: aisapc.Ptr("ais.example.com")}, wantErr: "gateway is required"},
+		{name: "pod DNS mode", target: routed("{cluster}-t{index}.{namespace}.example.com"), mode: aisapc.Ptr(PubNetDNSModePod), wantErr: "requires publicNetDNSMode"},
+		{name: "external DNS", proxy: externalDNS("{cluster}.example.com"), target: externalDNS("{cluster}-t{index}.{namespace}.example.com")},
+		{name: "external DNS without template", target: &ExternalAccessSpec{ExternalDNS: dns}, wantErr: "hostnameTemplate is required with externalDNS"},
+		{name: "external DNS outside zone", proxy: externalDNS("{cluster}.example.org"), wantErr: "outside of externalDNS zone"},
+		{name: "external DNS with routes", proxy: &ExternalAccessSpec{Type: aisapc.Ptr(ExternalAccessIngress), HostnameTemplate: aisapc.Ptr("ais.example.com"), ExternalDNS: dns}, wantErr: "only supported with type LoadBalancer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+			ais.Spec.ProxySpec.ExternalAccess = tt.proxy
+			ais.Spec.TargetSpec.ExternalAccess = tt.target
+			ais.Spec.PublicNetDNSMode = tt.mode
+			_, err := ais.validateExternalAccess()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tt.target != nil && tt.target.AdvertisesHostname() {
+				g.Expect(ais.ExternalHostname(tt.target, 2)).To(Equal("ais-t2.ais-ns.example.com"))
+			}
+		})
+	}
+}
+
+func TestValidateNodePortAccess(t *testing.T) {
+	nodePort := func(port int32) *ExternalAccessSpec {
+		return &ExternalAccessSpec{Type: aisapc.Ptr(ExternalAccessNodePort), NodePort: &NodePortAccessSpec{Port: port}}
+	}
+	tests := []struct {
+		name    string
+		proxy   *ExternalAccessSpec
+		target  *ExternalAccessSpec
+		mode    *PubNetDNSMode
+		shared  bool
+		wantErr string
+	}{
+		{name: "node ports", proxy: nodePort(30080), target: nodePort(30081)},
+		{name: "target only", target: nodePort(30081)},
+		{name: "missing node port", target: &ExternalAccessSpec{Type: aisapc.Ptr(ExternalAccessNodePort)}, wantErr: "nodePort is required"},
+		{name: "node DNS mode", target: nodePort(30081), mode: aisapc.Ptr(PubNetDNSModeNode), wantErr: "requires publicNetDNSMode IP"},
+		{name: "shared nodes", target: nodePort(30081), shared: true, wantErr: "disablePodAntiAffinity"},
+		{name: "same port", proxy: nodePort(30080), target: nodePort(30080), wantErr: "different node ports"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+			ais.Spec.ProxySpec.ExternalAccess = tt.proxy
+			ais.Spec.TargetSpec.ExternalAccess = tt.target
+			ais.Spec.PublicNetDNSMode = tt.mode
+			ais.Spec.TargetSpec.DisablePodAntiAffinity = aisapc.Ptr(tt.shared)
+			_, err := ais.validateExternalAccess()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(ais.TargetLoadBalancerEnabled()).To(BeFalse())
+		})
+	}
+}
+
+func TestValidateMultihome(t *testing.T) {
+	attachment := func(name string, usage ...NetworkUsage) NetworkAttachment {
+		return NetworkAttachment{Name: name, Usage: usage}
+	}
+	tests := []struct {
+		name          string
+		attachments   []NetworkAttachment
+		netAttachment *string
+		hostNetwork   bool
+		wantErr       string
+	}{
+		{name: "public and intra", attachments: []NetworkAttachment{attachment("pub", NetworkUsagePublic), attachment("intra", NetworkUsageIntraControl, NetworkUsageIntraData)}},
+		{name: "same name in other namespace", attachments: []NetworkAttachment{attachment("net", NetworkUsagePublic), {Name: "net", Namespace: aisapc.Ptr("other"), Usage: []NetworkUsage{NetworkUsagePublic}}}},
+		{name: "legacy attachment", attachments: []NetworkAttachment{attachment("pub", NetworkUsagePublic)}, netAttachment: aisapc.Ptr("pub"), wantErr: "spec.networkAttachment"},
+		{name: "host network", attachments: []NetworkAttachment{attachment("pub", NetworkUsagePublic)}, hostNetwork: true, wantErr: "hostNetwork"},
+		{name: "duplicate", attachments: []NetworkAttachment{attachment("net", NetworkUsagePublic), {Name: "net", Namespace: aisapc.Ptr("ais-ns"), Usage: []NetworkUsage{NetworkUsageIntraData}}}, wantErr: "more than once"},
+		{name: "two intra-data", attachments: []NetworkAttachment{attachment("a", NetworkUsageIntraData), attachment("b", NetworkUsageIntraData)}, wantErr: "only one attachment"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+			ais.Spec.Multihome = &MultihomeSpec{Attachments: tt.attachments}
+			ais.Spec.NetAttachment = tt.netAttachment
+			ais.Spec.TargetSpec.HostNetwork = aisapc.Ptr(tt.hostNetwork)
+			_, err := ais.validateMultihome()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestValidateCleanupHostData(t *testing.T) {
+	tests := []struct {
+		name        string
+		confirm     string
+		mounts      []Mount
+		pools       []TargetPoolSpec
+		wantErr     string
+		wantWarning string
+	}{
+		{name: "confirmed", confirm: "ais", mounts: []Mount{{Path: "/ais/nvme0", UseHostPath: aisapc.Ptr(true)}}},
+		{name: "wrong name", confirm: "yes", mounts: []Mount{{Path: "/ais/nvme0", UseHostPath: aisapc.Ptr(true)}}, wantErr: "must be set to the cluster name"},
+		{name: "root mount", confirm: "ais", mounts: []Mount{{Path: "/", UseHostPath: aisapc.Ptr(true)}}, wantErr: "absolute target mount paths"},
+		{name: "relative mount", confirm: "ais", mounts: []Mount{{Path: "ais/nvme0", UseHostPath: aisapc.Ptr(true)}}, wantErr: "absolute target mount paths"},
+		{name: "no host mounts", confirm: "ais", mounts: []Mount{{Path: "/ais/nvme0"}}, wantWarning: "has no effect"},
+		{
+			name: "pool host mounts", confirm: "ais", mounts: []Mount{{Path: "/ais/nvme0"}},
+			pools: []TargetPoolSpec{{Name: "hdd", Size: 1, Mounts: []Mount{{Path: "/ais/hdd0", UseHostPath: aisapc.Ptr(true)}}}},
+		},
+		{
+			name: "pool root mount", confirm: "ais", mounts: []Mount{{Path: "/ais/nvme0"}},
+			pools:   []TargetPoolSpec{{Name: "hdd", Size: 1, Mounts: []Mount{{Path: "/", UseHostPath: aisapc.Ptr(true)}}}},
+			wantErr: "absolute target mount paths",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+			ais.Spec.CleanupMetadata = aisapc.Ptr(true)
+			ais.Spec.CleanupData = aisapc.Ptr(true)
+			ais.Spec.CleanupHostData = aisapc.Ptr(tt.confirm)
+			ais.Spec.TargetSpec.Mounts = tt.mounts
+			ais.Spec.TargetPools = tt.pools
+			ais.Spec.TargetSpec.NodeSelector = map[string]string{"ais": "target"}
+			ais.Spec.ProxySpec.NodeSelector = map[string]string{"ais": "proxy"}
+			warnings, err := ais.validateCleanupConfig()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tt.wantWarning != "" {
+				g.Expect(warnings).To(ContainElement(ContainSubstring(tt.wantWarning)))
+				return
+			}
+			g.Expect(warnings).To(BeEmpty())
+			g.Expect(ais.ShouldCleanupHostData()).To(BeTrue())
+		})
+	}
+}
+
+func TestValidateLocalPVs(t *testing.T) {
+	size := resource.MustParse("1Ti")
+	tests := []struct {
+		name    string
+		mount   Mount
+		wantErr string
+	}{
+		{name: "valid", mount: Mount{Path: "/ais/nvme0", Size: &size, StorageClass: aisapc.Ptr("local")}},
+		{name: "host path", mount: Mount{Path: "/ais/nvme0", Size: &size, StorageClass: aisapc.Ptr("local"), UseHostPath: aisapc.Ptr(true)}, wantErr: "both localPV and useHostPath"},
+		{name: "no size", mount: Mount{Path: "/ais/nvme0", StorageClass: aisapc.Ptr("local")}, wantErr: "requires size"},
+		{name: "no storage class", mount: Mount{Path: "/ais/nvme0", Size: &size}, wantErr: "requires storageClass"},
+		{name: "relative path", mount: Mount{Path: "ais/nvme0", Size: &size, StorageClass: aisapc.Ptr("local")}, wantErr: "absolute path"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{}
+			tt.mount.LocalPV = aisapc.Ptr(true)
+			ais.Spec.TargetSpec.Mounts = []Mount{tt.mount}
+			_, err := ais.validateLocalPVs()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestValidateMountDiscovery(t *testing.T) {
+	tests := []struct {
+		name    string
+		md      MountDiscoverySpec
+		mounts  []Mount
+		wantErr string
+	}{
+		{name: "valid", md: MountDiscoverySpec{Root: "/ais", Pattern: aisapc.Ptr("nvme*")}, mounts: []Mount{{Path: "/data/nvme0"}}},
+		{name: "relative root", md: MountDiscoverySpec{Root: "ais"}, wantErr: "clean absolute path"},
+		{name: "host root", md: MountDiscoverySpec{Root: "/"}, wantErr: "clean absolute path"},
+		{name: "unclean root", md: MountDiscoverySpec{Root: "/ais/"}, wantErr: "clean absolute path"},
+		{name: "bad pattern", md: MountDiscoverySpec{Root: "/ais", Pattern: aisapc.Ptr("nvme[")}, wantErr: "valid glob"},
+		{name: "nested pattern", md: MountDiscoverySpec{Root: "/ais", Pattern: aisapc.Ptr("*/nvme*")}, wantErr: "valid glob"},
+		{name: "mount under root", md: MountDiscoverySpec{Root: "/ais"}, mounts: []Mount{{Path: "/ais/nvme0"}}, wantErr: "overlaps"},
+		{name: "mount above root", md: MountDiscoverySpec{Root: "/ais/disks"}, mounts: []Mount{{Path: "/ais"}}, wantErr: "overlaps"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{}
+			ais.Spec.TargetSpec.MountDiscovery = &tt.md
+			ais.Spec.TargetSpec.Mounts = tt.mounts
+			_, err := ais.validateMountDiscovery()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestValidateTopologySpread(t *testing.T) {
+	tests := []struct {
+		name     string
+		spread   TopologySpreadSpec
+		md       *MountDiscoverySpec
+		wantErr  string
+		warnings int
+	}{
+		{name: "zone", spread: TopologySpreadSpec{ZoneKey: aisapc.Ptr("topology.kubernetes.io/zone")}},
+		{name: "zone and rack", spread: TopologySpreadSpec{ZoneKey: aisapc.Ptr("topology.kubernetes.io/zone"), RackKey: aisapc.Ptr("example.com/rack")}},
+		{name: "no key", spread: TopologySpreadSpec{MaxSkew: aisapc.Ptr(int32(2))}, wantErr: "must set zoneKey or rackKey"},
+		{name: "invalid key", spread: TopologySpreadSpec{RackKey: aisapc.Ptr("rack/of/node")}, wantErr: "not a valid label key"},
+		{name: "same keys", spread: TopologySpreadSpec{ZoneKey: aisapc.Ptr("rack"), RackKey: aisapc.Ptr("rack")}, wantErr: "must differ"},
+		{
+			name:     "discovered mountpaths labeled",
+			spread:   TopologySpreadSpec{ZoneKey: aisapc.Ptr("topology.kubernetes.io/zone"), LabelMountpaths: true},
+			md:       &MountDiscoverySpec{Root: "/ais", Label: aisapc.Ptr("nvme")},
+			warnings: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{}
+			ais.Spec.TargetSpec.TopologySpread = &tt.spread
+			ais.Spec.TargetSpec.MountDiscovery = tt.md
+			warnings, err := ais.validateTopologySpread()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(warnings).To(HaveLen(tt.warnings))
+		})
+	}
+}
+
+func TestValidateTargetPools(t *testing.T) {
+	tests := []struct {
+		name    string
+		mounts  []Mount
+		pools   []TargetPoolSpec
+		ea      *ExternalAccessSpec
+		wantErr string
+	}{
+		{name: "no pools"},
+		{name: "inherited mounts", mounts: []Mount{{Path: "/ais/hdd"}}, pools: []TargetPoolSpec{{Name: "nvme", Size: 2}}},
+		{name: "own mounts", pools: []TargetPoolSpec{{Name: "nvme", Size: 2, Mounts: []Mount{{Path: "/ais/nvme"}}}}},
+		{name: "no mounts", mounts: []Mount{{Path: "/ais/hdd"}}, pools: []TargetPoolSpec{{Name: "nvme", Mounts: []Mount{}}}, wantErr: "has no mounts"},
+		{
+			name:    "inherited localPV",
+			mounts:  []Mount{{Path: "/ais/hdd", LocalPV: aisapc.Ptr(true)}},
+			pools:   []TargetPoolSpec{{Name: "nvme", Size: 2}},
+			wantErr: `cannot use localPV mount "/ais/hdd"`,
+		},
+		{
+			name:    "external access",
+			mounts:  []Mount{{Path: "/ais/hdd"}},
+			pools:   []TargetPoolSpec{{Name: "nvme", Size: 2}},
+			ea:      &ExternalAccessSpec{},
+			wantErr: "cannot be combined with external access",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{}
+			ais.Spec.Size = aisapc.Ptr(int32(1))
+			ais.Spec.TargetSpec.Mounts = tt.mounts
+			ais.Spec.TargetSpec.ExternalAccess = tt.ea
+			ais.Spec.TargetPools = tt.pools
+			_, err := ais.validateTargetPools()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestForTargetPool(t *testing.T) {
+	g := NewWithT(t)
+	ais := &AIStore{}
+	ais.Spec.Size = aisapc.Ptr(int32(-1))
+	ais.Spec.TargetSpec.AutoScaleConf = &AutoScaleConf{SizeLimit: aisapc.Ptr(int32(4))}
+	ais.Spec.TargetSpec.Mounts = []Mount{{Path: "/ais/hdd"}}
+	ais.Spec.TargetSpec.NodeSelector = map[string]string{"disk": "hdd"}
+	ais.Status.AutoScaleStatus.ExpectedTargetNodes = []string{"n1", "n2", "n3"}
+	ais.Spec.TargetPools = []TargetPoolSpec{
+		{Name: "nvme", Size: 2, Mounts: []Mount{{Path: "/ais/nvme"}}, NodeSelector: map[string]string{"disk": "nvme"}},
+		{Name: "ssd", Size: 1},
+	}
+	g.Expect(ais.GetTargetPoolsSize()).To(Equal(int32(3)))
+	g.Expect(ais.GetClusterTargetSize()).To(Equal(int32(6)))
+
+	views := ais.TargetPoolViews()
+	g.Expect(views).To(HaveLen(2))
+	nvme := views[0]
+	g.Expect(nvme.Spec.TargetSpec.Pool).To(Equal("nvme"))
+	g.Expect(nvme.Spec.TargetPools).To(BeNil())
+	g.Expect(nvme.IsTargetAutoScaling()).To(BeFalse())
+	g.Expect(nvme.GetTargetSize()).To(Equal(int32(2)))
+	g.Expect(nvme.GetClusterTargetSize()).To(Equal(int32(6)))
+	g.Expect(nvme.Spec.TargetSpec.Mounts).To(Equal([]Mount{{Path: "/ais/nvme"}}))
+	g.Expect(nvme.Spec.TargetSpec.NodeSelector).To(Equal(map[string]string{"disk": "nvme"}))
+	// Unset fields are taken from targetSpec
+	g.Expect(views[1].Spec.TargetSpec.Mounts).To(Equal(ais.Spec.TargetSpec.Mounts))
+	g.Expect(views[1].Spec.TargetSpec.NodeSelector).To(Equal(ais.Spec.TargetSpec.NodeSelector))
+	// The cluster itself is unchanged
+	g.Expect(ais.Spec.TargetSpec.Pool).To(BeEmpty())
+	g.Expect(ais.GetTargetSize()).To(Equal(int32(3)))
+}
+
+func TestValidateGarbageCollection(t *testing.T) {
+	duration := func(d time.Duration) *metav1.Duration { return &metav1.Duration{Duration: d} }
+	tests := []struct {
+		name    string
+		gc      *GarbageCollectionSpec
+		wantErr string
+	}{
+		{name: "disabled"},
+		{name: "defaults", gc: &GarbageCollectionSpec{}},
+		{name: "custom", gc: &GarbageCollectionSpec{Interval: duration(10 * time.Minute), PVCRetention: duration(7 * 24 * time.Hour)}},
+		{name: "immediate PVC deletion", gc: &GarbageCollectionSpec{PVCRetention: duration(0)}},
+		{name: "interval too short", gc: &GarbageCollectionSpec{Interval: duration(time.Second)}, wantErr: "interval must be at least 1m"},
+		{name: "negative retention", gc: &GarbageCollectionSpec{PVCRetention: duration(-time.Hour)}, wantErr: "pvcRetention must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{Spec: AIStoreSpec{GarbageCollection: tt.gc}}
+			_, err := ais.validateGarbageCollection()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestValidateScalingPolicies(t *testing.T) {
+	now := time.Now()
+	window := func(name string, start, end time.Duration) ScalingSchedule {
+		return ScalingSchedule{Name: name, Start: metav1.NewTime(now.Add(start)), End: metav1.NewTime(now.Add(end)), Size: 5}
+	}
+	cpu := resource.MustParse("500m")
+	prometheus := &PrometheusMetricSpec{URL: "http://prometheus:9090", Query: "sum(rate(ais_proxy_get_n[2m]))", TargetValue: resource.MustParse("1k")}
+	tests := []struct {
+		name    string
+		proxy   *AutoScaleConf
+		target  *AutoScaleConf
+		wantErr string
+	}{
+		{name: "none"},
+		{name: "cpu metric", proxy: &AutoScaleConf{Metric: &MetricScalingSpec{CPU: &cpu}}},
+		{name: "prometheus metric", proxy: &AutoScaleConf{Metric: &MetricScalingSpec{Prometheus: prometheus}}},
+		{name: "schedules", target: &AutoScaleConf{Schedules: []ScalingSchedule{window("a", 0, time.Hour), window("b", time.Hour, 2*time.Hour)}}},
+		{
+			name:    "no metric source",
+			proxy:   &AutoScaleConf{Metric: &MetricScalingSpec{}},
+			wantErr: "exactly one of cpu or prometheus",
+		},
+		{
+			name:    "both metric sources",
+			proxy:   &AutoScaleConf{Metric: &MetricScalingSpec{CPU: &cpu, Prometheus: prometheus}},
+			wantErr: "exactly one of cpu or prometheus",
+		},
+		{
+			name:    "zero cpu",
+			proxy:   &AutoScaleConf{Metric: &MetricScalingSpec{CPU: aisapc.Ptr(resource.MustParse("0"))}},
+			wantErr: "cpu must be positive",
+		},
+		{
+			name:    "proxy schedules",
+			proxy:   &AutoScaleConf{Schedules: []ScalingSchedule{window("a", 0, time.Hour)}},
+			wantErr: "schedules only apply to targets",
+		},
+		{
+			name:    "target metric",
+			target:  &AutoScaleConf{Metric: &MetricScalingSpec{CPU: &cpu}},
+			wantErr: "metrics only apply to proxies",
+		},
+		{
+			name:    "duplicate schedule",
+			target:  &AutoScaleConf{Schedules: []ScalingSchedule{window("a", 0, time.Hour), window("a", time.Hour, 2*time.Hour)}},
+			wantErr: `duplicate name "a"`,
+		},
+		{
+			name:    "empty window",
+			target:  &AutoScaleConf{Schedules: []ScalingSchedule{window("a", time.Hour, time.Hour)}},
+			wantErr: "end must be after start",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ais := &AIStore{}
+			ais.Spec.ProxySpec.AutoScaleConf = tt.proxy
+			ais.Spec.TargetSpec.AutoScaleConf = tt.target
+			_, err := ais.validateScalingPolicies()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestScalingPolicySizes(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	ais := &AIStore{}
+	ais.Spec.Size = aisapc.Ptr(int32(4))
+	ais.Spec.ProxySpec.AutoScaleConf = &AutoScaleConf{SizeLimit: aisapc.Ptr(int32(3)), Metric: &MetricScalingSpec{CPU: aisapc.Ptr(resource.MustParse("1"))}}
+	ais.Spec.TargetSpec.AutoScaleConf = &AutoScaleConf{Schedules: []ScalingSchedule{
+		{Name: "campaign", Start: metav1.NewTime(now.Add(-time.Hour)), End: metav1.NewTime(now.Add(time.Hour)), Size: 8},
+		{Name: "burst", Start: metav1.NewTime(now.Add(-time.Minute)), End: metav1.NewTime(now.Add(time.Minute)), Size: 10},
+		{Name: "later", Start: metav1.NewTime(now.Add(30 * time.Minute)), End: metav1.NewTime(now.Add(2 * time.Hour)), Size: 12},
+	}}
+
+	// Before the first evaluation, proxies keep the size without metric, capped by the size limit
+	g.Expect(ais.GetProxyMaxSize()).To(Equal(int32(3)))
+	g.Expect(ais.GetProxySize()).To(Equal(int32(3)))
+	ais.Status.AutoScaleStatus.ProxyMetric = &MetricScalingStatus{Size: 2}
+	g.Expect(ais.GetProxySize()).To(Equal(int32(2)))
+	g.Expect(ais.IsProxyDynamicScaling()).To(BeTrue())
+
+	// The largest schedule in effect applies
+	names, size := ais.Spec.TargetSpec.AutoScaleConf.ActiveSchedules(now)
+	g.Expect(names).To(Equal([]string{"campaign", "burst"}))
+	g.Expect(*size).To(Equal(int32(10)))
+	g.Expect(ais.Spec.TargetSpec.AutoScaleConf.NextScheduleChange(now)).To(Equal(now.Add(time.Minute)))
+	g.Expect(ais.GetTargetSize()).To(Equal(int32(4)))
+	ais.Status.AutoScaleStatus.TargetSchedules, ais.Status.AutoScaleStatus.TargetScheduleSize = names, size
+	g.Expect(ais.GetTargetSize()).To(Equal(int32(10)))
+	g.Expect(ais.IsTargetDynamicScaling()).To(BeTrue())
+
+	// Auto-scaling targets are capped by the matching nodes
+	ais.Spec.TargetSpec.Size = aisapc.Ptr(int32(-1))
+	ais.Status.AutoScaleStatus.ExpectedTargetNodes = []string{"n1", "n2", "n3"}
+	g.Expect(ais.GetTargetSize()).To(Equal(int32(3)))
+}
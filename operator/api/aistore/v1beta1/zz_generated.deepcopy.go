@@ -11,7 +11,8 @@ package v1beta1
 import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	appsv1 "k8s.io/api/apps/v1"
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -131,8 +132,20 @@ func (in *AIStoreSpec) DeepCopyInto(out *AIStoreSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Multihome != nil {
+		in, out := &in.Multihome, &out.Multihome
+		*out = new(MultihomeSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ProxySpec.DeepCopyInto(&out.ProxySpec)
 	in.TargetSpec.DeepCopyInto(&out.TargetSpec)
+	if in.TargetPools != nil {
+		in, out := &in.TargetPools, &out.TargetPools
+		*out = make([]TargetPoolSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ShutdownCluster != nil {
 		in, out := &in.ShutdownCluster, &out.ShutdownCluster
 		*out = new(bool)
@@ -148,6 +161,11 @@ func (in *AIStoreSpec) DeepCopyInto(out *AIStoreSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CleanupHostData != nil {
+		in, out := &in.CleanupHostData, &out.CleanupHostData
+		*out = new(string)
+		**out = **in
+	}
 	if in.ClusterDomain != nil {
 		in, out := &in.ClusterDomain, &out.ClusterDomain
 		*out = new(string)
@@ -173,6 +191,11 @@ func (in *AIStoreSpec) DeepCopyInto(out *AIStoreSpec) {
 		*out = new(TLSSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Trust != nil {
+		in, out := &in.Trust, &out.Trust
+		*out = new(TrustSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TracingTokenSecretName != nil {
 		in, out := &in.TracingTokenSecretName, &out.TracingTokenSecretName
 		*out = new(string)
@@ -195,7 +218,7 @@ func (in *AIStoreSpec) DeepCopyInto(out *AIStoreSpec) {
 	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
+		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.PublicNetDNSMode != nil {
@@ -203,11 +226,26 @@ func (in *AIStoreSpec) DeepCopyInto(out *AIStoreSpec) {
 		*out = new(PubNetDNSMode)
 		**out = **in
 	}
+	if in.IPFamilyPolicy != nil {
+		in, out := &in.IPFamilyPolicy, &out.IPFamilyPolicy
+		*out = new(IPFamilyPolicy)
+		**out = **in
+	}
 	if in.AdminClient != nil {
 		in, out := &in.AdminClient, &out.AdminClient
 		*out = new(AdminClientSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PriorityClassName != nil {
 		in, out := &in.PriorityClassName, &out.PriorityClassName
 		*out = new(string)
@@ -229,6 +267,60 @@ func (in *AIStoreSpec) DeepCopy() *AIStoreSpec {
 func (in *AIStoreStatus) DeepCopyInto(out *AIStoreStatus) {
 	*out = *in
 	in.AutoScaleStatus.DeepCopyInto(&out.AutoScaleStatus)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustBundle != nil {
+		in, out := &in.TrustBundle, &out.TrustBundle
+		*out = new(TrustBundleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalEndpoints != nil {
+		in, out := &in.ExternalEndpoints, &out.ExternalEndpoints
+		*out = make([]ExternalEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.MultihomeAddresses != nil {
+		in, out := &in.MultihomeAddresses, &out.MultihomeAddresses
+		*out = make([]MultihomeAddresses, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HostCleanup != nil {
+		in, out := &in.HostCleanup, &out.HostCleanup
+		*out = make([]HostCleanupResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.StateMigration != nil {
+		in, out := &in.StateMigration, &out.StateMigration
+		*out = new(StateMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetDrains != nil {
+		in, out := &in.TargetDrains, &out.TargetDrains
+		*out = make([]TargetDrainStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetDomains != nil {
+		in, out := &in.TargetDomains, &out.TargetDomains
+		*out = make([]TopologyDomainStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReleasedLocalPVs != nil {
+		in, out := &in.ReleasedLocalPVs, &out.ReleasedLocalPVs
+		*out = make([]ReleasedLocalPV, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -284,7 +376,7 @@ func (in *AdminClientSpec) DeepCopyInto(out *AdminClientSpec) {
 	}
 	if in.ImagePullPolicy != nil {
 		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
-		*out = new(v1.PullPolicy)
+		*out = new(corev1.PullPolicy)
 		**out = **in
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
@@ -311,19 +403,19 @@ func (in *AdminClientSpec) DeepCopyInto(out *AdminClientSpec) {
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -489,6 +581,11 @@ func (in *AuthSpec) DeepCopyInto(out *AuthSpec) {
 		*out = new(AuthTLSConfig)
 		**out = **in
 	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCAuthSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSpec.
@@ -529,6 +626,18 @@ func (in *AutoScaleConf) DeepCopyInto(out *AutoScaleConf) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(MetricScalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]ScalingSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScaleConf.
@@ -554,6 +663,21 @@ func (in *AutoScaleStatus) DeepCopyInto(out *AutoScaleStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProxyMetric != nil {
+		in, out := &in.ProxyMetric, &out.ProxyMetric
+		*out = new(MetricScalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetSchedules != nil {
+		in, out := &in.TargetSchedules, &out.TargetSchedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetScheduleSize != nil {
+		in, out := &in.TargetScheduleSize, &out.TargetScheduleSize
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScaleStatus.
@@ -995,7 +1119,7 @@ func (in *DaemonSpec) DeepCopyInto(out *DaemonSpec) {
 	out.ServiceSpec = in.ServiceSpec
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(v1.PodSecurityContext)
+		*out = new(corev1.PodSecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Size != nil {
@@ -1025,24 +1149,24 @@ func (in *DaemonSpec) DeepCopyInto(out *DaemonSpec) {
 	in.Resources.DeepCopyInto(&out.Resources)
 	if in.Capabilities != nil {
 		in, out := &in.Capabilities, &out.Capabilities
-		*out = new(v1.SecurityContext)
+		*out = new(corev1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.AISContainerSecurityContext != nil {
 		in, out := &in.AISContainerSecurityContext, &out.AISContainerSecurityContext
-		*out = new(v1.SecurityContext)
+		*out = new(corev1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.NodeSelector != nil {
@@ -1054,7 +1178,7 @@ func (in *DaemonSpec) DeepCopyInto(out *DaemonSpec) {
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1222,6 +1346,36 @@ func (in *ExternalAccessSpec) DeepCopyInto(out *ExternalAccessSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ExternalAccessType)
+		**out = **in
+	}
+	if in.HostnameTemplate != nil {
+		in, out := &in.HostnameTemplate, &out.HostnameTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(GatewayAccessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressAccessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalDNS != nil {
+		in, out := &in.ExternalDNS, &out.ExternalDNS
+		*out = new(ExternalDNSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodePort != nil {
+		in, out := &in.NodePort, &out.NodePort
+		*out = new(NodePortAccessSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessSpec.
@@ -1234,6 +1388,41 @@ func (in *ExternalAccessSpec) DeepCopy() *ExternalAccessSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDNSSpec) DeepCopyInto(out *ExternalDNSSpec) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDNSSpec.
+func (in *ExternalDNSSpec) DeepCopy() *ExternalDNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEndpoint) DeepCopyInto(out *ExternalEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEndpoint.
+func (in *ExternalEndpoint) DeepCopy() *ExternalEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FSHCConfToUpdate) DeepCopyInto(out *FSHCConfToUpdate) {
 	*out = *in
@@ -1274,6 +1463,106 @@ func (in *FSHCConfToUpdate) DeepCopy() *FSHCConfToUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionSpec) DeepCopyInto(out *GarbageCollectionSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PVCRetention != nil {
+		in, out := &in.PVCRetention, &out.PVCRetention
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionSpec.
+func (in *GarbageCollectionSpec) DeepCopy() *GarbageCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionStatus) DeepCopyInto(out *GarbageCollectionStatus) {
+	*out = *in
+	in.LastRunTime.DeepCopyInto(&out.LastRunTime)
+	if in.OrphanedResources != nil {
+		in, out := &in.OrphanedResources, &out.OrphanedResources
+		*out = make([]OrphanedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionStatus.
+func (in *GarbageCollectionStatus) DeepCopy() *GarbageCollectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAccessSpec) DeepCopyInto(out *GatewayAccessSpec) {
+	*out = *in
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]GatewayParentRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RouteKind != nil {
+		in, out := &in.RouteKind, &out.RouteKind
+		*out = new(GatewayRouteKind)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAccessSpec.
+func (in *GatewayAccessSpec) DeepCopy() *GatewayAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayParentRef) DeepCopyInto(out *GatewayParentRef) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayParentRef.
+func (in *GatewayParentRef) DeepCopy() *GatewayParentRef {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayParentRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GetBatchConfToUpdate) DeepCopyInto(out *GetBatchConfToUpdate) {
 	*out = *in
@@ -1390,6 +1679,46 @@ func (in *HTTPConfToUpdate) DeepCopy() *HTTPConfToUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostCleanupResult) DeepCopyInto(out *HostCleanupResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostCleanupResult.
+func (in *HostCleanupResult) DeepCopy() *HostCleanupResult {
+	if in == nil {
+		return nil
+	}
+	out := new(HostCleanupResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressAccessSpec) DeepCopyInto(out *IngressAccessSpec) {
+	*out = *in
+	if in.ClassName != nil {
+		in, out := &in.ClassName, &out.ClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLSSecretName != nil {
+		in, out := &in.TLSSecretName, &out.TLSSecretName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressAccessSpec.
+func (in *IngressAccessSpec) DeepCopy() *IngressAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeepaliveConfToUpdate) DeepCopyInto(out *KeepaliveConfToUpdate) {
 	*out = *in
@@ -1540,7 +1869,7 @@ func (in *LogSidecarSpec) DeepCopyInto(out *LogSidecarSpec) {
 	*out = *in
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1600,6 +1929,59 @@ func (in *MemsysConfToUpdate) DeepCopy() *MemsysConfToUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricScalingSpec) DeepCopyInto(out *MetricScalingSpec) {
+	*out = *in
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusMetricSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDownDelay != nil {
+		in, out := &in.ScaleDownDelay, &out.ScaleDownDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricScalingSpec.
+func (in *MetricScalingSpec) DeepCopy() *MetricScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricScalingStatus) DeepCopyInto(out *MetricScalingStatus) {
+	*out = *in
+	out.Value = in.Value.DeepCopy()
+	in.LastEvaluationTime.DeepCopyInto(&out.LastEvaluationTime)
+	in.LastScaleTime.DeepCopyInto(&out.LastScaleTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricScalingStatus.
+func (in *MetricScalingStatus) DeepCopy() *MetricScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MirrorConfToUpdate) DeepCopyInto(out *MirrorConfToUpdate) {
 	*out = *in
@@ -1653,6 +2035,11 @@ func (in *Mount) DeepCopyInto(out *Mount) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LocalPV != nil {
+		in, out := &in.LocalPV, &out.LocalPV
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Label != nil {
 		in, out := &in.Label, &out.Label
 		*out = new(string)
@@ -1671,30 +2058,236 @@ func (in *Mount) DeepCopy() *Mount {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetConfToUpdate) DeepCopyInto(out *NetConfToUpdate) {
+func (in *MountDiscoverySpec) DeepCopyInto(out *MountDiscoverySpec) {
 	*out = *in
-	if in.HTTP != nil {
-		in, out := &in.HTTP, &out.HTTP
-		*out = new(HTTPConfToUpdate)
-		(*in).DeepCopyInto(*out)
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.FSTypes != nil {
+		in, out := &in.FSTypes, &out.FSTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetConfToUpdate.
-func (in *NetConfToUpdate) DeepCopy() *NetConfToUpdate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountDiscoverySpec.
+func (in *MountDiscoverySpec) DeepCopy() *MountDiscoverySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetConfToUpdate)
+	out := new(MountDiscoverySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OIDCConfToUpdate) DeepCopyInto(out *OIDCConfToUpdate) {
+func (in *MultihomeAddresses) DeepCopyInto(out *MultihomeAddresses) {
 	*out = *in
-	if in.AllowedIssuers != nil {
-		in, out := &in.AllowedIssuers, &out.AllowedIssuers
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultihomeAddresses.
+func (in *MultihomeAddresses) DeepCopy() *MultihomeAddresses {
+	if in == nil {
+		return nil
+	}
+	out := new(MultihomeAddresses)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultihomeSpec) DeepCopyInto(out *MultihomeSpec) {
+	*out = *in
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]NetworkAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultihomeSpec.
+func (in *MultihomeSpec) DeepCopy() *MultihomeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultihomeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetConfToUpdate) DeepCopyInto(out *NetConfToUpdate) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPConfToUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UseIPv6 != nil {
+		in, out := &in.UseIPv6, &out.UseIPv6
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetConfToUpdate.
+func (in *NetConfToUpdate) DeepCopy() *NetConfToUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(NetConfToUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkAttachment) DeepCopyInto(out *NetworkAttachment) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interface != nil {
+		in, out := &in.Interface, &out.Interface
+		*out = new(string)
+		**out = **in
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make([]NetworkUsage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkAttachment.
+func (in *NetworkAttachment) DeepCopy() *NetworkAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicAccess != nil {
+		in, out := &in.PublicAccess, &out.PublicAccess
+		*out = make([]v1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OperatorAccess != nil {
+		in, out := &in.OperatorAccess, &out.OperatorAccess
+		*out = make([]v1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicySpec.
+func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDrainSpec) DeepCopyInto(out *NodeDrainSpec) {
+	*out = *in
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDrainSpec.
+func (in *NodeDrainSpec) DeepCopy() *NodeDrainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDrainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePortAccessSpec) DeepCopyInto(out *NodePortAccessSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePortAccessSpec.
+func (in *NodePortAccessSpec) DeepCopy() *NodePortAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePortAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCAuthSpec) DeepCopyInto(out *OIDCAuthSpec) {
+	*out = *in
+	if in.Issuers != nil {
+		in, out := &in.Issuers, &out.Issuers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CAConfigMap != nil {
+		in, out := &in.CAConfigMap, &out.CAConfigMap
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCAuthSpec.
+func (in *OIDCAuthSpec) DeepCopy() *OIDCAuthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCAuthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfToUpdate) DeepCopyInto(out *OIDCConfToUpdate) {
+	*out = *in
+	if in.AllowedIssuers != nil {
+		in, out := &in.AllowedIssuers, &out.AllowedIssuers
 		*out = new([]string)
 		if **in != nil {
 			in, out := *in, *out
@@ -1719,9 +2312,34 @@ func (in *OIDCConfToUpdate) DeepCopy() *OIDCConfToUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedResource) DeepCopyInto(out *OrphanedResource) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	if in.DeleteAfter != nil {
+		in, out := &in.DeleteAfter, &out.DeleteAfter
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedResource.
+func (in *OrphanedResource) DeepCopy() *OrphanedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PDBSpec) DeepCopyInto(out *PDBSpec) {
 	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(PDBMode)
+		**out = **in
+	}
 	if in.MaxUnavailable != nil {
 		in, out := &in.MaxUnavailable, &out.MaxUnavailable
 		*out = new(intstr.IntOrString)
@@ -1834,6 +2452,22 @@ func (in *ProbeSpec) DeepCopy() *ProbeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusMetricSpec) DeepCopyInto(out *PrometheusMetricSpec) {
+	*out = *in
+	out.TargetValue = in.TargetValue.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusMetricSpec.
+func (in *PrometheusMetricSpec) DeepCopy() *PrometheusMetricSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusMetricSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyConfToUpdate) DeepCopyInto(out *ProxyConfToUpdate) {
 	*out = *in
@@ -1955,6 +2589,21 @@ func (in *RebalanceConfToUpdate) DeepCopy() *RebalanceConfToUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleasedLocalPV) DeepCopyInto(out *ReleasedLocalPV) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleasedLocalPV.
+func (in *ReleasedLocalPV) DeepCopy() *ReleasedLocalPV {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleasedLocalPV)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequiredClaimsConfToUpdate) DeepCopyInto(out *RequiredClaimsConfToUpdate) {
 	*out = *in
@@ -1999,6 +2648,23 @@ func (in *ResilverConfToUpdate) DeepCopy() *ResilverConfToUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingSchedule) DeepCopyInto(out *ScalingSchedule) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingSchedule.
+func (in *ScalingSchedule) DeepCopy() *ScalingSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
 	*out = *in
@@ -2088,6 +2754,26 @@ func (in *StateHostPathConfig) DeepCopy() *StateHostPathConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StateMigrationStatus) DeepCopyInto(out *StateMigrationStatus) {
+	*out = *in
+	if in.PendingPods != nil {
+		in, out := &in.PendingPods, &out.PendingPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateMigrationStatus.
+func (in *StateMigrationStatus) DeepCopy() *StateMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StateMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatePVCConfig) DeepCopyInto(out *StatePVCConfig) {
 	*out = *in
@@ -2196,6 +2882,66 @@ func (in *TLSCertificateConfig) DeepCopy() *TLSCertificateConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSOperatorClientCertificate) DeepCopyInto(out *TLSOperatorClientCertificate) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertIssuerRef)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSOperatorClientCertificate.
+func (in *TLSOperatorClientCertificate) DeepCopy() *TLSOperatorClientCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSOperatorClientCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSelfSignedConfig) DeepCopyInto(out *TLSSelfSignedConfig) {
+	*out = *in
+	if in.AdditionalDNSNames != nil {
+		in, out := &in.AdditionalDNSNames, &out.AdditionalDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSelfSignedConfig.
+func (in *TLSSelfSignedConfig) DeepCopy() *TLSSelfSignedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSelfSignedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 	*out = *in
@@ -2209,6 +2955,16 @@ func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 		*out = new(TLSCertificateConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SelfSigned != nil {
+		in, out := &in.SelfSigned, &out.SelfSigned
+		*out = new(TLSSelfSignedConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OperatorClientCertificate != nil {
+		in, out := &in.OperatorClientCertificate, &out.OperatorClientCertificate
+		*out = new(TLSOperatorClientCertificate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSpec.
@@ -2221,6 +2977,95 @@ func (in *TLSSpec) DeepCopy() *TLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSStatus) DeepCopyInto(out *TLSStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastReloadTime != nil {
+		in, out := &in.LastReloadTime, &out.LastReloadTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StalePods != nil {
+		in, out := &in.StalePods, &out.StalePods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSStatus.
+func (in *TLSStatus) DeepCopy() *TLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetDrainStatus) DeepCopyInto(out *TargetDrainStatus) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetDrainStatus.
+func (in *TargetDrainStatus) DeepCopy() *TargetDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetPoolSpec) DeepCopyInto(out *TargetPoolSpec) {
+	*out = *in
+	if in.Mounts != nil {
+		in, out := &in.Mounts, &out.Mounts
+		*out = make([]Mount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetPoolSpec.
+func (in *TargetPoolSpec) DeepCopy() *TargetPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSpec) DeepCopyInto(out *TargetSpec) {
 	*out = *in
@@ -2247,6 +3092,21 @@ func (in *TargetSpec) DeepCopyInto(out *TargetSpec) {
 		*out = new(PDBSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MountDiscovery != nil {
+		in, out := &in.MountDiscovery, &out.MountDiscovery
+		*out = new(MountDiscoverySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeDrain != nil {
+		in, out := &in.NodeDrain, &out.NodeDrain
+		*out = new(NodeDrainSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpread != nil {
+		in, out := &in.TopologySpread, &out.TopologySpread
+		*out = new(TopologySpreadSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSpec.
@@ -2344,6 +3204,56 @@ func (in *TokenExchangeAuth) DeepCopy() *TokenExchangeAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDomainStatus) DeepCopyInto(out *TopologyDomainStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDomainStatus.
+func (in *TopologyDomainStatus) DeepCopy() *TopologyDomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySpreadSpec) DeepCopyInto(out *TopologySpreadSpec) {
+	*out = *in
+	if in.ZoneKey != nil {
+		in, out := &in.ZoneKey, &out.ZoneKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.RackKey != nil {
+		in, out := &in.RackKey, &out.RackKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSkew != nil {
+		in, out := &in.MaxSkew, &out.MaxSkew
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WhenUnsatisfiable != nil {
+		in, out := &in.WhenUnsatisfiable, &out.WhenUnsatisfiable
+		*out = new(corev1.UnsatisfiableConstraintAction)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpreadSpec.
+func (in *TopologySpreadSpec) DeepCopy() *TopologySpreadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySpreadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TraceExporterAuthConfToUpdate) DeepCopyInto(out *TraceExporterAuthConfToUpdate) {
 	*out = *in
@@ -2466,6 +3376,107 @@ func (in *TransportConfToUpdate) DeepCopy() *TransportConfToUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustBundleStatus) DeepCopyInto(out *TrustBundleStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustBundleStatus.
+func (in *TrustBundleStatus) DeepCopy() *TrustBundleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustBundleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustSource) DeepCopyInto(out *TrustSource) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(TrustSourceKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(TrustSourceKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustSource.
+func (in *TrustSource) DeepCopy() *TrustSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustSourceKeySelector) DeepCopyInto(out *TrustSourceKeySelector) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Optional != nil {
+		in, out := &in.Optional, &out.Optional
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustSourceKeySelector.
+func (in *TrustSourceKeySelector) DeepCopy() *TrustSourceKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustSourceKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustSpec) DeepCopyInto(out *TrustSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]TrustSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UseSystemCAs != nil {
+		in, out := &in.UseSystemCAs, &out.UseSystemCAs
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustSpec.
+func (in *TrustSpec) DeepCopy() *TrustSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UsernamePasswordAuth) DeepCopyInto(out *UsernamePasswordAuth) {
 	*out = *in
@@ -15,9 +15,11 @@ import (
 	"strings"
 	"time"
 
+	aisapc "github.com/NVIDIA/aistore/api/apc"
 	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	authcontroller "github.com/ais-operator/internal/controller/aisauth"
+	authprofilecontroller "github.com/ais-operator/internal/controller/aisauthprofile"
 	aiscontroller "github.com/ais-operator/internal/controller/aistore"
 	"github.com/ais-operator/internal/opinfo"
 	"github.com/ais-operator/internal/resources/aistore/cmn"
@@ -32,6 +34,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/client-go/rest"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
@@ -42,6 +45,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 var (
@@ -57,6 +61,8 @@ func init() {
 	utilruntime.Must(aisv1.AddToScheme(scheme))
 	utilruntime.Must(authv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(certmanagerv1.AddToScheme(scheme))
+	utilruntime.Must(gwapiv1.Install(scheme))
+	utilruntime.Must(metricsv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -67,6 +73,8 @@ func main() {
 	var webhookCertPath, webhookCertName, webhookCertKey string
 	var aisClientCertPath string
 	var aisClientCertPerCluster bool
+	var cacheAdminTokens bool
+	var authProfileCheckInterval time.Duration
 	var enableLeaderElection bool
 	var probeAddr string
 	var secureMetrics bool
@@ -89,6 +97,10 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.StringVar(&aisClientCertPath, "ais-client-cert-path", "/etc/operator/tls", "The directory that contains the AIS client certificate and key.")
 	flag.BoolVar(&aisClientCertPerCluster, "ais-client-cert-per-cluster", false, "If true, use namespace and cluster name from AIS spec as subdirectories to ais-client-cert-path.")
+	flag.BoolVar(&cacheAdminTokens, "cache-admin-tokens", false,
+		"If true, store each AIS cluster's admin token in an operator-owned Secret so it is reused across operator restarts and leader changes.")
+	flag.DurationVar(&authProfileCheckInterval, "auth-profile-check-interval", authprofilecontroller.DefaultCheckInterval,
+		"How often the operator checks the auth provider of each AIStoreAuthProfile.")
 
 	opts := zap.Options{
 		Development: true,
@@ -178,6 +190,10 @@ func main() {
 				&corev1.Pod{}: {
 					Label: labels.SelectorFromSet(labels.Set{cmn.LabelManagedBy: cmn.LabelManagedByValue}),
 				},
+				// Only local PVs created for AIS targets are watched, not every PV in the cluster.
+				&corev1.PersistentVolume{}: {
+					Label: labels.SelectorFromSet(labels.Set{cmn.LabelComponentPrefixed: aisapc.Target}),
+				},
 			},
 		},
 		Scheme:                 scheme,
@@ -208,6 +224,7 @@ func main() {
 			CertPath:       aisClientCertPath,
 			CertPerCluster: aisClientCertPerCluster,
 		},
+		cacheAdminTokens,
 		ctrl.Log.WithName("controllers").WithName("AIStore"),
 	).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AIStore")
@@ -219,6 +236,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = authprofilecontroller.NewReconcilerFromMgr(
+		mgr, authProfileCheckInterval, ctrl.Log.WithName("controllers").WithName("AIStoreAuthProfile"),
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AIStoreAuthProfile")
+		os.Exit(1)
+	}
 	if err = authwebhookv1alpha1.SetupAIStoreAuthProfileWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "AIStoreAuthProfile")
 		os.Exit(1)
@@ -7,6 +7,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"time"
 
@@ -24,8 +25,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -114,6 +117,14 @@ func (c *K8sClient) ListReadyPods(ctx context.Context, ais *aisv1.AIStore, label
 	return readyPods, nil
 }
 
+// ListPodMetrics reads the resource usage of the cluster's pods with the given labels from the metrics API,
+// which is not cached.
+func (c *K8sClient) ListPodMetrics(ctx context.Context, ais *aisv1.AIStore, labels map[string]string) (*metricsv1beta1.PodMetricsList, error) {
+	metricsList := &metricsv1beta1.PodMetricsList{}
+	err := c.apiReader.List(ctx, metricsList, client.InNamespace(ais.Namespace), client.MatchingLabels(labels))
+	return metricsList, err
+}
+
 func (c *K8sClient) ListJobsInNamespace(ctx context.Context, namespace string) (*batchv1.JobList, error) {
 	jobList := &batchv1.JobList{}
 	err := c.client.List(ctx, jobList, client.InNamespace(namespace))
@@ -154,12 +165,28 @@ func (c *K8sClient) GetSecret(ctx context.Context, name types.NamespacedName) (*
 	return getResource[*corev1.Secret](c.client, ctx, name)
 }
 
+// GetSecretDirect bypasses the informer cache, reading straight from the API server.
+// Use where acting on a stale Secret would be unsafe (e.g. regenerating a CA that already exists).
+func (c *K8sClient) GetSecretDirect(ctx context.Context, name types.NamespacedName) (*corev1.Secret, error) {
+	return getResource[*corev1.Secret](c.apiReader, ctx, name)
+}
+
 func (c *K8sClient) GetPod(ctx context.Context, name types.NamespacedName) (*corev1.Pod, error) {
 	return getResource[*corev1.Pod](c.client, ctx, name)
 }
 
 func (c *K8sClient) Status() client.StatusWriter { return c.client.Status() }
 
+// IsKindServed reports whether the API server serves the given kind, for optional APIs whose
+// CRDs may not be installed (e.g. VolumeSnapshot).
+func (c *K8sClient) IsKindServed(gvk schema.GroupVersionKind) (bool, error) {
+	_, err := c.client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 // ListNodesMatchingSelector returns a NodeList matching the given node selector
 func (c *K8sClient) ListNodesMatchingSelector(ctx context.Context, nodeSelector map[string]string) (*corev1.NodeList, error) {
 	nodeList := &corev1.NodeList{}
@@ -169,13 +196,19 @@ func (c *K8sClient) ListNodesMatchingSelector(ctx context.Context, nodeSelector
 }
 
 // ListNodesMatchingAISSelectors returns the union of node names matching
-// the proxy or target NodeSelector specs. A nil/empty selector matches all nodes.
+// the proxy, target or target pool NodeSelector specs. A nil/empty selector matches all nodes.
 func (c *K8sClient) ListNodesMatchingAISSelectors(ctx context.Context, ais *aisv1.AIStore) ([]string, error) {
 	nodeNames := sets.New[string]()
-	for _, selector := range []map[string]string{
+	selectors := []map[string]string{
 		ais.Spec.TargetSpec.NodeSelector,
 		ais.Spec.ProxySpec.NodeSelector,
-	} {
+	}
+	for i := range ais.Spec.TargetPools {
+		if selector := ais.Spec.TargetPools[i].NodeSelector; selector != nil {
+			selectors = append(selectors, selector)
+		}
+	}
+	for _, selector := range selectors {
 		nodes, err := c.ListNodesMatchingSelector(ctx, selector)
 		if err != nil {
 			return nil, err
@@ -190,9 +223,15 @@ func (c *K8sClient) ListNodesMatchingAISSelectors(ctx context.Context, ais *aisv
 // NodePrimaryIP returns the node's InternalIP, falling back to ExternalIP.
 // Returns "" when neither is set.
 func NodePrimaryIP(node *corev1.Node) string {
+	return NodeIP(node, "")
+}
+
+// NodeIP returns the node's InternalIP of the given IP family, falling back to ExternalIP.
+// An empty family matches addresses of any family. Returns "" when neither is set.
+func NodeIP(node *corev1.Node, family corev1.IPFamily) string {
 	for _, t := range []corev1.NodeAddressType{corev1.NodeInternalIP, corev1.NodeExternalIP} {
 		for _, addr := range node.Status.Addresses {
-			if addr.Type == t && addr.Address != "" {
+			if addr.Type == t && addr.Address != "" && (family == "" || IPFamilyOf(addr.Address) == family) {
 				return addr.Address
 			}
 		}
@@ -200,6 +239,31 @@ func NodePrimaryIP(node *corev1.Node) string {
 	return ""
 }
 
+// PodHostIP returns the host IP of the pod of the given IP family, falling back to its primary host IP.
+func PodHostIP(pod *corev1.Pod, family corev1.IPFamily) string {
+	if family != "" {
+		for _, hostIP := range pod.Status.HostIPs {
+			if IPFamilyOf(hostIP.IP) == family {
+				return hostIP.IP
+			}
+		}
+	}
+	return pod.Status.HostIP
+}
+
+// IPFamilyOf returns the IP family of an address, or "" if it is not an IP address.
+func IPFamilyOf(addr string) corev1.IPFamily {
+	ip := net.ParseIP(addr)
+	switch {
+	case ip == nil:
+		return ""
+	case ip.To4() != nil:
+		return corev1.IPv4Protocol
+	default:
+		return corev1.IPv6Protocol
+	}
+}
+
 //////////////////////////////////////
 //      Create/Update resources     //
 //////////////////////////////////////
@@ -277,4 +277,27 @@ var _ = Describe("K8sClient", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("IP family selection", func() {
+		node := &corev1.Node{Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+			{Type: corev1.NodeHostName, Address: "node-1"},
+			{Type: corev1.NodeExternalIP, Address: "2001:db8::10"},
+			{Type: corev1.NodeInternalIP, Address: "10.0.0.10"},
+		}}}
+
+		It("should prefer the InternalIP of the requested family", func() {
+			Expect(NodePrimaryIP(node)).To(Equal("10.0.0.10"))
+			Expect(NodeIP(node, corev1.IPv4Protocol)).To(Equal("10.0.0.10"))
+			Expect(NodeIP(node, corev1.IPv6Protocol)).To(Equal("2001:db8::10"))
+		})
+
+		It("should select the pod host IP of the requested family", func() {
+			pod := &corev1.Pod{Status: corev1.PodStatus{
+				HostIP:  "10.0.0.10",
+				HostIPs: []corev1.HostIP{{IP: "10.0.0.10"}, {IP: "fd00::10"}},
+			}}
+			Expect(PodHostIP(pod, corev1.IPv6Protocol)).To(Equal("fd00::10"))
+			Expect(PodHostIP(pod, "")).To(Equal("10.0.0.10"))
+		})
+	})
 })
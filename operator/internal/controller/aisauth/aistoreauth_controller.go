@@ -13,6 +13,7 @@ import (
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,6 +53,8 @@ func NewReconcilerFromMgr(mgr manager.Manager, logger logr.Logger) *Reconciler {
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create;delete
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := r.log.WithValues("namespace", req.Namespace, "name", req.Name)
@@ -83,7 +86,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	base := authn.DeepCopy()
-	reconcileErr := r.reconcileResources(ctx, authn)
+	result, reconcileErr := r.reconcileResources(ctx, authn)
 	if statusErr := r.updateStatus(ctx, base, authn); statusErr != nil {
 		if reconcileErr == nil {
 			return reconcile.Result{}, statusErr
@@ -96,43 +99,51 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	logger.V(1).Info("Reconciled AIStoreAuth")
-	return reconcile.Result{}, nil
+	return result, nil
 }
 
-// reconcileResources converges every operator-managed child object.
-func (r *Reconciler) reconcileResources(ctx context.Context, authn *authv1alpha1.AIStoreAuth) error {
+// reconcileResources converges every operator-managed child object. The returned result
+// requeues for the next scheduled backup.
+func (r *Reconciler) reconcileResources(ctx context.Context, authn *authv1alpha1.AIStoreAuth) (reconcile.Result, error) {
 	logger := logf.FromContext(ctx)
 	if err := r.reconcileConfigMap(ctx, authn); err != nil {
 		msg := "Failed to reconcile ConfigMap"
 		logger.Error(err, msg)
 		r.recordError(authn, EventReasonConfigMapFailed, msg)
-		return err
+		return reconcile.Result{}, err
 	}
 	if err := r.reconcilePersistence(ctx, authn); err != nil {
 		msg := "Failed to reconcile PersistentVolumeClaim"
 		logger.Error(err, msg)
 		r.recordError(authn, EventReasonPVCFailed, msg)
-		return err
+		return reconcile.Result{}, err
 	}
 	if err := r.reconcileServices(ctx, authn); err != nil {
 		msg := "Failed to reconcile Services"
 		logger.Error(err, msg)
 		r.recordError(authn, EventReasonServicesFailed, msg)
-		return err
+		return reconcile.Result{}, err
 	}
 	if err := r.reconcileTLSCertificate(ctx, authn); err != nil {
 		msg := "Failed to reconcile TLS Certificate"
 		logger.Error(err, msg)
 		r.recordError(authn, EventReasonCertificateFailed, msg)
-		return err
+		return reconcile.Result{}, err
 	}
 	if err := r.reconcileDeployment(ctx, authn); err != nil {
 		msg := "Failed to reconcile Deployment"
 		logger.Error(err, msg)
 		r.recordError(authn, EventReasonDeploymentFailed, msg)
-		return err
+		return reconcile.Result{}, err
 	}
-	return nil
+	requeueAfter, err := r.reconcileBackup(ctx, authn)
+	if err != nil {
+		msg := "Failed to reconcile backups"
+		logger.Error(err, msg)
+		r.recordError(authn, EventReasonBackupFailed, msg)
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // recordError records a failed apply and sets the ready condition false.
@@ -173,13 +184,19 @@ func (r *Reconciler) reconcilePersistence(ctx context.Context, authn *authv1alph
 
 // SetupWithManager registers the reconciler with the manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&authv1alpha1.AIStoreAuth{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
-		Owns(&certmanagerv1.Certificate{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
-		Named("aistoreauth").
-		Complete(r)
+		Owns(&batchv1.Job{})
+	served, err := r.client.IsKindServed(certmanagerv1.SchemeGroupVersion.WithKind(certmanagerv1.CertificateKind))
+	if err != nil {
+		return err
+	}
+	if served {
+		b = b.Owns(&certmanagerv1.Certificate{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+	}
+	return b.Named("aistoreauth").Complete(r)
 }
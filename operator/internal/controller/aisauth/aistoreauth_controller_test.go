@@ -17,6 +17,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -290,6 +291,7 @@ func newTestScheme() *runtime.Scheme {
 	scheme := runtime.NewScheme()
 	Expect(authv1alpha1.AddToScheme(scheme)).To(Succeed())
 	Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+	Expect(batchv1.AddToScheme(scheme)).To(Succeed())
 	Expect(corev1.AddToScheme(scheme)).To(Succeed())
 	Expect(certmanagerv1.AddToScheme(scheme)).To(Succeed())
 	return scheme
@@ -323,7 +325,7 @@ func newTestReconciler(
 ) (*Reconciler, *events.FakeRecorder) {
 	builder := fake.NewClientBuilder().
 		WithScheme(scheme).
-		WithStatusSubresource(authn, &appsv1.Deployment{}).
+		WithStatusSubresource(authn, &appsv1.Deployment{}, &batchv1.Job{}).
 		WithObjects(authn)
 	for _, funcs := range interceptors {
 		builder = builder.WithInterceptorFuncs(funcs)
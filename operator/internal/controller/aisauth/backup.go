@@ -0,0 +1,336 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aisauth
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
+	authnres "github.com/ais-operator/internal/resources/aisauth"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// BackupSucceeded condition messages.
+const (
+	msgBackupCompleted   = "The latest AuthN database backup is ready to use"
+	msgBackupInProgress  = "An AuthN database backup is in progress"
+	msgBackupUnavailable = "VolumeSnapshots are not served by the cluster and no backup PVC is configured"
+)
+
+// snapshotPollInterval is how often a pending VolumeSnapshot is checked. Snapshots are an optional
+// API and are not watched, whereas backup Jobs are owned and trigger reconciles on their own.
+const snapshotPollInterval = 15 * time.Second
+
+// backupRetryDelay is how long after a failed backup the next attempt is made, unless the interval
+// is shorter, so a transient failure does not leave the database without a backup for a full interval.
+const backupRetryDelay = 5 * time.Minute
+
+type backupState int
+
+const (
+	backupPending backupState = iota
+	backupSucceeded
+	backupFailed
+)
+
+// backupAttempt is a backup Job or VolumeSnapshot, normalized across both methods.
+type backupAttempt struct {
+	obj     client.Object
+	name    string
+	time    time.Time
+	state   backupState
+	message string
+}
+
+// reconcileBackup takes a backup when one is due, deletes backups beyond retention, and publishes
+// the retained ones in status. It returns how long until the backups need another look.
+func (r *Reconciler) reconcileBackup(ctx context.Context, authn *authv1alpha1.AIStoreAuth) (time.Duration, error) {
+	spec := authn.Spec.Backup
+	if spec == nil {
+		authn.Status.Backups = nil
+		meta.RemoveStatusCondition(&authn.Status.Conditions, string(authv1alpha1.ConditionBackupSucceeded))
+		return 0, nil
+	}
+
+	method, err := r.backupMethod(authn)
+	if err != nil {
+		return 0, err
+	}
+	if method == "" {
+		authn.Status.Backups = nil
+		setBackupCondition(authn, metav1.ConditionFalse, authv1alpha1.ReasonBackupUnavailable, msgBackupUnavailable)
+		return 0, nil
+	}
+
+	attempts, err := r.listBackups(ctx, authn, method)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	if isBackupDue(attempts, spec.Interval.Duration, now) {
+		attempt, err := r.takeBackup(ctx, authn, method, now)
+		if err != nil {
+			return 0, err
+		}
+		attempts = slices.Insert(attempts, 0, attempt)
+	}
+
+	if err := r.pruneBackups(ctx, authn, attempts); err != nil {
+		return 0, err
+	}
+	r.setBackupStatus(authn, method, attempts)
+
+	latest := attempts[0]
+	if latest.state == backupPending {
+		if method == authv1alpha1.BackupMethodVolumeSnapshot {
+			return snapshotPollInterval, nil
+		}
+		return 0, nil
+	}
+	return nextBackupTime(&latest, spec.Interval.Duration).Sub(now), nil
+}
+
+// backupMethod prefers VolumeSnapshots when configured and served, and falls back to PVC copies.
+// It returns an empty method when neither can be used.
+func (r *Reconciler) backupMethod(authn *authv1alpha1.AIStoreAuth) (authv1alpha1.BackupMethod, error) {
+	spec := authn.Spec.Backup
+	if spec.VolumeSnapshot != nil {
+		served, err := r.client.IsKindServed(authnres.VolumeSnapshotGVK)
+		if err != nil {
+			return "", err
+		}
+		if served {
+			return authv1alpha1.BackupMethodVolumeSnapshot, nil
+		}
+	}
+	if spec.PVC != nil {
+		return authv1alpha1.BackupMethodPVC, nil
+	}
+	return "", nil
+}
+
+// isBackupDue reports whether a new backup should be taken. Attempts are sorted newest first.
+func isBackupDue(attempts []backupAttempt, interval time.Duration, now time.Time) bool {
+	if len(attempts) == 0 {
+		return true
+	}
+	latest := attempts[0]
+	return latest.state != backupPending && !now.Before(nextBackupTime(&latest, interval))
+}
+
+// nextBackupTime returns when the backup after the given finished attempt is due. Failed attempts
+// are retried after backupRetryDelay.
+func nextBackupTime(latest *backupAttempt, interval time.Duration) time.Time {
+	if latest.state == backupFailed {
+		return latest.time.Add(min(interval, backupRetryDelay))
+	}
+	return latest.time.Add(interval)
+}
+
+func (r *Reconciler) takeBackup(
+	ctx context.Context, authn *authv1alpha1.AIStoreAuth, method authv1alpha1.BackupMethod, now time.Time,
+) (backupAttempt, error) {
+	attempt := backupAttempt{time: now.UTC().Truncate(time.Second), state: backupPending}
+	switch method {
+	case authv1alpha1.BackupMethodVolumeSnapshot:
+		snapshot := authnres.NewVolumeSnapshot(authn, attempt.time)
+		if err := r.client.Create(ctx, snapshot); err != nil {
+			return attempt, err
+		}
+		attempt.obj, attempt.name = snapshot, snapshot.GetName()
+	case authv1alpha1.BackupMethodPVC:
+		job, err := authnres.NewBackupJob(authn, attempt.time)
+		if err != nil {
+			return attempt, err
+		}
+		if err := r.client.Apply(ctx, job); err != nil {
+			return attempt, err
+		}
+		attempt.obj = &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: *job.Name, Namespace: authn.Namespace}}
+		attempt.name = authnres.BackupFilePath(authn, attempt.time)
+	}
+	r.recorder.Eventf(authn, nil, corev1.EventTypeNormal, EventReasonBackupStarted, ActionReconcile,
+		"Started AuthN database backup %s", attempt.name)
+	logf.FromContext(ctx).V(1).Info("AuthN backup started", "method", method, "name", attempt.name)
+	return attempt, nil
+}
+
+// listBackups returns the backups taken with the given method, newest first.
+func (r *Reconciler) listBackups(
+	ctx context.Context, authn *authv1alpha1.AIStoreAuth, method authv1alpha1.BackupMethod,
+) ([]backupAttempt, error) {
+	opts := []client.ListOption{
+		client.InNamespace(authn.Namespace),
+		client.MatchingLabels(authnres.BackupLabels(authn)),
+	}
+	var attempts []backupAttempt
+	switch method {
+	case authv1alpha1.BackupMethodVolumeSnapshot:
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(authnres.VolumeSnapshotGVK.GroupVersion().WithKind(authnres.VolumeSnapshotGVK.Kind + "List"))
+		if err := r.client.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			attempts = append(attempts, snapshotAttempt(&list.Items[i]))
+		}
+	case authv1alpha1.BackupMethodPVC:
+		list := &batchv1.JobList{}
+		if err := r.client.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			attempts = append(attempts, jobAttempt(authn, &list.Items[i]))
+		}
+	}
+	slices.SortFunc(attempts, func(a, b backupAttempt) int { return b.time.Compare(a.time) })
+	return attempts, nil
+}
+
+func snapshotAttempt(snapshot *unstructured.Unstructured) backupAttempt {
+	attempt := backupAttempt{
+		obj:   snapshot,
+		name:  snapshot.GetName(),
+		time:  attemptTime(snapshot),
+		state: backupPending,
+	}
+	if ready, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse"); ready {
+		attempt.state = backupSucceeded
+	} else if msg, found, _ := unstructured.NestedString(snapshot.Object, "status", "error", "message"); found {
+		attempt.state, attempt.message = backupFailed, msg
+	}
+	return attempt
+}
+
+func jobAttempt(authn *authv1alpha1.AIStoreAuth, job *batchv1.Job) backupAttempt {
+	at := attemptTime(job)
+	attempt := backupAttempt{
+		obj:   job,
+		name:  authnres.BackupFilePath(authn, at),
+		time:  at,
+		state: backupPending,
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			attempt.state = backupSucceeded
+		case batchv1.JobFailed:
+			attempt.state, attempt.message = backupFailed, condition.Message
+		}
+	}
+	return attempt
+}
+
+// attemptTime reads the backup time annotation, falling back to the creation time.
+func attemptTime(obj client.Object) time.Time {
+	if at, err := authnres.BackupTime(obj.GetAnnotations()); err == nil {
+		return at
+	}
+	return obj.GetCreationTimestamp().UTC()
+}
+
+// pruneBackups deletes successful backups beyond retention, and failed backups once a newer
+// attempt exists. The latest failure is kept for inspection.
+func (r *Reconciler) pruneBackups(ctx context.Context, authn *authv1alpha1.AIStoreAuth, attempts []backupAttempt) error {
+	retention := authn.Spec.Backup.GetRetention()
+	kept := 0
+	for i := range attempts {
+		attempt := &attempts[i]
+		switch attempt.state {
+		case backupSucceeded:
+			kept++
+			if kept <= retention {
+				continue
+			}
+		case backupFailed:
+			if i == 0 {
+				continue
+			}
+		default:
+			continue
+		}
+		if _, err := r.client.DeleteResourceIfExists(ctx, attempt.obj,
+			client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+			return err
+		}
+		logf.FromContext(ctx).V(1).Info("AuthN backup deleted", "name", attempt.name)
+		attempt.obj = nil
+	}
+	return nil
+}
+
+// setBackupStatus lists the retained backups and reports the latest attempt on BackupSucceeded.
+func (r *Reconciler) setBackupStatus(
+	authn *authv1alpha1.AIStoreAuth, method authv1alpha1.BackupMethod, attempts []backupAttempt,
+) {
+	backups := make([]authv1alpha1.BackupStatus, 0, len(attempts))
+	for i := range attempts {
+		attempt := &attempts[i]
+		if attempt.obj == nil || attempt.state == backupFailed {
+			continue
+		}
+		backups = append(backups, authv1alpha1.BackupStatus{
+			Name:       attempt.name,
+			Method:     method,
+			Time:       metav1.NewTime(attempt.time),
+			ReadyToUse: attempt.state == backupSucceeded,
+		})
+	}
+	authn.Status.Backups = backups
+
+	latest := &attempts[0]
+	switch latest.state {
+	case backupSucceeded:
+		setBackupCondition(authn, metav1.ConditionTrue, authv1alpha1.ReasonBackupCompleted, msgBackupCompleted)
+	case backupFailed:
+		msg := "AuthN database backup " + latest.name + " failed"
+		if latest.message != "" {
+			msg += ": " + latest.message
+		}
+		if !hasBackupFailure(authn, msg) {
+			r.recorder.Eventf(authn, nil, corev1.EventTypeWarning, EventReasonBackupAttemptFailed,
+				ActionReconcile, "%s", msg)
+		}
+		setBackupCondition(authn, metav1.ConditionFalse, authv1alpha1.ReasonBackupFailed, msg)
+	default:
+		setBackupCondition(authn, metav1.ConditionFalse, authv1alpha1.ReasonBackupInProgress, msgBackupInProgress)
+	}
+}
+
+// setBackupCondition sets BackupSucceeded, stamping the generation it was evaluated against.
+func setBackupCondition(
+	authn *authv1alpha1.AIStoreAuth,
+	status metav1.ConditionStatus,
+	reason authv1alpha1.ConditionReason,
+	message string,
+) {
+	meta.SetStatusCondition(&authn.Status.Conditions, metav1.Condition{
+		Type:               string(authv1alpha1.ConditionBackupSucceeded),
+		Status:             status,
+		Reason:             string(reason),
+		Message:            message,
+		ObservedGeneration: authn.GetGeneration(),
+	})
+}
+
+// hasBackupFailure reports whether BackupSucceeded already carries this exact failure.
+func hasBackupFailure(authn *authv1alpha1.AIStoreAuth, msg string) bool {
+	condition := meta.FindStatusCondition(authn.Status.Conditions, string(authv1alpha1.ConditionBackupSucceeded))
+	return condition != nil &&
+		condition.Reason == string(authv1alpha1.ReasonBackupFailed) &&
+		condition.Message == msg
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aisauth
+
+import (
+	"context"
+	"time"
+
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
+	authnres "github.com/ais-operator/internal/resources/aisauth"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("AIStoreAuth backups", Label("short"), func() {
+	var (
+		scheme     *runtime.Scheme
+		reconciler *Reconciler
+		recorder   *events.FakeRecorder
+		authn      *authv1alpha1.AIStoreAuth
+	)
+
+	BeforeEach(func() {
+		scheme = newTestScheme()
+		authn = newTestAuthN()
+		authn.Spec.Backup = &authv1alpha1.BackupSpec{
+			Interval:  metav1.Duration{Duration: time.Hour},
+			Retention: 2,
+			PVC:       &authv1alpha1.PVCBackupSpec{ClaimName: "authn-backups"},
+		}
+		reconciler, recorder = newTestReconciler(scheme, authn)
+	})
+
+	listJobs := func(ctx context.Context) []batchv1.Job {
+		GinkgoHelper()
+		jobs := &batchv1.JobList{}
+		Expect(reconciler.client.List(ctx, jobs, client.InNamespace(authn.Namespace),
+			client.MatchingLabels(authnres.BackupLabels(authn)))).To(Succeed())
+		return jobs.Items
+	}
+
+	It("starts a copy Job and waits for it before the next backup", func(ctx context.Context) {
+		requeue, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeue).To(BeZero())
+
+		jobs := listJobs(ctx)
+		Expect(jobs).To(HaveLen(1))
+		Expect(metav1.IsControlledBy(&jobs[0], authn)).To(BeTrue())
+		Expect(recorder.Events).To(Receive(ContainSubstring("Normal BackupStarted")))
+
+		Expect(authn.Status.Backups).To(HaveLen(1))
+		Expect(authn.Status.Backups[0].Method).To(Equal(authv1alpha1.BackupMethodPVC))
+		Expect(authn.Status.Backups[0].ReadyToUse).To(BeFalse())
+		condition := meta.FindStatusCondition(authn.Status.Conditions, string(authv1alpha1.ConditionBackupSucceeded))
+		Expect(condition.Reason).To(Equal(string(authv1alpha1.ReasonBackupInProgress)))
+
+		// A pending backup is never doubled up.
+		_, err = reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listJobs(ctx)).To(HaveLen(1))
+	})
+
+	It("reports a completed backup and requeues for the next one", func(ctx context.Context) {
+		_, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		job := listJobs(ctx)[0]
+		setJobCondition(ctx, reconciler, &job, batchv1.JobComplete, "")
+
+		requeue, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeue).To(BeNumerically(">", 59*time.Minute))
+		Expect(requeue).To(BeNumerically("<=", time.Hour))
+
+		Expect(authn.Status.Backups).To(HaveLen(1))
+		Expect(authn.Status.Backups[0].ReadyToUse).To(BeTrue())
+		Expect(authn.Status.Backups[0].Name).To(HavePrefix("ais-authn/authn-"))
+		Expect(meta.IsStatusConditionTrue(authn.Status.Conditions,
+			string(authv1alpha1.ConditionBackupSucceeded))).To(BeTrue())
+	})
+
+	It("reports a failed backup once", func(ctx context.Context) {
+		_, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(Receive())
+		job := listJobs(ctx)[0]
+		setJobCondition(ctx, reconciler, &job, batchv1.JobFailed, "BackoffLimitExceeded")
+
+		_, err = reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning BackupAttemptFailed")))
+		condition := meta.FindStatusCondition(authn.Status.Conditions, string(authv1alpha1.ConditionBackupSucceeded))
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(string(authv1alpha1.ReasonBackupFailed)))
+		Expect(authn.Status.Backups).To(BeEmpty())
+
+		_, err = reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("waits a short delay before retrying a failed backup", func(ctx context.Context) {
+		createBackupJob(ctx, reconciler, authn, time.Now().Add(-time.Minute), batchv1.JobFailed)
+
+		requeue, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeue).To(BeNumerically(">", 0))
+		Expect(requeue).To(BeNumerically("<=", backupRetryDelay-time.Minute))
+		Expect(listJobs(ctx)).To(HaveLen(1))
+	})
+
+	It("retries a failed backup before the next interval", func(ctx context.Context) {
+		createBackupJob(ctx, reconciler, authn, time.Now().Add(-2*backupRetryDelay), batchv1.JobFailed)
+
+		_, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(Receive(ContainSubstring("Normal BackupStarted")))
+
+		// The failed Job is deleted once the retry has started.
+		jobs := listJobs(ctx)
+		Expect(jobs).To(HaveLen(1))
+		Expect(jobs[0].Status.Conditions).To(BeEmpty())
+		condition := meta.FindStatusCondition(authn.Status.Conditions, string(authv1alpha1.ConditionBackupSucceeded))
+		Expect(condition.Reason).To(Equal(string(authv1alpha1.ReasonBackupInProgress)))
+	})
+
+	It("deletes backups beyond retention", func(ctx context.Context) {
+		now := time.Now()
+		for _, age := range []time.Duration{3 * time.Hour, 2 * time.Hour, 90 * time.Minute} {
+			createBackupJob(ctx, reconciler, authn, now.Add(-age), batchv1.JobComplete)
+		}
+
+		_, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The new backup is pending, so the two newest completed copies are retained.
+		Expect(listJobs(ctx)).To(HaveLen(3))
+		Expect(authn.Status.Backups).To(HaveLen(3))
+		Expect(authn.Status.Backups[0].ReadyToUse).To(BeFalse())
+		Expect(authn.Status.Backups[1].Name).To(Equal(authnres.BackupFilePath(authn, now.Add(-90*time.Minute))))
+		Expect(authn.Status.Backups[2].Name).To(Equal(authnres.BackupFilePath(authn, now.Add(-2*time.Hour))))
+	})
+
+	It("reports volume snapshots as unavailable without a PVC fallback", func(ctx context.Context) {
+		authn.Spec.Backup.PVC = nil
+		authn.Spec.Backup.VolumeSnapshot = &authv1alpha1.VolumeSnapshotBackupSpec{}
+
+		requeue, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeue).To(BeZero())
+		condition := meta.FindStatusCondition(authn.Status.Conditions, string(authv1alpha1.ConditionBackupSucceeded))
+		Expect(condition.Reason).To(Equal(string(authv1alpha1.ReasonBackupUnavailable)))
+		Expect(listJobs(ctx)).To(BeEmpty())
+	})
+
+	It("falls back to copies when volume snapshots are not served", func(ctx context.Context) {
+		authn.Spec.Backup.VolumeSnapshot = &authv1alpha1.VolumeSnapshotBackupSpec{}
+
+		_, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listJobs(ctx)).To(HaveLen(1))
+	})
+
+	It("clears backup status when backups are disabled", func(ctx context.Context) {
+		_, err := reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+
+		authn.Spec.Backup = nil
+		_, err = reconciler.reconcileBackup(ctx, authn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authn.Status.Backups).To(BeNil())
+		Expect(meta.FindStatusCondition(authn.Status.Conditions,
+			string(authv1alpha1.ConditionBackupSucceeded))).To(BeNil())
+	})
+})
+
+// createBackupJob stores a finished backup Job taken at the given time.
+func createBackupJob(
+	ctx context.Context, r *Reconciler, authn *authv1alpha1.AIStoreAuth, at time.Time, conditionType batchv1.JobConditionType,
+) {
+	GinkgoHelper()
+	at = at.UTC().Truncate(time.Second)
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+		Name:        authnres.BackupName(authn, at),
+		Namespace:   authn.Namespace,
+		Labels:      authnres.BackupLabels(authn),
+		Annotations: map[string]string{authnres.BackupTimeAnnotation: at.Format(time.RFC3339)},
+	}}
+	Expect(r.client.Create(ctx, job)).To(Succeed())
+	setJobCondition(ctx, r, job, conditionType, "")
+}
+
+// setJobCondition marks a backup Job finished.
+func setJobCondition(
+	ctx context.Context, r *Reconciler, job *batchv1.Job, conditionType batchv1.JobConditionType, reason string,
+) {
+	GinkgoHelper()
+	job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+		Type:   conditionType,
+		Status: corev1.ConditionTrue,
+		Reason: reason,
+	})
+	Expect(r.client.Status().Update(ctx, job)).To(Succeed())
+}
@@ -9,12 +9,15 @@ const (
 	EventReasonReady            = "Ready"
 	EventReasonRolloutStalled   = "RolloutStalled"
 	EventReasonCleanupCompleted = "CleanupCompleted"
+	EventReasonBackupStarted    = "BackupStarted"
 
 	EventReasonConfigMapFailed        = "ConfigMapFailed"
 	EventReasonPVCFailed              = "PVCFailed"
 	EventReasonServicesFailed         = "ServicesFailed"
 	EventReasonCertificateFailed      = "CertificateFailed"
 	EventReasonDeploymentFailed       = "DeploymentFailed"
+	EventReasonBackupFailed           = "BackupFailed"
+	EventReasonBackupAttemptFailed    = "BackupAttemptFailed"
 	EventReasonPVCRetentionFailed     = "PVCRetentionFailed"
 	EventReasonFinalizerRemovalFailed = "FinalizerRemovalFailed"
 	EventReasonFinalizerFailed        = "FinalizerFailed"
@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aisauthprofile
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/services"
+	"github.com/go-logr/logr"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultCheckInterval is how often each profile's auth provider is checked.
+const DefaultCheckInterval = 5 * time.Minute
+
+// authProviderChecker obtains a token from the auth provider a profile points to.
+type authProviderChecker interface {
+	CheckAuthProfile(ctx context.Context, profile *authv1alpha1.AIStoreAuthProfile) (*services.TokenInfo, error)
+}
+
+// Reconciler periodically checks the auth provider of an AIStoreAuthProfile and reports the
+// result in its status.
+type Reconciler struct {
+	client        *aisclient.K8sClient
+	checker       authProviderChecker
+	log           logr.Logger
+	recorder      events.EventRecorder
+	checkInterval time.Duration
+}
+
+// NewReconcilerFromMgr builds a Reconciler from a controller manager.
+func NewReconcilerFromMgr(mgr manager.Manager, checkInterval time.Duration, logger logr.Logger) *Reconciler {
+	k8sClient := aisclient.NewClientFromMgr(mgr)
+	return &Reconciler{
+		client:        k8sClient,
+		checker:       services.NewAuthNClient(k8sClient),
+		log:           logger,
+		recorder:      mgr.GetEventRecorder("aistoreauthprofile-controller"),
+		checkInterval: checkInterval,
+	}
+}
+
+// +kubebuilder:rbac:groups=auth.ais.nvidia.com,resources=aistoreauthprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=auth.ais.nvidia.com,resources=aistoreauthprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ais.nvidia.com,resources=aistores,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.log.WithValues("name", req.Name)
+	ctx = logf.IntoContext(ctx, logger)
+
+	profile, err := r.client.GetAuthProfile(ctx, req.Name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch AIStoreAuthProfile")
+		return reconcile.Result{}, err
+	}
+
+	base := profile.DeepCopy()
+	referencedBy, err := r.listReferences(ctx, profile.Name)
+	if err != nil {
+		logger.Error(err, "Failed to list AIStore clusters referencing the profile")
+		return reconcile.Result{}, err
+	}
+	profile.Status.ReferencedBy = referencedBy
+
+	_, checkErr := r.checker.CheckAuthProfile(ctx, profile)
+	if checkErr != nil {
+		logger.Info("Auth provider check failed", "serviceURL", profile.Spec.ServiceURL, "error", checkErr.Error())
+	}
+	r.setCheckResult(profile, checkErr, time.Now())
+
+	if err := r.updateStatus(ctx, base, profile); err != nil {
+		logger.Error(err, "Failed to update AIStoreAuthProfile status")
+		return reconcile.Result{}, err
+	}
+	logger.V(1).Info("Checked AIStoreAuthProfile", "ready", checkErr == nil)
+	return reconcile.Result{RequeueAfter: r.checkInterval}, nil
+}
+
+// listReferences returns the AIStore clusters referencing the profile, as sorted namespace/name.
+func (r *Reconciler) listReferences(ctx context.Context, profileName string) ([]string, error) {
+	list, err := r.client.ListAIStoreCR(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var referencedBy []string
+	for i := range list.Items {
+		ais := &list.Items[i]
+		if profileRefName(ais) == profileName {
+			referencedBy = append(referencedBy, types.NamespacedName{Namespace: ais.Namespace, Name: ais.Name}.String())
+		}
+	}
+	slices.Sort(referencedBy)
+	return referencedBy, nil
+}
+
+// profileRefName returns the name of the AIStoreAuthProfile the cluster references, if any.
+func profileRefName(ais *aisv1.AIStore) string {
+	if ais.Spec.Auth == nil || ais.Spec.Auth.ProfileRef == nil {
+		return ""
+	}
+	return ais.Spec.Auth.ProfileRef.Name
+}
+
+// profileForAIStore maps an AIStore to the profile it references.
+func profileForAIStore(_ context.Context, obj client.Object) []reconcile.Request {
+	ais, ok := obj.(*aisv1.AIStore)
+	if !ok {
+		return nil
+	}
+	name := profileRefName(ais)
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name}}}
+}
+
+// profileRefChanged passes AIStore updates only when the referenced profile changes, so routine
+// cluster status updates do not trigger extra checks against the auth provider.
+var profileRefChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldAIS, okOld := e.ObjectOld.(*aisv1.AIStore)
+		newAIS, okNew := e.ObjectNew.(*aisv1.AIStore)
+		return okOld && okNew && profileRefName(oldAIS) != profileRefName(newAIS)
+	},
+}
+
+// SetupWithManager registers the reconciler with the manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&authv1alpha1.AIStoreAuthProfile{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&aisv1.AIStore{},
+			handler.EnqueueRequestsFromMapFunc(profileForAIStore),
+			builder.WithPredicates(profileRefChanged)).
+		Named("aistoreauthprofile").
+		Complete(r)
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aisauthprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/services"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func TestAIStoreAuthProfileController(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AIStoreAuthProfile controller suite")
+}
+
+// fakeChecker returns the configured error, or a token when there is none.
+type fakeChecker struct {
+	err    error
+	checks int
+}
+
+func (c *fakeChecker) CheckAuthProfile(context.Context, *authv1alpha1.AIStoreAuthProfile) (*services.TokenInfo, error) {
+	c.checks++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &services.TokenInfo{Token: "admin-token"}, nil
+}
+
+var _ = Describe("AIStoreAuthProfileReconciler", Label("short"), func() {
+	var (
+		reconciler *Reconciler
+		recorder   *events.FakeRecorder
+		checker    *fakeChecker
+		profile    *authv1alpha1.AIStoreAuthProfile
+		req        ctrl.Request
+	)
+
+	BeforeEach(func() {
+		profile = &authv1alpha1.AIStoreAuthProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-authn", Generation: 2},
+			Spec: authv1alpha1.AIStoreAuthProfileSpec{
+				ServiceURL: "https://auth-provider.ais.svc:52001",
+				UsernamePassword: &authv1alpha1.AuthProfileUsernamePassword{
+					Secret: authv1alpha1.AuthProfileSecret{Name: "admin", Namespace: "auth-config"},
+				},
+			},
+		}
+		req = ctrl.Request{NamespacedName: types.NamespacedName{Name: profile.Name}}
+		checker = &fakeChecker{}
+		reconciler, recorder = newTestReconciler(checker, profile,
+			newTestAIStore("ais", "prod", "prod-authn"),
+			newTestAIStore("ais", "dev", "dev-authn"),
+			newTestAIStore("tenant", "analytics", "prod-authn"),
+			newTestAIStore("tenant", "legacy", ""),
+		)
+	})
+
+	reconcileProfile := func(ctx context.Context) *authv1alpha1.AIStoreAuthProfile {
+		GinkgoHelper()
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultCheckInterval))
+		stored := &authv1alpha1.AIStoreAuthProfile{}
+		Expect(reconciler.client.Get(ctx, req.NamespacedName, stored)).To(Succeed())
+		return stored
+	}
+
+	expectCondition := func(
+		stored *authv1alpha1.AIStoreAuthProfile,
+		conditionType authv1alpha1.ConditionType,
+		status metav1.ConditionStatus,
+		reason authv1alpha1.ConditionReason,
+	) {
+		GinkgoHelper()
+		condition := meta.FindStatusCondition(stored.Status.Conditions, string(conditionType))
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(status))
+		Expect(condition.Reason).To(Equal(string(reason)))
+		Expect(condition.ObservedGeneration).To(Equal(stored.Generation))
+	}
+
+	It("reports a healthy provider and the clusters referencing the profile", func(ctx context.Context) {
+		stored := reconcileProfile(ctx)
+
+		expectCondition(stored, authv1alpha1.ConditionReady, metav1.ConditionTrue, authv1alpha1.ReasonTokenIssued)
+		expectCondition(stored, authv1alpha1.ConditionReachable, metav1.ConditionTrue, authv1alpha1.ReasonResponseReceived)
+		expectCondition(stored, authv1alpha1.ConditionCredentialsValid, metav1.ConditionTrue, authv1alpha1.ReasonTokenIssued)
+		Expect(stored.Status.LastTokenTime).NotTo(BeNil())
+		Expect(stored.Status.ObservedGeneration).To(Equal(int64(2)))
+		Expect(stored.Status.ReferencedBy).To(Equal([]string{"ais/prod", "tenant/analytics"}))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Normal Ready")))
+	})
+
+	DescribeTable("classifies failed checks",
+		func(ctx context.Context, checkErr error, reachable metav1.ConditionStatus,
+			reachableReason authv1alpha1.ConditionReason, credentials metav1.ConditionStatus,
+			credentialsReason, readyReason authv1alpha1.ConditionReason,
+		) {
+			checker.err = checkErr
+			stored := reconcileProfile(ctx)
+
+			expectCondition(stored, authv1alpha1.ConditionReady, metav1.ConditionFalse, readyReason)
+			expectCondition(stored, authv1alpha1.ConditionReachable, reachable, reachableReason)
+			expectCondition(stored, authv1alpha1.ConditionCredentialsValid, credentials, credentialsReason)
+			ready := meta.FindStatusCondition(stored.Status.Conditions, string(authv1alpha1.ConditionReady))
+			Expect(ready.Message).To(Equal(checkErr.Error()))
+			Expect(stored.Status.LastTokenTime).To(BeNil())
+		},
+		Entry("invalid TLS configuration",
+			fmt.Errorf("%w: CA ConfigMap auth-config/auth-ca has no key", services.ErrAuthProviderTLS),
+			metav1.ConditionFalse, authv1alpha1.ReasonTLSConfigInvalid,
+			metav1.ConditionUnknown, authv1alpha1.ReasonNotChecked,
+			authv1alpha1.ReasonTLSConfigInvalid),
+		Entry("unreadable credentials",
+			fmt.Errorf("%w: secrets \"admin\" not found", services.ErrAuthProviderCredentials),
+			metav1.ConditionUnknown, authv1alpha1.ReasonNotChecked,
+			metav1.ConditionFalse, authv1alpha1.ReasonCredentialsUnavailable,
+			authv1alpha1.ReasonCredentialsUnavailable),
+		Entry("unreachable provider",
+			fmt.Errorf("%w: connection refused", services.ErrAuthProviderUnreachable),
+			metav1.ConditionFalse, authv1alpha1.ReasonConnectionFailed,
+			metav1.ConditionUnknown, authv1alpha1.ReasonNotChecked,
+			authv1alpha1.ReasonConnectionFailed),
+		Entry("rejected credentials",
+			errors.New("failed to login \"admin\" user to AuthN: unauthorized"),
+			metav1.ConditionTrue, authv1alpha1.ReasonResponseReceived,
+			metav1.ConditionFalse, authv1alpha1.ReasonTokenRejected,
+			authv1alpha1.ReasonTokenRejected),
+	)
+
+	It("reports a failure once and recovery once", func(ctx context.Context) {
+		checker.err = fmt.Errorf("%w: connection refused", services.ErrAuthProviderUnreachable)
+		reconcileProfile(ctx)
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning CheckFailed")))
+		reconcileProfile(ctx)
+		Expect(recorder.Events).NotTo(Receive())
+
+		checker.err = nil
+		stored := reconcileProfile(ctx)
+		Expect(recorder.Events).To(Receive(ContainSubstring("Normal Ready")))
+		Expect(stored.Status.LastTokenTime).NotTo(BeNil())
+		Expect(checker.checks).To(Equal(3))
+	})
+
+	It("ignores a deleted profile", func(ctx context.Context) {
+		_, err := reconciler.client.DeleteResourceIfExists(ctx, profile)
+		Expect(err).NotTo(HaveOccurred())
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(checker.checks).To(BeZero())
+	})
+})
+
+var _ = Describe("AIStore watch", Label("short"), func() {
+	It("maps a cluster to the profile it references", func(ctx context.Context) {
+		Expect(profileForAIStore(ctx, newTestAIStore("ais", "prod", "prod-authn"))).To(ConsistOf(
+			ctrl.Request{NamespacedName: types.NamespacedName{Name: "prod-authn"}}))
+		Expect(profileForAIStore(ctx, newTestAIStore("ais", "prod", ""))).To(BeEmpty())
+	})
+
+	It("passes updates only when the referenced profile changes", func() {
+		oldAIS := newTestAIStore("ais", "prod", "prod-authn")
+		Expect(profileRefChanged.Update(event.UpdateEvent{
+			ObjectOld: oldAIS, ObjectNew: newTestAIStore("ais", "prod", "prod-authn"),
+		})).To(BeFalse())
+		Expect(profileRefChanged.Update(event.UpdateEvent{
+			ObjectOld: oldAIS, ObjectNew: newTestAIStore("ais", "prod", "dev-authn"),
+		})).To(BeTrue())
+		Expect(profileRefChanged.Create(event.CreateEvent{Object: oldAIS})).To(BeTrue())
+	})
+})
+
+// newTestAIStore builds an AIStore referencing the named profile, or none when empty.
+func newTestAIStore(namespace, name, profileName string) *aisv1.AIStore {
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	if profileName != "" {
+		ais.Spec.Auth = &aisv1.AuthSpec{ProfileRef: &aisv1.AuthProfileRef{Name: profileName}}
+	}
+	return ais
+}
+
+func newTestReconciler(
+	checker authProviderChecker, profile *authv1alpha1.AIStoreAuthProfile, objs ...client.Object,
+) (*Reconciler, *events.FakeRecorder) {
+	GinkgoHelper()
+	scheme := runtime.NewScheme()
+	Expect(authv1alpha1.AddToScheme(scheme)).To(Succeed())
+	Expect(aisv1.AddToScheme(scheme)).To(Succeed())
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(profile).
+		WithObjects(append(objs, profile)...).
+		Build()
+	recorder := events.NewFakeRecorder(8)
+	return &Reconciler{
+		client:        aisclient.NewClient(c, scheme),
+		checker:       checker,
+		log:           zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)),
+		recorder:      recorder,
+		checkInterval: DefaultCheckInterval,
+	}, recorder
+}
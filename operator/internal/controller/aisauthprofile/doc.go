@@ -0,0 +1,6 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+// Package aisauthprofile contains Kubernetes controller logic for AIStoreAuthProfile resources.
+package aisauthprofile
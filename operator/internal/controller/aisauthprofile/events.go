@@ -0,0 +1,17 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aisauthprofile
+
+// Reasons to be used by event recorder.
+const (
+	EventReasonReady = "Ready"
+
+	EventReasonCheckFailed = "CheckFailed"
+)
+
+// Actions to be used in events.
+const (
+	ActionCheck = "Check"
+)
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aisauthprofile
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
+	"github.com/ais-operator/internal/services"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Condition messages.
+const (
+	msgTokenIssued      = "The auth provider issued a token"
+	msgResponseReceived = "The auth provider answered"
+	msgNotChecked       = "Not checked because of an earlier failure"
+)
+
+// checkResult is the outcome of an auth provider check, as reported on each condition.
+type checkResult struct {
+	reachable         metav1.ConditionStatus
+	reachableReason   authv1alpha1.ConditionReason
+	credentials       metav1.ConditionStatus
+	credentialsReason authv1alpha1.ConditionReason
+	readyReason       authv1alpha1.ConditionReason
+}
+
+// classifyCheck maps an error from CheckAuthProfile to the conditions it implies.
+func classifyCheck(err error) checkResult {
+	switch {
+	case err == nil:
+		return checkResult{
+			metav1.ConditionTrue, authv1alpha1.ReasonResponseReceived,
+			metav1.ConditionTrue, authv1alpha1.ReasonTokenIssued,
+			authv1alpha1.ReasonTokenIssued,
+		}
+	case errors.Is(err, services.ErrAuthProviderTLS):
+		return checkResult{
+			metav1.ConditionFalse, authv1alpha1.ReasonTLSConfigInvalid,
+			metav1.ConditionUnknown, authv1alpha1.ReasonNotChecked,
+			authv1alpha1.ReasonTLSConfigInvalid,
+		}
+	case errors.Is(err, services.ErrAuthProviderCredentials):
+		return checkResult{
+			metav1.ConditionUnknown, authv1alpha1.ReasonNotChecked,
+			metav1.ConditionFalse, authv1alpha1.ReasonCredentialsUnavailable,
+			authv1alpha1.ReasonCredentialsUnavailable,
+		}
+	case errors.Is(err, services.ErrAuthProviderUnreachable):
+		return checkResult{
+			metav1.ConditionFalse, authv1alpha1.ReasonConnectionFailed,
+			metav1.ConditionUnknown, authv1alpha1.ReasonNotChecked,
+			authv1alpha1.ReasonConnectionFailed,
+		}
+	default:
+		return checkResult{
+			metav1.ConditionTrue, authv1alpha1.ReasonResponseReceived,
+			metav1.ConditionFalse, authv1alpha1.ReasonTokenRejected,
+			authv1alpha1.ReasonTokenRejected,
+		}
+	}
+}
+
+// setCheckResult records the outcome of a check in status, emitting an event when Ready changes.
+func (r *Reconciler) setCheckResult(profile *authv1alpha1.AIStoreAuthProfile, err error, now time.Time) {
+	result := classifyCheck(err)
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	}
+	messageFor := func(status metav1.ConditionStatus, reason authv1alpha1.ConditionReason) string {
+		switch {
+		case reason == authv1alpha1.ReasonNotChecked:
+			return msgNotChecked
+		case status != metav1.ConditionTrue:
+			return errMsg
+		case reason == authv1alpha1.ReasonTokenIssued:
+			return msgTokenIssued
+		default:
+			return msgResponseReceived
+		}
+	}
+
+	if err == nil {
+		if !isReady(profile) {
+			r.recorder.Eventf(profile, nil, corev1.EventTypeNormal, EventReasonReady, ActionCheck, "%s", msgTokenIssued)
+		}
+		profile.Status.LastTokenTime = &metav1.Time{Time: now}
+	} else if !hasReadyFailure(profile, result.readyReason, errMsg) {
+		r.recorder.Eventf(profile, nil, corev1.EventTypeWarning, EventReasonCheckFailed, ActionCheck, "%s", errMsg)
+	}
+
+	readyStatus := metav1.ConditionFalse
+	if err == nil {
+		readyStatus = metav1.ConditionTrue
+	}
+	setCondition(profile, authv1alpha1.ConditionReachable, result.reachable, result.reachableReason,
+		messageFor(result.reachable, result.reachableReason))
+	setCondition(profile, authv1alpha1.ConditionCredentialsValid, result.credentials, result.credentialsReason,
+		messageFor(result.credentials, result.credentialsReason))
+	setCondition(profile, authv1alpha1.ConditionReady, readyStatus, result.readyReason,
+		messageFor(readyStatus, result.readyReason))
+}
+
+// updateStatus persists the status built up in memory.
+func (r *Reconciler) updateStatus(ctx context.Context, base, profile *authv1alpha1.AIStoreAuthProfile) error {
+	profile.Status.ObservedGeneration = profile.GetGeneration()
+
+	if equality.Semantic.DeepEqual(base.Status, profile.Status) {
+		return nil
+	}
+	return client.IgnoreNotFound(r.client.Status().Patch(ctx, profile, client.MergeFrom(base)))
+}
+
+// setCondition sets a status condition, stamping the generation it was evaluated against.
+func setCondition(
+	profile *authv1alpha1.AIStoreAuthProfile,
+	conditionType authv1alpha1.ConditionType,
+	status metav1.ConditionStatus,
+	reason authv1alpha1.ConditionReason,
+	message string,
+) {
+	meta.SetStatusCondition(&profile.Status.Conditions, metav1.Condition{
+		Type:               string(conditionType),
+		Status:             status,
+		Reason:             string(reason),
+		Message:            message,
+		ObservedGeneration: profile.GetGeneration(),
+	})
+}
+
+// isReady reports whether Ready is currently True.
+func isReady(profile *authv1alpha1.AIStoreAuthProfile) bool {
+	return meta.IsStatusConditionTrue(profile.Status.Conditions, string(authv1alpha1.ConditionReady))
+}
+
+// hasReadyFailure reports whether Ready already carries this exact failure.
+func hasReadyFailure(profile *authv1alpha1.AIStoreAuthProfile, reason authv1alpha1.ConditionReason, msg string) bool {
+	condition := meta.FindStatusCondition(profile.Status.Conditions, string(authv1alpha1.ConditionReady))
+	return condition != nil &&
+		condition.Status == metav1.ConditionFalse &&
+		condition.Reason == string(reason) &&
+		condition.Message == msg
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ais-operator/internal/resources/aistore/statsd"
 	"github.com/ais-operator/internal/resources/aistore/target"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -28,15 +29,16 @@ func (r *Reconciler) cleanup(ctx context.Context, ais *aisv1.AIStore) (updated b
 		func() (bool, error) { return r.k8sClient.DeleteConfigMapIfExists(ctx, statsd.ConfigMapNSName(ais)) },
 		func() (bool, error) { return r.cleanupRBAC(ctx, ais) },
 		func() (bool, error) { return r.cleanupPVC(ctx, ais) },
+		func() (bool, error) { return r.cleanupLocalPVs(ctx, ais) },
 		func() (bool, error) { return r.cleanupTLS(ctx, ais) },
 	)
 	if err != nil {
 		return
 	}
-	// If using HostPath for state, create node-local host cleanup jobs.
+	// If using HostPath for state or wiping HostPath target data, create node-local host cleanup jobs.
 	// Note this is not part of the above list to avoid host cleanup jobs blocking any K8s resource cleanup.
-	if ais.ShouldCleanupMetadata() && ais.Spec.UsesStateHostPath() {
-		err = r.cleanupHostStateMounts(ctx, ais)
+	if ais.ShouldCleanupMetadata() && (ais.Spec.UsesStateHostPath() || ais.ShouldCleanupHostData()) {
+		err = r.cleanupHostMounts(ctx, ais)
 	}
 	return
 }
@@ -57,22 +59,29 @@ func (r *Reconciler) createCleanupJobs(ctx context.Context, ais *aisv1.AIStore,
 	return nil
 }
 
-func (r *Reconciler) listCleanupJobs(ctx context.Context, namespace string) (*batchv1.JobList, error) {
+// listCleanupJobs lists the cleanup jobs of the cluster, including unlabeled jobs created by earlier operator versions.
+func (r *Reconciler) listCleanupJobs(ctx context.Context, ais *aisv1.AIStore) (*batchv1.JobList, error) {
 	var cleanupJobs batchv1.JobList
-	jobs, err := r.k8sClient.ListJobsInNamespace(ctx, namespace)
+	jobs, err := r.k8sClient.ListJobsInNamespace(ctx, ais.Namespace)
 	if err != nil {
 		return nil, err
 	}
 	for i := range jobs.Items {
 		job := &jobs.Items[i]
-		if strings.HasPrefix(job.Name, cmn.CleanupPrefix) {
-			cleanupJobs.Items = append(cleanupJobs.Items, *job)
+		if !strings.HasPrefix(job.Name, cmn.CleanupPrefix) {
+			continue
+		}
+		if cluster, ok := job.Labels[cmn.LabelAppPrefixed]; ok && cluster != ais.Name {
+			continue
 		}
+		cleanupJobs.Items = append(cleanupJobs.Items, *job)
 	}
 	return &cleanupJobs, nil
 }
 
-func (r *Reconciler) deleteFinishedJobs(ctx context.Context, jobs *batchv1.JobList) (*batchv1.JobList, error) {
+// deleteFinishedJobs records the result of each job in the status, deletes the jobs that succeeded, failed,
+// or exceeded their deadline, and returns the jobs still running.
+func (r *Reconciler) deleteFinishedJobs(ctx context.Context, ais *aisv1.AIStore, jobs *batchv1.JobList) (*batchv1.JobList, error) {
 	logger := logf.FromContext(ctx)
 	remaining := &batchv1.JobList{
 		TypeMeta: jobs.TypeMeta,
@@ -80,33 +89,90 @@ func (r *Reconciler) deleteFinishedJobs(ctx context.Context, jobs *batchv1.JobLi
 		Items:    make([]batchv1.Job, 0, len(jobs.Items)),
 	}
 
+	statusUpdated := false
 	for i := range jobs.Items {
 		job := &jobs.Items[i]
-		// Job succeeded, delete it
-		if job.Status.Succeeded > 0 {
-			if _, err := r.k8sClient.DeleteResourceIfExists(ctx, job); err != nil {
-				logger.Error(err, "Failed to delete successful job", "name", job.Name)
-				return nil, err
-			}
-			logger.Info("Deleted successful cleanup job", "name", job.Name)
+		result := cleanupJobResult(job)
+		statusUpdated = setHostCleanupResult(ais, result) || statusUpdated
+		if result.Phase == aisv1.HostCleanupRunning {
+			remaining.Items = append(remaining.Items, *job)
 			continue
 		}
-		// Job has been stuck too long, delete it
-		if time.Since(job.CreationTimestamp.Time) > 2*time.Minute {
-			if _, err := r.k8sClient.DeleteResourceIfExists(ctx, job); err != nil {
-				logger.Error(err, "Failed to delete expired job", "name", job.Name)
-				return nil, err
-			}
-			logger.Info("Aborted expired job", "name", job.Name)
+		if _, err := r.k8sClient.DeleteResourceIfExists(ctx, job); err != nil {
+			logger.Error(err, "Failed to delete finished job", "name", job.Name)
+			return nil, err
+		}
+		if result.Phase == aisv1.HostCleanupFailed {
+			logger.Info("Aborted failed cleanup job", "name", job.Name, "node", result.Node, "reason", result.Message)
+			r.recorder.Eventf(ais, nil, corev1.EventTypeWarning, EventReasonHostCleanupFailed, ActionHostCleanup,
+				"Cleanup job %s failed on node %s: %s", job.Name, result.Node, result.Message)
 			continue
 		}
-		remaining.Items = append(remaining.Items, *job)
+		logger.Info("Deleted successful cleanup job", "name", job.Name, "node", result.Node)
+	}
+	if statusUpdated {
+		if err := r.patchStatus(ctx, ais); err != nil {
+			return nil, err
+		}
 	}
-
 	return remaining, nil
 }
 
-func (r *Reconciler) cleanupHostStateMounts(ctx context.Context, ais *aisv1.AIStore) error {
+// cleanupJobResult returns the progress of a cleanup job. Jobs still running past their deadline are failed,
+// in case the Job controller cannot run them, e.g. when the node is gone.
+func cleanupJobResult(job *batchv1.Job) aisv1.HostCleanupResult {
+	result := aisv1.HostCleanupResult{
+		Node:  job.Annotations[cmn.CleanupNodeAnnotation],
+		Job:   job.Name,
+		Phase: aisv1.HostCleanupRunning,
+	}
+	if result.Node == "" {
+		// Jobs created by earlier operator versions only have the node in their name
+		result.Node = job.Name
+	}
+	deadline := cmn.StateCleanupDeadline
+	if job.Spec.ActiveDeadlineSeconds != nil {
+		deadline = time.Duration(*job.Spec.ActiveDeadlineSeconds)*time.Second + cleanupJobGracePeriod
+	}
+	if job.Status.Succeeded > 0 {
+		result.Phase = aisv1.HostCleanupSucceeded
+		return result
+	}
+	for i := range job.Status.Conditions {
+		cond := &job.Status.Conditions[i]
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			result.Phase = aisv1.HostCleanupFailed
+			result.Message = cond.Reason
+			if cond.Message != "" {
+				result.Message += ": " + cond.Message
+			}
+			return result
+		}
+	}
+	if time.Since(job.CreationTimestamp.Time) > deadline {
+		result.Phase = aisv1.HostCleanupFailed
+		result.Message = "job did not finish within " + deadline.String()
+	}
+	return result
+}
+
+// setHostCleanupResult records the result of the cleanup job on a node, returning whether the status changed.
+func setHostCleanupResult(ais *aisv1.AIStore, result aisv1.HostCleanupResult) bool {
+	for i := range ais.Status.HostCleanup {
+		if ais.Status.HostCleanup[i].Node != result.Node {
+			continue
+		}
+		if ais.Status.HostCleanup[i] == result {
+			return false
+		}
+		ais.Status.HostCleanup[i] = result
+		return true
+	}
+	ais.Status.HostCleanup = append(ais.Status.HostCleanup, result)
+	return true
+}
+
+func (r *Reconciler) cleanupHostMounts(ctx context.Context, ais *aisv1.AIStore) error {
 	nodeNames, err := r.k8sClient.ListNodesMatchingAISSelectors(ctx, ais)
 	if err != nil {
 		return err
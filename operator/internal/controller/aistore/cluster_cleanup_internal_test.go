@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+)
+
+func TestDeleteFinishedJobs(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"}}
+	newJob := func(name, node string, status batchv1.JobStatus) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         ais.Namespace,
+				Labels:            cmn.SelectorLabels(ais.Name, cmn.CleanupPrefix),
+				Annotations:       map[string]string{cmn.CleanupNodeAnnotation: node},
+				CreationTimestamp: metav1.Now(),
+			},
+			Spec:   batchv1.JobSpec{ActiveDeadlineSeconds: aisapc.Ptr(int64(cmn.HostDataCleanupDeadline.Seconds()))},
+			Status: status,
+		}
+	}
+	failed := batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+		Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit",
+	}}}
+	expired := newJob("cleanup-node-4-x", "node-4", batchv1.JobStatus{})
+	expired.CreationTimestamp = metav1.NewTime(time.Now().Add(-cmn.HostDataCleanupDeadline - 2*cleanupJobGracePeriod))
+	otherCluster := newJob("cleanup-node-1-y", "node-1", batchv1.JobStatus{})
+	otherCluster.Labels = cmn.SelectorLabels("other", cmn.CleanupPrefix)
+	c := newFakeClientBuilder(g).WithObjects(
+		ais,
+		newJob("cleanup-node-1-x", "node-1", batchv1.JobStatus{Succeeded: 1}),
+		newJob("cleanup-node-2-x", "node-2", failed),
+		newJob("cleanup-node-3-x", "node-3", batchv1.JobStatus{Active: 1}),
+		expired,
+		otherCluster,
+	).WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+	recorder := r.recorder.(*events.FakeRecorder)
+
+	jobs, err := r.listCleanupJobs(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(jobs.Items).To(HaveLen(4))
+	remaining, err := r.deleteFinishedJobs(ctx, ais, jobs)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(remaining.Items).To(HaveLen(1))
+	g.Expect(remaining.Items[0].Name).To(Equal("cleanup-node-3-x"))
+
+	g.Expect(ais.Status.HostCleanup).To(ConsistOf(
+		aisv1.HostCleanupResult{Node: "node-1", Job: "cleanup-node-1-x", Phase: aisv1.HostCleanupSucceeded},
+		aisv1.HostCleanupResult{Node: "node-2", Job: "cleanup-node-2-x", Phase: aisv1.HostCleanupFailed,
+			Message: "BackoffLimitExceeded: Job has reached the specified backoff limit"},
+		aisv1.HostCleanupResult{Node: "node-3", Job: "cleanup-node-3-x", Phase: aisv1.HostCleanupRunning},
+		HaveField("Phase", aisv1.HostCleanupFailed),
+	))
+	g.Expect(recorder.Events).To(HaveLen(2))
+	stored := &aisv1.AIStore{}
+	g.Expect(c.Get(ctx, ais.NamespacedName(), stored)).To(Succeed())
+	g.Expect(stored.Status.HostCleanup).To(HaveLen(4))
+
+	// Only the running job and the job of the other cluster are left
+	left := &batchv1.JobList{}
+	g.Expect(c.List(ctx, left)).To(Succeed())
+	g.Expect(left.Items).To(HaveLen(2))
+}
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	aiscmn "github.com/NVIDIA/aistore/cmn"
@@ -24,8 +25,10 @@ import (
 	"github.com/ais-operator/internal/services"
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/go-logr/logr"
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	apiv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -42,6 +45,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
@@ -49,6 +53,7 @@ const (
 	aisShutdownRequeueDelay  = 5 * time.Second
 	aisReadinessRequeueDelay = 3 * time.Second
 	cleanupJobDelay          = 5 * time.Second
+	cleanupJobGracePeriod    = time.Minute
 	externalSvcDelay         = 2 * time.Second
 )
 
@@ -59,6 +64,11 @@ type (
 		log           logr.Logger
 		recorder      events.EventRecorder
 		clientManager services.AISClientManagerInterface
+		tlsProber     services.TLSProberInterface
+		// operatorNamespace selects the operator pods allowed by default in NetworkPolicies
+		operatorNamespace string
+		// oidcChecks holds the time of the last failed OIDC issuer discovery per cluster
+		oidcChecks sync.Map
 	}
 )
 
@@ -68,14 +78,17 @@ func NewReconciler(c *aisclient.K8sClient, recorder events.EventRecorder, logger
 		log:           logger,
 		recorder:      recorder,
 		clientManager: clientManager,
+		tlsProber:     services.NewTLSProber(),
 	}
 }
 
-func NewReconcilerFromMgr(mgr manager.Manager, aisClientTLSOpts services.AISClientTLSOpts, logger logr.Logger) *Reconciler {
+func NewReconcilerFromMgr(mgr manager.Manager, aisClientTLSOpts services.AISClientTLSOpts, cacheAdminTokens bool, logger logr.Logger) *Reconciler {
 	c := aisclient.NewClientFromMgr(mgr)
 	recorder := mgr.GetEventRecorder("ais-controller")
-	clientManager := services.NewAISClientManager(c, aisClientTLSOpts)
-	return NewReconciler(c, recorder, logger, clientManager)
+	clientManager := services.NewAISClientManager(c, aisClientTLSOpts, cacheAdminTokens)
+	r := NewReconciler(c, recorder, logger, clientManager)
+	r.operatorNamespace = detectOperatorNamespace()
+	return r
 }
 
 // +kubebuilder:rbac:groups=ais.nvidia.com,resources=aistores,verbs=get;list;watch;create;update;patch;delete
@@ -83,19 +96,23 @@ func NewReconcilerFromMgr(mgr manager.Manager, aisClientTLSOpts services.AISClie
 // +kubebuilder:rbac:groups=ais.nvidia.com,resources=aistores/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=statefulsets;deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=services;configmaps,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;delete;
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=list;watch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=create;list;watch;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;patch;update;delete
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=create;list;watch;delete
 // +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses;networkpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;tlsroutes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list
 // +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -174,8 +191,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.finalize(ctx, ais)
 	}
 
-	if result, err := r.ensurePrereqs(ctx, ais); err != nil || !result.IsZero() {
+	result, recheck, err := r.ensurePrereqs(ctx, ais)
+	if err != nil {
 		return result, err
+	} else if !result.IsZero() {
+		return earliestRequeue(result, recheck), nil
 	}
 
 	if ais.Status.IntraClusterURL == "" {
@@ -186,11 +206,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	if !ais.IsConditionTrue(aisv1.ConditionCreated) {
-		return r.bootstrapNew(ctx, ais)
+		if result, err = r.bootstrapNew(ctx, ais); err != nil {
+			return result, err
+		}
+		return earliestRequeue(result, recheck), nil
 	}
 
-	if res, err := r.handleCREvents(ctx, ais); err != nil || !res.IsZero() {
-		return res, err
+	scalingResult, err := r.evaluateScalingPolicies(ctx, ais)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if res, err := r.handleCREvents(ctx, ais); err != nil {
+		return earliestRequeue(res, scalingResult), err
+	} else if !res.IsZero() {
+		return earliestRequeue(earliestRequeue(res, scalingResult), recheck), nil
 	}
 
 	// Delete any deprecated statsd ConfigMap.
@@ -198,7 +228,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		r.recordError(ctx, ais, err, "Failed to reconcile deletion of StatsD ConfigMap")
 		return reconcile.Result{}, err
 	}
-	return reconcile.Result{}, nil
+	return earliestRequeue(scalingResult, recheck), nil
 }
 
 func (r *Reconciler) determineAutoScaleStatus(ctx context.Context, ais *aisv1.AIStore) error {
@@ -218,6 +248,13 @@ func (r *Reconciler) determineAutoScaleStatus(ctx context.Context, ais *aisv1.AI
 		// We include both nodes that are schedulable and nodes that currently contain AIStore pods to avoid unnecessary scale-downs
 		// when a node that's still running an AIStore pod becomes unschedulable.
 		unionNodes := unionSorted(schedulableNodes, podNodes)
+		// Nodes running the targets of pools cannot host other targets, and their pods also match the target labels
+		poolNodes, err := r.listTargetPoolNodes(ctx, ais)
+		if err != nil {
+			logger.Error(err, "Unable to fetch nodes running target pool pods for autoScaleStatus")
+			return err
+		}
+		unionNodes = slices.DeleteFunc(unionNodes, func(node string) bool { return slices.Contains(poolNodes, node) })
 		logger.Info("Discovered autoScaleStatus target nodes", "targetNodes", unionNodes)
 		autoScaleStatus.ExpectedTargetNodes = unionNodes
 	}
@@ -280,13 +317,15 @@ func (r *Reconciler) shutdownCluster(ctx context.Context, ais *aisv1.AIStore) (r
 		return reconcile.Result{RequeueAfter: aisShutdownRequeueDelay}, err
 	}
 
-	// Scale target statefulset to 0 and wait for it to finish
-	if _, err = r.k8sClient.UpdateStatefulSetReplicas(ctx, target.StatefulSetNSName(ais), 0); err != nil {
-		return reconcile.Result{}, err
-	}
-	targetFinished, err := r.k8sClient.IsStatefulSetSize(ctx, target.StatefulSetNSName(ais), 0)
-	if err != nil || !targetFinished {
-		return reconcile.Result{RequeueAfter: aisShutdownRequeueDelay}, err
+	// Scale target statefulsets to 0 and wait for them to finish
+	for _, view := range append([]*aisv1.AIStore{ais}, ais.TargetPoolViews()...) {
+		if _, err = r.k8sClient.UpdateStatefulSetReplicas(ctx, target.StatefulSetNSName(view), 0); err != nil {
+			return reconcile.Result{}, err
+		}
+		targetFinished, err := r.k8sClient.IsStatefulSetSize(ctx, target.StatefulSetNSName(view), 0)
+		if err != nil || !targetFinished {
+			return reconcile.Result{RequeueAfter: aisShutdownRequeueDelay}, err
+		}
 	}
 
 	err = r.updateStatusWithState(ctx, ais, aisv1.ClusterShutdown)
@@ -340,12 +379,12 @@ func (r *Reconciler) cleanupClusterRes(ctx context.Context, ais *aisv1.AIStore)
 
 func (r *Reconciler) cleanupHost(ctx context.Context, ais *aisv1.AIStore) (reconcile.Result, error) {
 	// Get cleanup jobs
-	jobs, err := r.listCleanupJobs(ctx, ais.Namespace)
+	jobs, err := r.listCleanupJobs(ctx, ais)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
-	// Delete all finished or expired jobs
-	remainingJobs, err := r.deleteFinishedJobs(ctx, jobs)
+	// Record the result of each job and delete all finished or expired jobs
+	remainingJobs, err := r.deleteFinishedJobs(ctx, ais, jobs)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -445,47 +484,76 @@ func (r *Reconciler) attemptGracefulShutdown(ctx context.Context, ais *aisv1.AIS
 
 // reconcileResources is responsible for reconciling all resources that given
 // AIStore CRD is managing. It handles initial reconcile as well as any updates.
-func (r *Reconciler) reconcileResources(ctx context.Context, ais *aisv1.AIStore) (err error) {
+// The result requeues when operator-issued certificates are due for renewal.
+func (r *Reconciler) reconcileResources(ctx context.Context, ais *aisv1.AIStore) (renewal ctrl.Result, err error) {
 	_, err = ais.ValidateSpec(ctx)
 	if err != nil {
 		r.recordError(ctx, ais, err, "Failed to validate AIStore spec")
-		return err
+		return ctrl.Result{}, err
 	}
 
 	globalCM, err := cmn.NewGlobalCM(ais)
 	if err != nil {
 		r.recordError(ctx, ais, err, "Failed to construct global config")
-		return err
+		return ctrl.Result{}, err
 	}
 
 	// 1. Deploy RBAC resources.
 	err = r.createOrUpdateRBACResources(ctx, ais)
 	if err != nil {
 		r.recordError(ctx, ais, err, "Failed to create/update RBAC resources")
-		return err
+		return ctrl.Result{}, err
 	}
 
-	// 2. Reconcile TLS certificate if configured.
-	if err = r.reconcileTLSCertificate(ctx, ais); err != nil {
+	// 2. Reconcile TLS certificate if configured, with the multihome addresses assigned so far.
+	if err = r.updateMultihomeAddresses(ctx, ais); err != nil {
+		r.recordError(ctx, ais, err, "Failed to update multihome addresses")
+		return ctrl.Result{}, err
+	}
+	if renewal, err = r.reconcileTLSCertificate(ctx, ais); err != nil {
 		r.recordError(ctx, ais, err, "Failed to reconcile TLS certificate")
-		return err
+		return ctrl.Result{}, err
+	}
+
+	// 3. Merge the trust bundle if configured.
+	if err = r.reconcileTrustBundle(ctx, ais); err != nil {
+		r.recordError(ctx, ais, err, "Failed to reconcile trust bundle")
+		return ctrl.Result{}, err
 	}
 
-	// 3. Deploy global cluster ConfigMap.
+	// 4. Deploy global cluster ConfigMap.
 	if err = r.k8sClient.Apply(ctx, globalCM); err != nil {
 		r.recordError(ctx, ais, err, "Failed to deploy global cluster ConfigMap")
-		return err
+		return ctrl.Result{}, err
 	}
 
-	// 4. Deploy admin client if enabled.
+	// 5. Deploy admin client if enabled.
 	if err = r.reconcileAdminClient(ctx, ais); err != nil {
 		r.recordError(ctx, ais, err, "Failed to reconcile admin client")
-		return err
+		return ctrl.Result{}, err
+	}
+
+	// 6. Restrict access to AIS pods if configured.
+	if err = r.reconcileNetworkPolicies(ctx, ais); err != nil {
+		r.recordError(ctx, ais, err, "Failed to reconcile NetworkPolicies")
+		return ctrl.Result{}, err
+	}
+
+	// 7. Create local PVs for target mounts if configured.
+	if err = r.reconcileLocalPVs(ctx, ais); err != nil {
+		r.recordError(ctx, ais, err, "Failed to reconcile local PVs")
+		return ctrl.Result{}, err
+	}
+
+	// 8. Report pods migrating to a new state storage.
+	if err = r.updateStateMigrationStatus(ctx, ais); err != nil {
+		r.recordError(ctx, ais, err, "Failed to update state migration status")
+		return ctrl.Result{}, err
 	}
 
 	// FIXME: We should also move the logic from `bootstrapNew` and `handleCREvents`.
 
-	return nil
+	return renewal, nil
 }
 
 func (r *Reconciler) reconcileDeprecatedStatsDCM(ctx context.Context, ais *aisv1.AIStore) error {
@@ -506,11 +574,20 @@ func (r *Reconciler) reconcileDeprecatedStatsDCM(ctx context.Context, ais *aisv1
 	return err
 }
 
-func (r *Reconciler) ensurePrereqs(ctx context.Context, ais *aisv1.AIStore) (result ctrl.Result, err error) {
+// ensurePrereqs reconciles the resources the cluster depends on. A non-zero result stops the reconcile until
+// it requeues, while recheck requeues resources that need another look later, e.g. certificate renewals,
+// without blocking the rest of the reconcile.
+func (r *Reconciler) ensurePrereqs(ctx context.Context, ais *aisv1.AIStore) (result, recheck ctrl.Result, err error) {
 	// Reconcile basic resources like RBAC and ConfigMaps.
-	if err = r.reconcileResources(ctx, ais); err != nil {
-		return result, err
+	if recheck, err = r.reconcileResources(ctx, ais); err != nil {
+		return result, recheck, err
+	}
+
+	oidcRetry, err := r.reconcileOIDCIssuers(ctx, ais)
+	if err != nil {
+		return result, recheck, err
 	}
+	recheck = earliestRequeue(recheck, oidcRetry)
 
 	result, err = r.reconcileExternalAccess(ctx, ais)
 	if err != nil || !result.IsZero() {
@@ -525,9 +602,26 @@ func (r *Reconciler) ensurePrereqs(ctx context.Context, ais *aisv1.AIStore) (res
 	return
 }
 
-// If the cluster needs external access, create service(s) for targets and/or proxies and wait for ingress to be allocated.
+// reconcileExternalAccess creates the routes, NodePort and LoadBalancer services exposing proxies and targets,
+// waits for LoadBalancer ingress to be allocated, and reports the resulting endpoints.
 func (r *Reconciler) reconcileExternalAccess(ctx context.Context, ais *aisv1.AIStore) (result ctrl.Result, err error) {
-	if !ais.ProxyExternalAccessEnabled() && !ais.TargetExternalAccessEnabled() {
+	if err = r.reconcileExternalRoutes(ctx, ais); err != nil {
+		r.recordError(ctx, ais, err, "Failed to reconcile external routes")
+		return result, err
+	}
+	if err = r.reconcileNodePorts(ctx, ais); err != nil {
+		r.recordError(ctx, ais, err, "Failed to reconcile node port services")
+		return result, err
+	}
+	if result, err = r.reconcileLoadBalancers(ctx, ais); err != nil || !result.IsZero() {
+		return result, err
+	}
+	return result, r.updateExternalEndpoints(ctx, ais)
+}
+
+// If the cluster needs LoadBalancers, create service(s) for targets and/or proxies and wait for ingress to be allocated.
+func (r *Reconciler) reconcileLoadBalancers(ctx context.Context, ais *aisv1.AIStore) (result ctrl.Result, err error) {
+	if !ais.ProxyLoadBalancerEnabled() && !ais.TargetLoadBalancerEnabled() {
 		return
 	}
 	if err = r.createExternalServices(ctx, ais); err != nil {
@@ -553,12 +647,12 @@ func (r *Reconciler) reconcileExternalAccess(ctx context.Context, ais *aisv1.AIS
 // createExternalServices applies the service(s) for proxies and/or targets.
 // Both sets are created together so we can subsequently wait on them in a single pass.
 func (r *Reconciler) createExternalServices(ctx context.Context, ais *aisv1.AIStore) error {
-	if ais.ProxyExternalAccessEnabled() {
+	if ais.ProxyLoadBalancerEnabled() {
 		if err := r.createProxyExternalService(ctx, ais); err != nil {
 			return err
 		}
 	}
-	if ais.TargetExternalAccessEnabled() {
+	if ais.TargetLoadBalancerEnabled() {
 		if err := r.createTargetExternalServices(ctx, ais); err != nil {
 			return err
 		}
@@ -566,15 +660,15 @@ func (r *Reconciler) createExternalServices(ctx context.Context, ais *aisv1.AISt
 	return nil
 }
 
-// waitForExternalSvcReady reports whether all enabled external access services have ingress allocated.
+// waitForExternalSvcReady reports whether all enabled LoadBalancer services have ingress allocated.
 func (r *Reconciler) waitForExternalSvcReady(ctx context.Context, ais *aisv1.AIStore) (bool, error) {
-	if ais.ProxyExternalAccessEnabled() {
+	if ais.ProxyLoadBalancerEnabled() {
 		ready, err := r.proxyExternalSvcReady(ctx, ais)
 		if err != nil || !ready {
 			return false, err
 		}
 	}
-	if ais.TargetExternalAccessEnabled() {
+	if ais.TargetLoadBalancerEnabled() {
 		ready, err := r.targetExternalSvcReady(ctx, ais)
 		if err != nil || !ready {
 			return false, err
@@ -627,17 +721,29 @@ func (r *Reconciler) handleCREvents(ctx context.Context, ais *aisv1.AIStore) (ct
 		return r.updateStatusAndRequeue(ctx, ais, res)
 	}
 
+	// Drains are coordinated before syncing the target PDB, which blocks evictions until they are rebalanced
+	drainResult, err := r.reconcileTargetDrains(ctx, ais)
+	if err != nil {
+		r.recordError(ctx, ais, err, "Failed to coordinate node drains")
+		return ctrl.Result{}, err
+	}
+
+	if err := r.updateTargetDomains(ctx, ais); err != nil {
+		r.recordError(ctx, ais, err, "Failed to update target topology domains")
+		return ctrl.Result{}, err
+	}
+
 	if res, err := r.handleTargetState(ctx, ais); err != nil {
 		return res, err
 	} else if !res.IsZero() {
-		return r.updateStatusAndRequeue(ctx, ais, res)
+		return r.updateStatusAndRequeue(ctx, ais, earliestRequeue(res, drainResult))
 	}
 
 	ready, err := r.checkAISClusterReady(ctx, ais)
 	if err != nil {
 		return ctrl.Result{}, err
 	} else if !ready {
-		return r.updateStatusAndRequeue(ctx, ais, ctrl.Result{RequeueAfter: aisReadinessRequeueDelay})
+		return r.updateStatusAndRequeue(ctx, ais, earliestRequeue(ctrl.Result{RequeueAfter: aisReadinessRequeueDelay}, drainResult))
 	}
 
 	// Enable the rebalance condition (still respects the spec desired rebalance.Enabled property)
@@ -652,7 +758,31 @@ func (r *Reconciler) handleCREvents(ctx context.Context, ais *aisv1.AIStore) (ct
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, r.handleSuccessfulReconcile(ctx, ais)
+	// Pods serving a previous certificate do not affect readiness, but are tracked until they serve the current one
+	tlsResult, err := r.reconcileServedCertificate(ctx, ais)
+	if err != nil {
+		r.recordError(ctx, ais, err, "Failed to reconcile served TLS certificate")
+		return ctrl.Result{}, err
+	}
+
+	if err = r.handleSuccessfulReconcile(ctx, ais); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	gcResult, err := r.collectGarbage(ctx, ais)
+	if err != nil {
+		r.recordError(ctx, ais, err, "Failed to collect orphaned resources")
+		return ctrl.Result{}, err
+	}
+	return earliestRequeue(earliestRequeue(tlsResult, gcResult), drainResult), nil
+}
+
+// earliestRequeue returns the result requeuing soonest, ignoring results that do not requeue
+func earliestRequeue(a, b ctrl.Result) ctrl.Result {
+	if a.RequeueAfter == 0 || (b.RequeueAfter != 0 && b.RequeueAfter < a.RequeueAfter) {
+		return b
+	}
+	return a
 }
 
 // updateStatusAndRequeue updates the cluster status to indicate it's upgrading when we need to requeue.
@@ -717,7 +847,16 @@ func (r *Reconciler) patchAISAnnotations(ctx context.Context, ais *aisv1.AIStore
 		ais.Annotations[cmn.ConfigHashAnnotation] = confHash
 	}
 	ais.Annotations[cmn.RestartConfigHashAnnotation] = restartHash
-	return r.k8sClient.Patch(ctx, ais, k8sclient.MergeFrom(original))
+	return r.k8sClient.Patch(ctx, patchedObject(ais), k8sclient.MergeFrom(original))
+}
+
+// patchedObject returns the cluster to patch and read back. The view of a target pool is patched through a copy,
+// so the cluster spec read back does not replace the spec of the pool, see aisv1.AIStore.ForTargetPool.
+func patchedObject(ais *aisv1.AIStore) *aisv1.AIStore {
+	if ais.Spec.TargetSpec.Pool != "" {
+		return ais.DeepCopy()
+	}
+	return ais
 }
 
 // Given cluster config, compute the hash, update the cluster if it does not match, and return hash if changed
@@ -793,21 +932,28 @@ func (r *Reconciler) createOrUpdateRBACResources(ctx context.Context, ais *aisv1
 }
 
 // discoverPublicNetHosts returns target+proxy public network hosts per
-// publicNetDNSMode, reusing Status.AutoScaleStatus when populated.
+// publicNetDNSMode, reusing Status.AutoScaleStatus when populated, and their multihome addresses.
 func (r *Reconciler) discoverPublicNetHosts(ctx context.Context, ais *aisv1.AIStore) ([]string, error) {
 	mode := ais.GetPublicNetDNSMode()
 	if mode == aisv1.PubNetDNSModePod {
-		return nil, nil
+		return multihomeHosts(ais), nil
 	}
 	targetHosts, err := r.targetPublicHosts(ctx, ais, mode)
 	if err != nil {
 		return nil, err
 	}
+	for _, view := range ais.TargetPoolViews() {
+		poolHosts, err := r.targetPublicHosts(ctx, view, mode)
+		if err != nil {
+			return nil, err
+		}
+		targetHosts = append(targetHosts, poolHosts...)
+	}
 	proxyHosts, err := r.proxyPublicHosts(ctx, ais, mode)
 	if err != nil {
 		return nil, err
 	}
-	hosts := slices.Concat(targetHosts, proxyHosts)
+	hosts := slices.Concat(targetHosts, proxyHosts, multihomeHosts(ais))
 	slices.Sort(hosts)
 	return slices.Compact(hosts), nil
 }
@@ -821,9 +967,16 @@ func (r *Reconciler) targetPublicHosts(ctx context.Context, ais *aisv1.AIStore,
 		if listErr != nil {
 			return nil, listErr
 		}
-		hosts = publicHostsForNodes(nodes, mode)
+		hosts = publicHostsForNodes(nodes, mode, ais.IPFamilies())
 	}
-	if !ais.TargetExternalAccessEnabled() {
+	// Advertised hostnames stay valid when LoadBalancer addresses change
+	if ea := ais.Spec.TargetSpec.ExternalAccess; ea.AdvertisesHostname() {
+		for i := range ais.GetTargetSize() {
+			hosts = append(hosts, ais.ExternalHostname(ea, i))
+		}
+		return hosts, nil
+	}
+	if !ais.TargetLoadBalancerEnabled() {
 		return hosts, nil
 	}
 	endpoints, err := r.externalEndpoints(ctx, ais, target.ServiceLabelLB)
@@ -842,9 +995,12 @@ func (r *Reconciler) proxyPublicHosts(ctx context.Context, ais *aisv1.AIStore, m
 		if listErr != nil {
 			return nil, listErr
 		}
-		hosts = publicHostsForNodes(nodes, mode)
+		hosts = publicHostsForNodes(nodes, mode, ais.IPFamilies())
 	}
-	if !ais.ProxyExternalAccessEnabled() {
+	if ea := ais.Spec.ProxySpec.ExternalAccess; ea.AdvertisesHostname() {
+		return append(hosts, ais.ExternalHostname(ea, 0)), nil
+	}
+	if !ais.ProxyLoadBalancerEnabled() {
 		return hosts, nil
 	}
 	endpoints, err := r.externalEndpoints(ctx, ais, proxy.ServiceLabelLB)
@@ -868,18 +1024,27 @@ func (r *Reconciler) externalEndpoints(ctx context.Context, ais *aisv1.AIStore,
 	return certres.LoadBalancerEndpoints(svcList.Items...), nil
 }
 
-func (r *Reconciler) reconcileTLSCertificate(ctx context.Context, ais *aisv1.AIStore) error {
+// reconcileTLSCertificate reconciles the certificates of the cluster, returning when the operator-issued
+// ones are due for renewal. Certificates from cert-manager are renewed by cert-manager.
+func (r *Reconciler) reconcileTLSCertificate(ctx context.Context, ais *aisv1.AIStore) (ctrl.Result, error) {
+	renewal, err := r.reconcileSelfSignedTLS(ctx, ais)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err = r.reconcileOperatorClientCertificate(ctx, ais); err != nil {
+		return ctrl.Result{}, err
+	}
 	// Create a Certificate if configured in spec (not using csi-driver or pre-existing secret)
 	if ais.UseTLSCertificate() {
 		publicHosts, err := r.discoverPublicNetHosts(ctx, ais)
 		if err != nil {
-			return err
+			return ctrl.Result{}, err
 		}
-		return r.k8sClient.Apply(ctx, cmn.NewCertificate(ais, publicHosts))
+		return renewal, r.k8sClient.Apply(ctx, cmn.NewCertificate(ais, publicHosts))
 	}
 	// Delete Certificate if it exists
-	_, err := r.k8sClient.DeleteResourceIfExists(ctx, cmn.TLSCertificate(ais))
-	return err
+	_, err = r.k8sClient.DeleteResourceIfExists(ctx, cmn.TLSCertificate(ais))
+	return renewal, err
 }
 
 // reconcileAdminClient handles creating, updating, or deleting the admin client deployment.
@@ -994,7 +1159,8 @@ func (r *Reconciler) updateAutoScaleStatus(ctx context.Context, ais *aisv1.AISto
 		return nil
 	}
 	logf.FromContext(ctx).Info("Updating autoScaleStatus", "status", status)
-	ais.Status.AutoScaleStatus = status
+	ais.Status.AutoScaleStatus.ExpectedProxyNodes = status.ExpectedProxyNodes
+	ais.Status.AutoScaleStatus.ExpectedTargetNodes = status.ExpectedTargetNodes
 	return r.patchStatus(ctx, ais)
 }
 
@@ -1021,7 +1187,7 @@ func (r *Reconciler) patchStatus(ctx context.Context, ais *aisv1.AIStore) error
 	}
 	patch := k8sclient.RawPatch(types.MergePatchType, patchBytes)
 
-	err = r.k8sClient.Status().Patch(ctx, ais, patch)
+	err = r.k8sClient.Status().Patch(ctx, patchedObject(ais), patch)
 	if err != nil {
 		r.recordError(ctx, ais, err, "Failed to patch CR status")
 	}
@@ -1041,14 +1207,50 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			if !ok1 || !ok2 {
 				return false
 			}
-			return !reflect.DeepEqual(oldNode.Spec.Taints, newNode.Spec.Taints)
+			// The mount-discovery agent reports filesystems of the node in an annotation
+			return !reflect.DeepEqual(oldNode.Spec.Taints, newNode.Spec.Taints) ||
+				oldNode.Spec.Unschedulable != newNode.Spec.Unschedulable ||
+				oldNode.Annotations[cmn.MountpathsAnnotation] != newNode.Annotations[cmn.MountpathsAnnotation]
+		},
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return true
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+	}
+	secretPredicate := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSecret, ok1 := e.ObjectOld.(*corev1.Secret)
+			newSecret, ok2 := e.ObjectNew.(*corev1.Secret)
+			if !ok1 || !ok2 {
+				return false
+			}
+			return !reflect.DeepEqual(oldSecret.Data, newSecret.Data)
 		},
 		CreateFunc: func(_ event.CreateEvent) bool {
 			return true
 		},
 		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return false
+		},
+	}
+	configMapPredicate := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldConfigMap, ok1 := e.ObjectOld.(*corev1.ConfigMap)
+			newConfigMap, ok2 := e.ObjectNew.(*corev1.ConfigMap)
+			if !ok1 || !ok2 {
+				return false
+			}
+			return !reflect.DeepEqual(oldConfigMap.Data, newConfigMap.Data) ||
+				!reflect.DeepEqual(oldConfigMap.BinaryData, newConfigMap.BinaryData)
+		},
+		CreateFunc: func(_ event.CreateEvent) bool {
 			return true
 		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return false
+		},
 	}
 	podPredicate := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
@@ -1057,7 +1259,9 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			if !ok1 || !ok2 {
 				return true
 			}
-			return oldPod.Spec.NodeName != newPod.Spec.NodeName
+			// Multus reports the addresses of multihome attachments after the pod is scheduled
+			return oldPod.Spec.NodeName != newPod.Spec.NodeName ||
+				oldPod.Annotations[nadv1.NetworkStatusAnnot] != newPod.Annotations[nadv1.NetworkStatusAnnot]
 		},
 		CreateFunc: func(_ event.CreateEvent) bool {
 			return true
@@ -1066,7 +1270,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			return true
 		},
 	}
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&aisv1.AIStore{}).
 		Watches(&corev1.Node{},
 			handler.EnqueueRequestsFromMapFunc(r.findAISClustersForNode),
@@ -1076,11 +1280,55 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findAISClustersForPod),
 			builder.WithPredicates(podPredicate),
 		).
+		// Certificate renewals update the TLS Secret without changing the Certificate's generation
+		Watches(&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findAISClustersForSecret),
+			builder.WithPredicates(secretPredicate),
+		).
+		// Trust sources are not owned by the cluster
+		Watches(&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.findAISClustersForConfigMap),
+			builder.WithPredicates(configMapPredicate),
+		).
+		// Local PVs are cluster-scoped and cannot be owned by the cluster
+		Watches(&corev1.PersistentVolume{},
+			handler.EnqueueRequestsFromMapFunc(r.findAISClusterForLocalPV),
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(o k8sclient.Object) bool {
+				_, ok := o.GetLabels()[cmn.LocalPVNamespaceLabel]
+				return ok
+			})),
+		).
 		Owns(&apiv1.StatefulSet{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.Service{}).
-		Owns(&certmanagerv1.Certificate{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
-		Complete(r)
+		Owns(&networkingv1.Ingress{}).
+		Owns(&networkingv1.NetworkPolicy{})
+	// cert-manager is optional when clusters use existing or self-signed TLS certificates
+	served, err := r.k8sClient.IsKindServed(certmanagerv1.SchemeGroupVersion.WithKind(certmanagerv1.CertificateKind))
+	if err != nil {
+		return err
+	}
+	if served {
+		b = b.Owns(&certmanagerv1.Certificate{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+	} else {
+		r.log.Info("cert-manager Certificates are not served, spec.tls.certificate is unavailable")
+	}
+	// Gateway API routes are optional, and their status reports whether external endpoints are ready
+	for kind, route := range map[aisv1.GatewayRouteKind]k8sclient.Object{
+		aisv1.GatewayRouteKindHTTP: &gwapiv1.HTTPRoute{},
+		aisv1.GatewayRouteKindTLS:  &gwapiv1.TLSRoute{},
+	} {
+		served, err = r.k8sClient.IsKindServed(gwapiv1.SchemeGroupVersion.WithKind(string(kind)))
+		if err != nil {
+			return err
+		}
+		if served {
+			b = b.Owns(route)
+		} else {
+			r.log.Info("Gateway API routes are not served, Gateway external access is unavailable", "kind", kind)
+		}
+	}
+	return b.Complete(r)
 }
 
 func (r *Reconciler) findAISClustersForNode(ctx context.Context, o k8sclient.Object) []reconcile.Request {
@@ -1125,6 +1373,17 @@ func (r *Reconciler) findAISClustersForNode(ctx context.Context, o k8sclient.Obj
 			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ais.Namespace, Name: ais.Name}})
 			continue
 		}
+		// Cordoned and uncordoned nodes start and end the maintenance of their targets
+		if ais.NodeDrainEnabled() || len(ais.Status.TargetDrains) > 0 {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ais.Namespace, Name: ais.Name}})
+			continue
+		}
+		// Nodes joining or leaving the target node selector gain or lose local PVs and discovered mountpaths,
+		// and relabeled nodes change the domains of their targets
+		if len(ais.LocalPVMounts()) > 0 || ais.Spec.TargetSpec.MountDiscovery != nil || ais.Spec.TargetSpec.TopologySpread != nil {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ais.Namespace, Name: ais.Name}})
+			continue
+		}
 
 		logger.Info("Node changes do not require AIStore resource update", "cr", ais.GetName())
 	}
@@ -1259,11 +1518,11 @@ func unionSorted(a, b []string) []string {
 }
 
 // nodeAffectsTLSCertificate reports whether a change to node could change the
-// SAN list of AIS's operator-managed TLS Certificate. A node contributes a SAN
+// SAN list of AIS's operator-managed TLS certificate. A node contributes a SAN
 // only if it can actually host a daemon pod (its labels match the node selector
 // and tolerates the taints).
 func (r *Reconciler) nodeAffectsTLSCertificate(ctx context.Context, node *corev1.Node, ais *aisv1.AIStore) bool {
-	if !ais.UseTLSCertificate() || ais.GetPublicNetDNSMode() == aisv1.PubNetDNSModePod {
+	if (!ais.UseTLSCertificate() && !ais.UseTLSSelfSigned()) || ais.GetPublicNetDNSMode() == aisv1.PubNetDNSModePod {
 		return false
 	}
 	if r.nodeMatchesSelector(node, ais.Spec.ProxySpec.NodeSelector) &&
@@ -1320,10 +1579,11 @@ func (r *Reconciler) checkAISClusterReady(ctx context.Context, ais *aisv1.AIStor
 		)
 		return
 	}
-	if targetCount < ais.GetMinReadyTargets() {
+	// Targets of pools have a fixed size, so all of them are expected
+	if targetCount < ais.GetMinReadyTargets()+ais.GetTargetPoolsSize() {
 		logger.Info(
 			"AIS cluster is not ready, target count does not match spec",
-			"smapTargets", targetCount, "expectedTargets", ais.GetTargetSize(),
+			"smapTargets", targetCount, "expectedTargets", ais.GetClusterTargetSize(),
 		)
 		return
 	}
@@ -1129,6 +1129,20 @@ var _ = Describe("AIStoreController", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(hosts).To(ContainElements(nodeName, lbIP, lbHostname))
 		})
+
+		It("uses external-dns hostnames instead of external endpoints", func(ctx SpecContext) {
+			r, ais := reconcilerWithLB(target.ServiceLabelLB)
+			ais.Spec.TargetSpec.Size = apc.Ptr[int32](2)
+			ais.Spec.TargetSpec.ExternalAccess = &aisv1.ExternalAccessSpec{
+				HostnameTemplate: apc.Ptr("{cluster}-{index}.example.com"),
+				ExternalDNS:      &aisv1.ExternalDNSSpec{Zone: "example.com"},
+			}
+
+			hosts, err := r.targetPublicHosts(ctx, ais, aisv1.PubNetDNSModeIP)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hosts).To(ContainElements("ais-0.example.com", "ais-1.example.com"))
+			Expect(hosts).ToNot(ContainElements(lbIP, lbHostname))
+		})
 	})
 
 	Describe("shouldUpdatePVCRetentionPolicy", func() {
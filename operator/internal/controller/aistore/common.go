@@ -569,9 +569,10 @@ func toleratesTaints(ctx context.Context, tolerations []corev1.Toleration, node
 }
 
 // publicHostsForNodes returns host strings per publicNetDNSMode: node names for
-// `publicNetDNSMode: Node`, primary IPs for `publicNetDNSMode: IP`, and no hosts
-// for `publicNetDNSMode: Pod`. Caller is responsible for sort/dedup.
-func publicHostsForNodes(nodes []corev1.Node, mode aisv1.PubNetDNSMode) []string {
+// `publicNetDNSMode: Node`, IPs for `publicNetDNSMode: IP`, and no hosts
+// for `publicNetDNSMode: Pod`. IPs are the primary IP of each node, or one IP
+// per given IP family. Caller is responsible for sort/dedup.
+func publicHostsForNodes(nodes []corev1.Node, mode aisv1.PubNetDNSMode, families []corev1.IPFamily) []string {
 	if mode == aisv1.PubNetDNSModePod {
 		return nil
 	}
@@ -583,8 +584,16 @@ func publicHostsForNodes(nodes []corev1.Node, mode aisv1.PubNetDNSMode) []string
 		}
 	case aisv1.PubNetDNSModeIP:
 		for i := range nodes {
-			if ip := aisclient.NodePrimaryIP(&nodes[i]); ip != "" {
-				hosts = append(hosts, ip)
+			if len(families) == 0 {
+				if ip := aisclient.NodePrimaryIP(&nodes[i]); ip != "" {
+					hosts = append(hosts, ip)
+				}
+				continue
+			}
+			for _, family := range families {
+				if ip := aisclient.NodeIP(&nodes[i], family); ip != "" {
+					hosts = append(hosts, ip)
+				}
 			}
 		}
 	}
@@ -16,6 +16,26 @@ const (
 	EventReasonDecommissionCompleted = "DecommissionCompleted"
 	EventReasonDeleted               = "CRDeleted"
 	EventReasonUpdated               = "CRUpdated"
+	EventReasonOIDCDiscoveryFailed   = "OIDCDiscoveryFailed"
+	EventReasonCertificateIssued     = "CertificateIssued"
+	EventReasonCARotated             = "CARotated"
+	EventReasonCertificateRotated    = "CertificateRotated"
+	EventReasonCertificateReloaded   = "CertificateReloaded"
+	EventReasonCertificateRestart    = "CertificateRestart"
+	EventReasonTrustBundleUpdated    = "TrustBundleUpdated"
+	EventReasonHostCleanupFailed     = "HostCleanupFailed"
+	EventReasonStateCopyStarted      = "StateCopyStarted"
+	EventReasonStateCopyFailed       = "StateCopyFailed"
+	EventReasonOrphanFound           = "OrphanFound"
+	EventReasonOrphanDeleted         = "OrphanDeleted"
+	EventReasonPDBUpdated            = "PDBUpdated"
+	EventReasonNodeDraining          = "NodeDraining"
+	EventReasonDrainRebalanced       = "DrainRebalanced"
+	EventReasonNodeUncordoned        = "NodeUncordoned"
+	EventReasonScheduleChanged       = "ScheduleChanged"
+	EventReasonProxyScaled           = "ProxyScaled"
+	EventReasonMetricUnavailable     = "MetricUnavailable"
+	EventReasonLocalPVReleased       = "LocalPVReleased"
 )
 
 // Actions to be used in events
@@ -29,4 +49,9 @@ const (
 	ActionInitExternalSvc   = "InitExternalService"
 	ActionInitTargets       = "InitTargets"
 	ActionInitProxies       = "InitProxies"
+	ActionHostCleanup       = "HostCleanup"
+	ActionMigrateState      = "MigrateState"
+	ActionCollectGarbage    = "CollectGarbage"
+	ActionCoordinateDrain   = "CoordinateDrain"
+	ActionScale             = "Scale"
 )
@@ -0,0 +1,316 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// externalRouteComponent groups the routes of a daemon type exposed through Gateway API routes or Ingresses.
+type externalRouteComponent struct {
+	daemonType string
+	ea         *aisv1.ExternalAccessSpec
+	label      string
+	routes     []*cmn.ExternalRoute
+}
+
+func newExternalRouteComponents(ais *aisv1.AIStore) []externalRouteComponent {
+	components := []externalRouteComponent{
+		{daemonType: aisapc.Proxy, ea: ais.Spec.ProxySpec.ExternalAccess, label: proxy.ServiceLabelRoute},
+		{daemonType: aisapc.Target, ea: ais.Spec.TargetSpec.ExternalAccess, label: target.ServiceLabelRoute},
+	}
+	if components[0].ea.UsesRoutes() {
+		components[0].routes = []*cmn.ExternalRoute{proxy.NewExternalRoute(ais)}
+	}
+	if components[1].ea.UsesRoutes() {
+		components[1].routes = target.NewExternalRouteList(ais)
+	}
+	return components
+}
+
+// reconcileExternalRoutes applies the Services and routes of components exposed through Gateway API routes or
+// Ingresses, and deletes the ones no longer configured, e.g. for removed target ordinals or a changed type.
+func (r *Reconciler) reconcileExternalRoutes(ctx context.Context, ais *aisv1.AIStore) error {
+	for _, c := range newExternalRouteComponents(ais) {
+		if len(c.routes) > 0 {
+			// Routes replace the LoadBalancer Services of the component
+			if err := r.deleteLoadBalancers(ctx, ais, c.daemonType); err != nil {
+				return err
+			}
+		}
+		for _, route := range c.routes {
+			if err := r.k8sClient.Apply(ctx, cmn.NewRouteSVC(ais, route)); err != nil {
+				return err
+			}
+			if err := r.k8sClient.Apply(ctx, cmn.NewExternalRoute(ais, c.ea, route)); err != nil {
+				if meta.IsNoMatchError(err) {
+					return fmt.Errorf("%s is not served by the API server, install its CRD to use %s external access: %w",
+						cmn.ExternalRouteKind(ais, c.ea), c.ea.GetType(), err)
+				}
+				return err
+			}
+		}
+		if err := r.pruneExternalRoutes(ctx, ais, &c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileNodePorts applies the NodePort Services of components exposed on a node port, replacing their
+// LoadBalancer Services, and deletes the NodePort Services no longer configured.
+func (r *Reconciler) reconcileNodePorts(ctx context.Context, ais *aisv1.AIStore) error {
+	if ais.Spec.ProxySpec.ExternalAccess.UsesNodePort() {
+		if err := r.deleteLoadBalancers(ctx, ais, aisapc.Proxy); err != nil {
+			return err
+		}
+		if err := r.k8sClient.Apply(ctx, proxy.NewProxyNodePortSVC(ais)); err != nil {
+			return err
+		}
+	} else if _, err := r.k8sClient.DeleteServiceIfExists(ctx, proxy.NodePortSVCNSName(ais)); err != nil {
+		return err
+	}
+	if ais.Spec.TargetSpec.ExternalAccess.UsesNodePort() {
+		if err := r.deleteLoadBalancers(ctx, ais, aisapc.Target); err != nil {
+			return err
+		}
+		return r.k8sClient.Apply(ctx, target.NewTargetNodePortSVC(ais))
+	}
+	_, err := r.k8sClient.DeleteServiceIfExists(ctx, target.NodePortSVCNSName(ais))
+	return err
+}
+
+func (r *Reconciler) deleteLoadBalancers(ctx context.Context, ais *aisv1.AIStore, daemonType string) (err error) {
+	if daemonType == aisapc.Proxy {
+		_, err = r.k8sClient.DeleteServiceIfExists(ctx, proxy.LoadBalancerSVCNSName(ais))
+	} else {
+		_, err = r.k8sClient.DeleteAllServicesIfExist(ctx, ais.Namespace, cmn.NewServiceLabels(ais.Name, target.ServiceLabelLB))
+	}
+	return err
+}
+
+// pruneExternalRoutes deletes the route Services, routes and Ingresses of a component that are not in c.routes.
+func (r *Reconciler) pruneExternalRoutes(ctx context.Context, ais *aisv1.AIStore, c *externalRouteComponent) error {
+	desired := make(map[string]struct{}, 2*len(c.routes))
+	for _, route := range c.routes {
+		desired["Service/"+route.Name] = struct{}{}
+		desired[cmn.ExternalRouteKind(ais, c.ea)+"/"+route.Name] = struct{}{}
+	}
+	lists := map[string]k8sclient.ObjectList{
+		"Service":       &corev1.ServiceList{},
+		cmn.IngressKind: &networkingv1.IngressList{},
+	}
+	for _, kind := range []string{string(aisv1.GatewayRouteKindHTTP), string(aisv1.GatewayRouteKindTLS)} {
+		served, err := r.k8sClient.IsKindServed(gwapiv1.SchemeGroupVersion.WithKind(kind))
+		if err != nil {
+			return err
+		}
+		if !served {
+			continue
+		}
+		if kind == string(aisv1.GatewayRouteKindHTTP) {
+			lists[kind] = &gwapiv1.HTTPRouteList{}
+		} else {
+			lists[kind] = &gwapiv1.TLSRouteList{}
+		}
+	}
+	for kind, list := range lists {
+		err := r.k8sClient.List(ctx, list, k8sclient.InNamespace(ais.Namespace), k8sclient.MatchingLabels(cmn.NewServiceLabels(ais.Name, c.label)))
+		if err != nil {
+			return err
+		}
+		objs, err := meta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+		for _, o := range objs {
+			obj, ok := o.(k8sclient.Object)
+			if !ok {
+				continue
+			}
+			if _, keep := desired[kind+"/"+obj.GetName()]; keep || !metav1.IsControlledBy(obj, ais) {
+				continue
+			}
+			if _, err := r.k8sClient.DeleteResourceIfExists(ctx, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateExternalEndpoints reports the LoadBalancer addresses, node ports and routed hostnames of the cluster in its status.
+// LoadBalancers published with external-dns are reported with their hostnames.
+func (r *Reconciler) updateExternalEndpoints(ctx context.Context, ais *aisv1.AIStore) error {
+	var endpoints []aisv1.ExternalEndpoint
+	if ais.ProxyLoadBalancerEnabled() {
+		endpoint, err := r.loadBalancerEndpoint(ctx, proxy.LoadBalancerSVCNSName(ais))
+		if err != nil {
+			return err
+		}
+		if ea := ais.Spec.ProxySpec.ExternalAccess; ea.UsesExternalDNS() {
+			endpoint.Address = ais.ExternalHostname(ea, 0)
+		}
+		endpoint.Component = aisapc.Proxy
+		endpoints = append(endpoints, endpoint)
+	}
+	if ais.TargetLoadBalancerEnabled() {
+		for i := range ais.GetTargetSize() {
+			endpoint, err := r.loadBalancerEndpoint(ctx, target.LoadBalancerSVCNSName(ais, i))
+			if err != nil {
+				return err
+			}
+			if ea := ais.Spec.TargetSpec.ExternalAccess; ea.UsesExternalDNS() {
+				endpoint.Address = ais.ExternalHostname(ea, i)
+			}
+			endpoint.Component, endpoint.Pod = aisapc.Target, target.PodName(ais, i)
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	nodePorts := []struct {
+		daemonType string
+		ea         *aisv1.ExternalAccessSpec
+		labels     map[string]string
+	}{
+		{aisapc.Proxy, ais.Spec.ProxySpec.ExternalAccess, proxy.SelectorLabels(ais)},
+		{aisapc.Target, ais.Spec.TargetSpec.ExternalAccess, target.SelectorLabels(ais)},
+	}
+	for _, np := range nodePorts {
+		if !np.ea.UsesNodePort() {
+			continue
+		}
+		podEndpoints, err := r.nodePortEndpoints(ctx, ais, np.labels, np.ea.NodePort.Port)
+		if err != nil {
+			return err
+		}
+		for i := range podEndpoints {
+			podEndpoints[i].Component = np.daemonType
+		}
+		endpoints = append(endpoints, podEndpoints...)
+	}
+	for _, c := range newExternalRouteComponents(ais) {
+		if len(c.routes) == 0 {
+			continue
+		}
+		kind := cmn.ExternalRouteKind(ais, c.ea)
+		for i, route := range c.routes {
+			ready, err := r.externalRouteReady(ctx, kind, types.NamespacedName{Name: route.Name, Namespace: ais.Namespace})
+			if err != nil {
+				return err
+			}
+			endpoint := aisv1.ExternalEndpoint{Component: c.daemonType, Address: route.Hostname, Ready: ready}
+			if c.daemonType == aisapc.Target {
+				endpoint.Pod = target.PodName(ais, int32(i))
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	if reflect.DeepEqual(ais.Status.ExternalEndpoints, endpoints) {
+		return nil
+	}
+	ais.Status.ExternalEndpoints = endpoints
+	return r.patchStatus(ctx, ais)
+}
+
+func (r *Reconciler) loadBalancerEndpoint(ctx context.Context, name types.NamespacedName) (aisv1.ExternalEndpoint, error) {
+	var endpoint aisv1.ExternalEndpoint
+	svc, err := r.k8sClient.GetService(ctx, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return endpoint, nil
+		}
+		return endpoint, err
+	}
+	for _, ing := range svc.Status.LoadBalancer.Ingress {
+		if ing.IP != "" || ing.Hostname != "" {
+			endpoint.Address = ing.IP
+			if endpoint.Address == "" {
+				endpoint.Address = ing.Hostname
+			}
+			endpoint.Ready = true
+			break
+		}
+	}
+	return endpoint, nil
+}
+
+// nodePortEndpoints returns the node IP and node port pair of each scheduled pod matching the given labels,
+// ready once the pod is.
+func (r *Reconciler) nodePortEndpoints(ctx context.Context, ais *aisv1.AIStore, labels map[string]string, port int32) ([]aisv1.ExternalEndpoint, error) {
+	pods, err := r.k8sClient.ListPods(ctx, ais, labels)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([]aisv1.ExternalEndpoint, 0, len(pods.Items))
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.HostIP == "" {
+			continue
+		}
+		endpoints = append(endpoints, aisv1.ExternalEndpoint{
+			Pod:     pod.Name,
+			Address: net.JoinHostPort(pod.Status.HostIP, strconv.Itoa(int(port))),
+			Ready:   isPodReady(pod),
+		})
+	}
+	slices.SortFunc(endpoints, func(a, b aisv1.ExternalEndpoint) int { return strings.Compare(a.Pod, b.Pod) })
+	return endpoints, nil
+}
+
+// externalRouteReady reports whether a route is accepted by all of its Gateways, or an Ingress has an address.
+func (r *Reconciler) externalRouteReady(ctx context.Context, kind string, name types.NamespacedName) (bool, error) {
+	switch kind {
+	case cmn.IngressKind:
+		ingress := &networkingv1.Ingress{}
+		if err := r.k8sClient.Get(ctx, name, ingress); err != nil {
+			return false, k8sclient.IgnoreNotFound(err)
+		}
+		return len(ingress.Status.LoadBalancer.Ingress) > 0, nil
+	case string(aisv1.GatewayRouteKindTLS):
+		route := &gwapiv1.TLSRoute{}
+		if err := r.k8sClient.Get(ctx, name, route); err != nil {
+			return false, k8sclient.IgnoreNotFound(err)
+		}
+		return routeAccepted(route.Status.Parents), nil
+	default:
+		route := &gwapiv1.HTTPRoute{}
+		if err := r.k8sClient.Get(ctx, name, route); err != nil {
+			return false, k8sclient.IgnoreNotFound(err)
+		}
+		return routeAccepted(route.Status.Parents), nil
+	}
+}
+
+func routeAccepted(parents []gwapiv1.RouteParentStatus) bool {
+	if len(parents) == 0 {
+		return false
+	}
+	for i := range parents {
+		if !meta.IsStatusConditionTrue(parents[i].Conditions, string(gwapiv1.RouteConditionAccepted)) {
+			return false
+		}
+	}
+	return true
+}
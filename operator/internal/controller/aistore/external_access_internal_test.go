@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestReconcileExternalRoutes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	scheme := newTestScheme(g, gwapiv1.Install)
+
+	serviceSpec := aisv1.ServiceSpec{ServicePort: intstr.FromInt32(51080), PublicPort: intstr.FromInt32(51081)}
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"},
+		Spec: aisv1.AIStoreSpec{
+			Size: aisapc.Ptr(int32(2)),
+			ProxySpec: aisv1.DaemonSpec{ServiceSpec: serviceSpec, ExternalAccess: &aisv1.ExternalAccessSpec{
+				Type:             aisapc.Ptr(aisv1.ExternalAccessGateway),
+				HostnameTemplate: aisapc.Ptr("{cluster}.example.com"),
+				Gateway:          &aisv1.GatewayAccessSpec{ParentRefs: []aisv1.GatewayParentRef{{Name: "gw"}}},
+			}},
+			TargetSpec: aisv1.TargetSpec{DaemonSpec: aisv1.DaemonSpec{ServiceSpec: serviceSpec, ExternalAccess: &aisv1.ExternalAccessSpec{
+				Type:             aisapc.Ptr(aisv1.ExternalAccessIngress),
+				HostnameTemplate: aisapc.Ptr("{cluster}-{index}.example.com"),
+			}}},
+		},
+	}
+	// LoadBalancer left from a previous type
+	targetLB := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name: target.LoadBalancerSVCNSName(ais, 0).Name, Namespace: ais.Namespace,
+		Labels: cmn.NewServiceLabels(ais.Name, target.ServiceLabelLB),
+	}}
+	// Serve the Gateway API kinds, which the default fake RESTMapper does not know
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for gvk := range scheme.AllKnownTypes() {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).WithObjects(ais, targetLB).WithStatusSubresource(ais, &gwapiv1.HTTPRoute{}).Build()
+	r := newTestReconciler(c, nil)
+	exists := func(name string, obj k8sclient.Object) bool {
+		err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ais.Namespace}, obj)
+		g.Expect(k8serrors.IsNotFound(err) || err == nil).To(BeTrue())
+		return err == nil
+	}
+
+	g.Expect(r.reconcileExternalRoutes(ctx, ais)).To(Succeed())
+	g.Expect(exists(targetLB.Name, &corev1.Service{})).To(BeFalse())
+	route := &gwapiv1.HTTPRoute{}
+	g.Expect(exists("ais-proxy-route", route)).To(BeTrue())
+	g.Expect(route.Spec.Hostnames).To(ConsistOf(gwapiv1.Hostname("ais.example.com")))
+	g.Expect(exists("ais-proxy-route", &corev1.Service{})).To(BeTrue())
+	for _, name := range []string{"ais-target-0-route", "ais-target-1-route"} {
+		g.Expect(exists(name, &networkingv1.Ingress{})).To(BeTrue())
+		g.Expect(exists(name, &corev1.Service{})).To(BeTrue())
+	}
+
+	route.Status.Parents = []gwapiv1.RouteParentStatus{{
+		ParentRef:  gwapiv1.ParentReference{Name: "gw"},
+		Conditions: []metav1.Condition{{Type: string(gwapiv1.RouteConditionAccepted), Status: metav1.ConditionTrue, Reason: "Accepted"}},
+	}}
+	g.Expect(c.Status().Update(ctx, route)).To(Succeed())
+	g.Expect(r.updateExternalEndpoints(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.ExternalEndpoints).To(Equal([]aisv1.ExternalEndpoint{
+		{Component: aisapc.Proxy, Address: "ais.example.com", Ready: true},
+		{Component: aisapc.Target, Pod: "ais-target-0", Address: "ais-0.example.com"},
+		{Component: aisapc.Target, Pod: "ais-target-1", Address: "ais-1.example.com"},
+	}))
+
+	// Scaling down and changing the route kind removes the stale objects
+	ais.Spec.Size = aisapc.Ptr(int32(1))
+	ais.Spec.ProxySpec.ExternalAccess.Gateway.RouteKind = aisapc.Ptr(aisv1.GatewayRouteKindTLS)
+	g.Expect(r.reconcileExternalRoutes(ctx, ais)).To(Succeed())
+	g.Expect(exists("ais-proxy-route", &gwapiv1.HTTPRoute{})).To(BeFalse())
+	g.Expect(exists("ais-proxy-route", &gwapiv1.TLSRoute{})).To(BeTrue())
+	g.Expect(exists("ais-target-1-route", &networkingv1.Ingress{})).To(BeFalse())
+	g.Expect(exists("ais-target-1-route", &corev1.Service{})).To(BeFalse())
+	g.Expect(exists("ais-target-0-route", &networkingv1.Ingress{})).To(BeTrue())
+
+	// Removing external access removes all routes
+	ais.Spec.ProxySpec.ExternalAccess, ais.Spec.TargetSpec.ExternalAccess = nil, nil
+	g.Expect(r.reconcileExternalRoutes(ctx, ais)).To(Succeed())
+	g.Expect(exists("ais-proxy-route", &gwapiv1.TLSRoute{})).To(BeFalse())
+	g.Expect(exists("ais-target-0-route", &networkingv1.Ingress{})).To(BeFalse())
+	g.Expect(r.updateExternalEndpoints(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.ExternalEndpoints).To(BeNil())
+}
+
+func TestReconcileNodePorts(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	serviceSpec := aisv1.ServiceSpec{ServicePort: intstr.FromInt32(51080), PublicPort: intstr.FromInt32(51081)}
+	nodePort := func(port int32) *aisv1.ExternalAccessSpec {
+		return &aisv1.ExternalAccessSpec{Type: aisapc.Ptr(aisv1.ExternalAccessNodePort), NodePort: &aisv1.NodePortAccessSpec{Port: port}}
+	}
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"},
+		Spec: aisv1.AIStoreSpec{
+			Size:       aisapc.Ptr(int32(2)),
+			ProxySpec:  aisv1.DaemonSpec{ServiceSpec: serviceSpec, ExternalAccess: nodePort(30080)},
+			TargetSpec: aisv1.TargetSpec{DaemonSpec: aisv1.DaemonSpec{ServiceSpec: serviceSpec, ExternalAccess: nodePort(30081)}},
+		},
+	}
+	// LoadBalancer left from a previous type
+	proxyLB := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: proxy.LoadBalancerSVCNSName(ais).Name, Namespace: ais.Namespace}}
+	targetPod := func(name string, ready corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ais.Namespace, Labels: target.SelectorLabels(ais)},
+			Status: corev1.PodStatus{
+				HostIP:     "10.0.0." + name[len(name)-1:],
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+			},
+		}
+	}
+	pending := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "ais-target-2", Namespace: ais.Namespace, Labels: target.SelectorLabels(ais)}}
+	c := newFakeClientBuilder(g).
+		WithObjects(ais, proxyLB, targetPod("ais-target-0", corev1.ConditionTrue), targetPod("ais-target-1", corev1.ConditionFalse), pending).
+		WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+	getService := func(name types.NamespacedName) (*corev1.Service, error) {
+		svc := &corev1.Service{}
+		return svc, c.Get(ctx, name, svc)
+	}
+
+	g.Expect(r.reconcileNodePorts(ctx, ais)).To(Succeed())
+	_, err := getService(proxy.LoadBalancerSVCNSName(ais))
+	g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	svc, err := getService(proxy.NodePortSVCNSName(ais))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(svc.Spec.Type).To(Equal(corev1.ServiceTypeNodePort))
+	g.Expect(svc.Spec.Ports[0].NodePort).To(Equal(int32(30080)))
+	svc, err = getService(target.NodePortSVCNSName(ais))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(svc.Spec.Ports[0].NodePort).To(Equal(int32(30081)))
+	g.Expect(svc.Spec.ExternalTrafficPolicy).To(Equal(corev1.ServiceExternalTrafficPolicyLocal))
+
+	g.Expect(r.updateExternalEndpoints(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.ExternalEndpoints).To(Equal([]aisv1.ExternalEndpoint{
+		{Component: aisapc.Target, Pod: "ais-target-0", Address: "10.0.0.0:30081", Ready: true},
+		{Component: aisapc.Target, Pod: "ais-target-1", Address: "10.0.0.1:30081"},
+	}))
+
+	// Switching back to LoadBalancers removes the NodePort Services
+	ais.Spec.ProxySpec.ExternalAccess, ais.Spec.TargetSpec.ExternalAccess = &aisv1.ExternalAccessSpec{}, nil
+	g.Expect(r.reconcileNodePorts(ctx, ais)).To(Succeed())
+	for _, name := range []types.NamespacedName{proxy.NodePortSVCNSName(ais), target.NodePortSVCNSName(ais)} {
+		_, err = getService(name)
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	}
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/services"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// testEventBufferSize is the number of events the fake recorder of a test reconciler holds before blocking
+const testEventBufferSize = 32
+
+// newTestScheme returns a scheme with the built-in and AIStore types, and the types of addToScheme
+func newTestScheme(g *WithT, addToScheme ...func(*runtime.Scheme) error) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	g.Expect(aisv1.AddToScheme(scheme)).To(Succeed())
+	for _, add := range addToScheme {
+		g.Expect(add(scheme)).To(Succeed())
+	}
+	return scheme
+}
+
+// newFakeClientBuilder returns a fake client builder with the scheme of newTestScheme
+func newFakeClientBuilder(g *WithT, addToScheme ...func(*runtime.Scheme) error) *fake.ClientBuilder {
+	return fake.NewClientBuilder().WithScheme(newTestScheme(g, addToScheme...))
+}
+
+// newTestReconciler returns a Reconciler using the fake client and the AIS client manager, if any, recording
+// events to a fake recorder
+func newTestReconciler(c k8sclient.Client, clientManager services.AISClientManagerInterface) *Reconciler {
+	recorder := events.NewFakeRecorder(testEventBufferSize)
+	return NewReconciler(aisclient.NewClient(c, c.Scheme()), recorder, logr.Discard(), clientManager)
+}
@@ -0,0 +1,274 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// orphan is a resource labeled for an AIStore cluster that no longer uses it
+type orphan struct {
+	obj     k8sclient.Object
+	kind    string
+	cluster string
+	reason  aisv1.OrphanReason
+}
+
+// collectGarbage runs a garbage collection pass once the interval since the last one elapsed, and returns when
+// to run the next one. It only runs on ready clusters, so resources of ordinals beyond the size are no longer
+// needed by a scale-down in progress.
+func (r *Reconciler) collectGarbage(ctx context.Context, ais *aisv1.AIStore) (ctrl.Result, error) {
+	if !ais.GarbageCollectionEnabled() {
+		if ais.Status.GarbageCollection == nil {
+			return ctrl.Result{}, nil
+		}
+		ais.Status.GarbageCollection = nil
+		return ctrl.Result{}, r.patchStatus(ctx, ais)
+	}
+	interval := ais.Spec.GarbageCollection.GetInterval()
+	if status := ais.Status.GarbageCollection; status != nil {
+		if wait := time.Until(status.LastRunTime.Add(interval)); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	orphans, err := r.findOrphans(ctx, ais)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	// Annotations only keep whole seconds
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	var kept []aisv1.OrphanedResource
+	for i := range orphans {
+		res, err := r.collectOrphan(ctx, ais, &orphans[i], now)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if res != nil {
+			kept = append(kept, *res)
+		}
+	}
+	slices.SortFunc(kept, func(a, b aisv1.OrphanedResource) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Name, b.Name))
+	})
+	ais.Status.GarbageCollection = &aisv1.GarbageCollectionStatus{LastRunTime: now, OrphanedResources: kept}
+	if err = r.patchStatus(ctx, ais); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// findOrphans lists the PVCs, per-ordinal target LoadBalancer Services, and finished cleanup Jobs of the namespace
+// left by scale-downs of this cluster or by clusters that no longer exist. Resources of other existing clusters
+// are left to their own garbage collection.
+func (r *Reconciler) findOrphans(ctx context.Context, ais *aisv1.AIStore) ([]orphan, error) {
+	clusters, err := r.k8sClient.ListAIStoreCR(ctx, ais.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool, len(clusters.Items))
+	for i := range clusters.Items {
+		existing[clusters.Items[i].Name] = true
+	}
+	// reason returns why the resource of the cluster is orphaned, if it is
+	reason := func(cluster string, beyondSize func() bool) (aisv1.OrphanReason, bool) {
+		switch {
+		case !existing[cluster]:
+			return aisv1.OrphanReasonClusterDeleted, true
+		case cluster == ais.Name && beyondSize():
+			return aisv1.OrphanReasonScaledDown, true
+		}
+		return "", false
+	}
+	inNamespace := []k8sclient.ListOption{k8sclient.InNamespace(ais.Namespace), k8sclient.HasLabels{cmn.LabelAppPrefixed}}
+
+	sizes, err := r.statefulSetSizes(ctx, ais)
+	if err != nil {
+		return nil, err
+	}
+	var orphans []orphan
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := r.k8sClient.List(ctx, pvcs, inNamespace...); err != nil {
+		return nil, err
+	}
+	for i := range pvcs.Items {
+		pvc := &pvcs.Items[i]
+		cluster, component := pvc.Labels[cmn.LabelAppPrefixed], pvc.Labels[cmn.LabelComponentPrefixed]
+		if (component != aisapc.Target && component != aisapc.Proxy) || pvc.DeletionTimestamp != nil {
+			continue
+		}
+		why, ok := reason(cluster, func() bool { return beyondSize(pvc.Name, sizes) })
+		if ok {
+			orphans = append(orphans, orphan{obj: pvc, kind: "PersistentVolumeClaim", cluster: cluster, reason: why})
+		} else if cluster == ais.Name {
+			if err := r.clearOrphanedSince(ctx, pvc); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	svcs := &corev1.ServiceList{}
+	if err := r.k8sClient.List(ctx, svcs, append(inNamespace, k8sclient.MatchingLabels{cmn.LabelComponentPrefixed: target.ServiceLabelLB})...); err != nil {
+		return nil, err
+	}
+	for i := range svcs.Items {
+		svc := &svcs.Items[i]
+		cluster := svc.Labels[cmn.LabelAppPrefixed]
+		why, ok := reason(cluster, func() bool { return beyondSize(svc.Name, sizes) })
+		if ok && svc.DeletionTimestamp == nil {
+			orphans = append(orphans, orphan{obj: svc, kind: "Service", cluster: cluster, reason: why})
+		}
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := r.k8sClient.List(ctx, jobs, append(inNamespace, k8sclient.MatchingLabels{cmn.LabelComponentPrefixed: cmn.CleanupPrefix})...); err != nil {
+		return nil, err
+	}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		// Cleanup jobs only run while a cluster is deleted, so those of this ready cluster come from a previous one
+		// with the same name
+		cluster := job.Labels[cmn.LabelAppPrefixed]
+		if (existing[cluster] && cluster != ais.Name) || job.DeletionTimestamp != nil {
+			continue
+		}
+		if cleanupJobResult(job).Phase != aisv1.HostCleanupRunning {
+			orphans = append(orphans, orphan{obj: job, kind: "Job", cluster: cluster, reason: aisv1.OrphanReasonClusterDeleted})
+		}
+	}
+	return orphans, nil
+}
+
+// statefulSetSizes returns the number of pods of each StatefulSet of the cluster by name, including those of target
+// pools and of pools removed from the spec, as the larger of its replicas and the size in the spec
+func (r *Reconciler) statefulSetSizes(ctx context.Context, ais *aisv1.AIStore) (map[string]int32, error) {
+	sizes := map[string]int32{
+		proxy.StatefulSetNSName(ais).Name:  ais.GetProxySize(),
+		target.StatefulSetNSName(ais).Name: ais.GetTargetSize(),
+	}
+	for _, view := range ais.TargetPoolViews() {
+		sizes[target.StatefulSetNSName(view).Name] = view.GetTargetSize()
+	}
+	for name := range sizes {
+		ss, err := r.k8sClient.GetStatefulSet(ctx, types.NamespacedName{Namespace: ais.Namespace, Name: name})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ss.Spec.Replicas != nil && *ss.Spec.Replicas > sizes[name] {
+			sizes[name] = *ss.Spec.Replicas
+		}
+	}
+	removed, err := r.listRemovedTargetPools(ctx, ais)
+	if err != nil {
+		return nil, err
+	}
+	for i := range removed {
+		sizes[removed[i].Name] = 0
+		if replicas := removed[i].Spec.Replicas; replicas != nil {
+			sizes[removed[i].Name] = *replicas
+		}
+	}
+	return sizes, nil
+}
+
+// beyondSize reports whether the resource of a StatefulSet ordinal is beyond the size of every StatefulSet it
+// may belong to, as the names of pools are appended to the name of the target StatefulSet
+func beyondSize(name string, sizes map[string]int32) bool {
+	var matched bool
+	for ssName, size := range sizes {
+		ordinal, ok := cmn.ResourceOrdinal(name, ssName)
+		if !ok {
+			continue
+		}
+		if ordinal < size {
+			return false
+		}
+		matched = true
+	}
+	return matched
+}
+
+// collectOrphan deletes the orphaned resource, or PVCs once kept for the retention period, and returns the
+// PVCs it keeps.
+func (r *Reconciler) collectOrphan(ctx context.Context, ais *aisv1.AIStore, o *orphan, now metav1.Time) (*aisv1.OrphanedResource, error) {
+	logger := logf.FromContext(ctx).WithValues("kind", o.kind, "name", o.obj.GetName(), "cluster", o.cluster, "reason", o.reason)
+	res := &aisv1.OrphanedResource{Kind: o.kind, Name: o.obj.GetName(), Cluster: o.cluster, Reason: o.reason, Since: now}
+	if pvc, ok := o.obj.(*corev1.PersistentVolumeClaim); ok {
+		since, err := r.markOrphanedSince(ctx, pvc, now)
+		if err != nil {
+			return nil, err
+		}
+		if since.Equal(&now) {
+			logger.Info("Found orphaned resource")
+			r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonOrphanFound, ActionCollectGarbage,
+				"Found orphaned %s %s of cluster %s (%s)", o.kind, res.Name, o.cluster, o.reason)
+		}
+		res.Since = since
+		retention := ais.Spec.GarbageCollection.PVCRetention
+		if retention == nil {
+			return res, nil
+		}
+		res.DeleteAfter = &metav1.Time{Time: since.Add(retention.Duration)}
+		if now.Before(res.DeleteAfter) {
+			return res, nil
+		}
+	}
+
+	propagation := k8sclient.PropagationPolicy(metav1.DeletePropagationBackground)
+	if _, err := r.k8sClient.DeleteResourceIfExists(ctx, o.obj, propagation); err != nil {
+		return nil, fmt.Errorf("failed to delete orphaned %s %s: %w", o.kind, res.Name, err)
+	}
+	logger.Info("Deleted orphaned resource")
+	r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonOrphanDeleted, ActionCollectGarbage,
+		"Deleted orphaned %s %s of cluster %s (%s)", o.kind, res.Name, o.cluster, o.reason)
+	return nil, nil
+}
+
+// markOrphanedSince returns when the PVC was first found orphaned, annotating it on the first pass finding it
+func (r *Reconciler) markOrphanedSince(ctx context.Context, pvc *corev1.PersistentVolumeClaim, now metav1.Time) (metav1.Time, error) {
+	if value, ok := pvc.Annotations[cmn.OrphanedSinceAnnotation]; ok {
+		if since, err := time.Parse(time.RFC3339, value); err == nil {
+			return metav1.NewTime(since), nil
+		}
+	}
+	patch := k8sclient.MergeFrom(pvc.DeepCopy())
+	if pvc.Annotations == nil {
+		pvc.Annotations = map[string]string{}
+	}
+	pvc.Annotations[cmn.OrphanedSinceAnnotation] = now.UTC().Format(time.RFC3339)
+	return now, r.k8sClient.Patch(ctx, pvc, patch)
+}
+
+// clearOrphanedSince removes the orphaned annotation of a PVC used again, e.g. after scaling back up
+func (r *Reconciler) clearOrphanedSince(ctx context.Context, pvc *corev1.PersistentVolumeClaim) error {
+	if _, ok := pvc.Annotations[cmn.OrphanedSinceAnnotation]; !ok {
+		return nil
+	}
+	patch := k8sclient.MergeFrom(pvc.DeepCopy())
+	delete(pvc.Annotations, cmn.OrphanedSinceAnnotation)
+	logf.FromContext(ctx).Info("PVC is no longer orphaned", "name", pvc.Name)
+	return r.k8sClient.Patch(ctx, pvc, patch)
+}
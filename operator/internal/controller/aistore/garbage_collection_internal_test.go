@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestCollectGarbage(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	const ns = "ais-ns"
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: ns}}
+	ais.Spec.Size = aisapc.Ptr(int32(2))
+	ais.Spec.TargetPools = []aisv1.TargetPoolSpec{{Name: "nvme", Size: 1}}
+	ais.Spec.GarbageCollection = &aisv1.GarbageCollectionSpec{PVCRetention: &metav1.Duration{Duration: 24 * time.Hour}}
+	other := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: ns}}
+
+	orphanedSince := func(since time.Time) map[string]string {
+		return map[string]string{cmn.OrphanedSinceAnnotation: since.UTC().Format(time.RFC3339)}
+	}
+	newPVC := func(name, cluster, component string, annotations map[string]string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: ns, Labels: cmn.SelectorLabels(cluster, component), Annotations: annotations,
+		}}
+	}
+	newLBService := func(name, cluster string) *corev1.Service {
+		return &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: ns, Labels: cmn.NewServiceLabels(cluster, target.ServiceLabelLB),
+		}}
+	}
+	newCleanupJob := func(name, cluster string, succeeded int32) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name, Namespace: ns, Labels: cmn.SelectorLabels(cluster, cmn.CleanupPrefix),
+				CreationTimestamp: metav1.Now(),
+			},
+			Status: batchv1.JobStatus{Succeeded: succeeded},
+		}
+	}
+	twoDaysAgo := time.Now().Add(-48 * time.Hour)
+	// A pool removed from the spec while still running a target
+	removedPool := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "ais-target-hdd", Namespace: ns,
+			Labels: map[string]string{
+				cmn.LabelAppPrefixed: "ais", cmn.LabelComponentPrefixed: aisapc.Target, cmn.LabelTargetPool: "hdd",
+			},
+		},
+		Spec: appsv1.StatefulSetSpec{Replicas: aisapc.Ptr(int32(1))},
+	}
+
+	c := newFakeClientBuilder(g).WithObjects(
+		ais, other, removedPool,
+		// Used again after scaling back up
+		newPVC("ais-data-ais-target-1", "ais", aisapc.Target, orphanedSince(twoDaysAgo)),
+		// Scaled down
+		newPVC("ais-data-ais-target-2", "ais", aisapc.Target, nil),
+		newPVC("ais-data-ais-target-3", "ais", aisapc.Target, orphanedSince(twoDaysAgo)),
+		newPVC("ais-ns-ais-state-ais-proxy-2", "ais", aisapc.Proxy, nil),
+		// Of target pools, resolved against the StatefulSet of their pool
+		newPVC("ais-data-ais-target-nvme-0", "ais", aisapc.Target, nil),
+		newPVC("ais-data-ais-target-nvme-1", "ais", aisapc.Target, nil),
+		newPVC("ais-data-ais-target-hdd-0", "ais", aisapc.Target, nil),
+		newPVC("ais-data-ais-target-hdd-1", "ais", aisapc.Target, nil),
+		// Of a deleted cluster, or of another cluster
+		newPVC("data-old-target-0", "old", aisapc.Target, nil),
+		newPVC("data-other-target-5", "other", aisapc.Target, nil),
+		newLBService("ais-target-1", "ais"),
+		newLBService("ais-target-2", "ais"),
+		newLBService("ais-target-nvme-0", "ais"),
+		newLBService("ais-target-nvme-1", "ais"),
+		newLBService("old-target-0", "old"),
+		newCleanupJob("cleanup-node-1-abc", "old", 1),
+		newCleanupJob("cleanup-node-2-abc", "old", 0),
+		newCleanupJob("cleanup-node-1-def", "other", 1),
+	).WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+
+	result, err := r.collectGarbage(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{RequeueAfter: aisv1.DefaultGarbageCollectionInterval}))
+
+	status := ais.Status.GarbageCollection
+	g.Expect(status).NotTo(BeNil())
+	g.Expect(status.OrphanedResources).To(HaveLen(5))
+	for i, want := range []struct {
+		name    string
+		cluster string
+		reason  aisv1.OrphanReason
+	}{
+		{"ais-data-ais-target-2", "ais", aisv1.OrphanReasonScaledDown},
+		{"ais-data-ais-target-hdd-1", "ais", aisv1.OrphanReasonScaledDown},
+		{"ais-data-ais-target-nvme-1", "ais", aisv1.OrphanReasonScaledDown},
+		{"ais-ns-ais-state-ais-proxy-2", "ais", aisv1.OrphanReasonScaledDown},
+		{"data-old-target-0", "old", aisv1.OrphanReasonClusterDeleted},
+	} {
+		res := status.OrphanedResources[i]
+		g.Expect(res.Kind).To(Equal("PersistentVolumeClaim"))
+		g.Expect(res.Name).To(Equal(want.name))
+		g.Expect(res.Cluster).To(Equal(want.cluster))
+		g.Expect(res.Reason).To(Equal(want.reason))
+		g.Expect(res.Since).To(Equal(status.LastRunTime))
+		g.Expect(res.DeleteAfter.Time).To(Equal(status.LastRunTime.Add(24 * time.Hour)))
+	}
+
+	exists := func(obj k8sclient.Object, name string) bool {
+		err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ns}, obj)
+		g.Expect(err == nil || k8serrors.IsNotFound(err)).To(BeTrue())
+		return err == nil
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	g.Expect(exists(pvc, "ais-data-ais-target-1")).To(BeTrue())
+	g.Expect(pvc.Annotations).NotTo(HaveKey(cmn.OrphanedSinceAnnotation))
+	g.Expect(exists(pvc, "ais-data-ais-target-2")).To(BeTrue())
+	g.Expect(pvc.Annotations).To(HaveKey(cmn.OrphanedSinceAnnotation))
+	g.Expect(exists(pvc, "ais-data-ais-target-3")).To(BeFalse())
+	g.Expect(exists(pvc, "data-other-target-5")).To(BeTrue())
+	g.Expect(pvc.Annotations).NotTo(HaveKey(cmn.OrphanedSinceAnnotation))
+	g.Expect(exists(pvc, "ais-data-ais-target-nvme-0")).To(BeTrue())
+	g.Expect(pvc.Annotations).NotTo(HaveKey(cmn.OrphanedSinceAnnotation))
+	g.Expect(exists(pvc, "ais-data-ais-target-hdd-0")).To(BeTrue())
+	g.Expect(pvc.Annotations).NotTo(HaveKey(cmn.OrphanedSinceAnnotation))
+
+	svc := &corev1.Service{}
+	g.Expect(exists(svc, "ais-target-1")).To(BeTrue())
+	g.Expect(exists(svc, "ais-target-2")).To(BeFalse())
+	g.Expect(exists(svc, "ais-target-nvme-0")).To(BeTrue())
+	g.Expect(exists(svc, "ais-target-nvme-1")).To(BeFalse())
+	g.Expect(exists(svc, "old-target-0")).To(BeFalse())
+
+	job := &batchv1.Job{}
+	g.Expect(exists(job, "cleanup-node-1-abc")).To(BeFalse())
+	g.Expect(exists(job, "cleanup-node-2-abc")).To(BeTrue())
+	g.Expect(exists(job, "cleanup-node-1-def")).To(BeTrue())
+
+	// The next pass waits for the interval
+	result, err = r.collectGarbage(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(And(BeNumerically(">", 0), BeNumerically("<=", aisv1.DefaultGarbageCollectionInterval)))
+	g.Expect(ais.Status.GarbageCollection).To(Equal(status))
+
+	// Once disabled, the status is cleared
+	ais.Spec.GarbageCollection = nil
+	result, err = r.collectGarbage(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.IsZero()).To(BeTrue())
+	g.Expect(ais.Status.GarbageCollection).To(BeNil())
+}
+
+func TestEarliestRequeue(t *testing.T) {
+	g := NewWithT(t)
+	none, soon, later := ctrl.Result{}, ctrl.Result{RequeueAfter: time.Minute}, ctrl.Result{RequeueAfter: time.Hour}
+	g.Expect(earliestRequeue(none, none)).To(Equal(none))
+	g.Expect(earliestRequeue(none, later)).To(Equal(later))
+	g.Expect(earliestRequeue(soon, none)).To(Equal(soon))
+	g.Expect(earliestRequeue(later, soon)).To(Equal(soon))
+	g.Expect(earliestRequeue(soon, later)).To(Equal(soon))
+}
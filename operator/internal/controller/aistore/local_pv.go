@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"cmp"
+	"context"
+	"reflect"
+	"slices"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// reconcileLocalPVs creates the local PVs of target mounts with localPV on every node matching the target node
+// selector and tolerations. PVs that are not bound are deleted when their node or mount no longer uses them.
+// Released PVs still hold the data of their previous claim on the disk, so they are kept and listed in the status
+// until an admin wipes the disk and deletes them, after which they are recreated available for new claims.
+func (r *Reconciler) reconcileLocalPVs(ctx context.Context, ais *aisv1.AIStore) error {
+	logger := logf.FromContext(ctx)
+	desired := map[string]*corev1.PersistentVolume{}
+	if mounts := ais.LocalPVMounts(); len(mounts) > 0 {
+		nodes, err := r.listMatchingNodes(ctx, ais.Spec.TargetSpec.NodeSelector, ais.Spec.TargetSpec.Tolerations)
+		if err != nil {
+			return err
+		}
+		for i := range nodes {
+			for j := range mounts {
+				pv := cmn.NewLocalPV(ais, &nodes[i], &mounts[j])
+				desired[pv.Name] = pv
+			}
+		}
+	}
+
+	existing, err := r.listLocalPVs(ctx, ais)
+	if err != nil {
+		return err
+	}
+	var released []aisv1.ReleasedLocalPV
+	for i := range existing.Items {
+		pv := &existing.Items[i]
+		_, wanted := desired[pv.Name]
+		delete(desired, pv.Name)
+		if !pv.DeletionTimestamp.IsZero() || pv.Status.Phase == corev1.VolumeBound {
+			continue
+		}
+		if pv.Status.Phase == corev1.VolumeReleased {
+			released = append(released, aisv1.ReleasedLocalPV{Name: pv.Name, Node: pv.Annotations[cmn.LocalPVNodeAnnotation]})
+			continue
+		}
+		if wanted {
+			continue
+		}
+		if _, err := r.k8sClient.DeleteResourceIfExists(ctx, pv); err != nil {
+			return err
+		}
+		logger.Info("Deleted local PV", "name", pv.Name, "node", pv.Annotations[cmn.LocalPVNodeAnnotation], "phase", pv.Status.Phase)
+	}
+	if err := r.updateReleasedLocalPVs(ctx, ais, released); err != nil {
+		return err
+	}
+
+	for _, pv := range desired {
+		exists, err := r.k8sClient.CreateResourceIfNotExists(ctx, nil, pv)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			logger.Info("Created local PV", "name", pv.Name, "node", pv.Annotations[cmn.LocalPVNodeAnnotation])
+		}
+	}
+	return nil
+}
+
+// updateReleasedLocalPVs lists the released PVs in the status, warning about each PV once, when it is first listed.
+func (r *Reconciler) updateReleasedLocalPVs(ctx context.Context, ais *aisv1.AIStore, released []aisv1.ReleasedLocalPV) error {
+	slices.SortFunc(released, func(a, b aisv1.ReleasedLocalPV) int { return cmp.Compare(a.Name, b.Name) })
+	previous := ais.Status.ReleasedLocalPVs
+	if reflect.DeepEqual(previous, released) {
+		return nil
+	}
+	ais.Status.ReleasedLocalPVs = released
+	if err := r.patchStatus(ctx, ais); err != nil {
+		return err
+	}
+	for _, pv := range released {
+		if slices.Contains(previous, pv) {
+			continue
+		}
+		r.recorder.Eventf(ais, nil, corev1.EventTypeWarning, EventReasonLocalPVReleased, ActionReconcile,
+			"Local PV %s on node %s was released and still holds the data of its claim, wipe the disk and delete the PV to reuse it",
+			pv.Name, pv.Node)
+	}
+	return nil
+}
+
+// cleanupLocalPVs deletes all local PVs of the cluster. Bound PVs are removed once their PVCs are deleted.
+func (r *Reconciler) cleanupLocalPVs(ctx context.Context, ais *aisv1.AIStore) (anyDeleted bool, err error) {
+	if !ais.ShouldCleanupMetadata() {
+		return false, nil
+	}
+	pvs, err := r.listLocalPVs(ctx, ais)
+	if err != nil {
+		return false, err
+	}
+	for i := range pvs.Items {
+		if !pvs.Items[i].DeletionTimestamp.IsZero() {
+			continue
+		}
+		deleted, err := r.k8sClient.DeleteResourceIfExists(ctx, &pvs.Items[i])
+		if err != nil {
+			return anyDeleted, err
+		}
+		anyDeleted = anyDeleted || deleted
+	}
+	return anyDeleted, nil
+}
+
+func (r *Reconciler) listLocalPVs(ctx context.Context, ais *aisv1.AIStore) (*corev1.PersistentVolumeList, error) {
+	pvs := &corev1.PersistentVolumeList{}
+	err := r.k8sClient.List(ctx, pvs, k8sclient.MatchingLabels(cmn.LocalPVClusterLabels(ais)))
+	return pvs, err
+}
+
+// findAISClusterForLocalPV maps a local PV to the cluster it was created for, so deleted PVs are recreated.
+func (*Reconciler) findAISClusterForLocalPV(_ context.Context, o k8sclient.Object) []reconcile.Request {
+	name, namespace := o.GetLabels()[cmn.LabelAppPrefixed], o.GetLabels()[cmn.LocalPVNamespaceLabel]
+	if name == "" || namespace == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+}
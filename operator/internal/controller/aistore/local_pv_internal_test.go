@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestReconcileLocalPVs(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	size := resource.MustParse("1Ti")
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	ais.Spec.TargetSpec.NodeSelector = map[string]string{"ais": "target"}
+	ais.Spec.TargetSpec.Mounts = []aisv1.Mount{
+		{Path: "/ais/nvme0", Size: &size, StorageClass: aisapc.Ptr("local"), LocalPV: aisapc.Ptr(true)},
+		{Path: "/ais/nvme1", Size: &size, StorageClass: aisapc.Ptr("standard")},
+	}
+	newNode := func(name string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: ais.Spec.TargetSpec.NodeSelector}}
+	}
+	withPhase := func(pv *corev1.PersistentVolume, phase corev1.PersistentVolumePhase) *corev1.PersistentVolume {
+		pv.Status.Phase = phase
+		return pv
+	}
+	mnt := &ais.Spec.TargetSpec.Mounts[0]
+	bound := withPhase(cmn.NewLocalPV(ais, newNode("removed-bound"), mnt), corev1.VolumeBound)
+	available := withPhase(cmn.NewLocalPV(ais, newNode("removed-available"), mnt), corev1.VolumeAvailable)
+	released := withPhase(cmn.NewLocalPV(ais, newNode("node-1"), mnt), corev1.VolumeReleased)
+
+	c := newFakeClientBuilder(g).WithObjects(
+		ais, newNode("node-1"), newNode("node-2"),
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "proxy-node"}},
+		bound, available, released,
+	).WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+	recorder := r.recorder.(*events.FakeRecorder)
+
+	g.Expect(r.reconcileLocalPVs(ctx, ais)).To(Succeed())
+	pvs, err := r.listLocalPVs(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	names := make([]string, 0, len(pvs.Items))
+	for i := range pvs.Items {
+		names = append(names, pvs.Items[i].Name)
+	}
+	// Released PV of node-1 still holds data, so it is kept and reported, the bound PV is kept with its claim
+	g.Expect(names).To(ConsistOf(bound.Name, released.Name, cmn.LocalPVName(ais, "node-2", mnt)))
+	g.Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonLocalPVReleased)))
+	releasedStatus := []aisv1.ReleasedLocalPV{{Name: released.Name, Node: "node-1"}}
+	g.Expect(ais.Status.ReleasedLocalPVs).To(Equal(releasedStatus))
+	stored := &aisv1.AIStore{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(ais), stored)).To(Succeed())
+	g.Expect(stored.Status.ReleasedLocalPVs).To(Equal(releasedStatus))
+
+	// The released PV is reported once, not on every reconcile
+	g.Expect(r.reconcileLocalPVs(ctx, ais)).To(Succeed())
+	g.Expect(recorder.Events).NotTo(Receive())
+	pv := &corev1.PersistentVolume{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(released), pv)).To(Succeed())
+	g.Expect(pv.Status.Phase).To(Equal(corev1.VolumeReleased))
+
+	// Once the admin deletes the released PV, it is recreated available
+	g.Expect(c.Delete(ctx, pv)).To(Succeed())
+	g.Expect(r.reconcileLocalPVs(ctx, ais)).To(Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(released), pv)).To(Succeed())
+	g.Expect(pv.Status.Phase).NotTo(Equal(corev1.VolumeReleased))
+	g.Expect(ais.Status.ReleasedLocalPVs).To(BeEmpty())
+	pvs, err = r.listLocalPVs(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pvs.Items).To(HaveLen(3))
+
+	// Without cleanupMetadata the PVs are left for the next deployment
+	g.Expect(r.cleanupLocalPVs(ctx, ais)).To(BeFalse())
+	ais.Spec.CleanupMetadata = aisapc.Ptr(true)
+	g.Expect(r.cleanupLocalPVs(ctx, ais)).To(BeTrue())
+	pvs, err = r.listLocalPVs(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pvs.Items).To(BeEmpty())
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// discoverMountpaths maps every node matching the target node selector and tolerations to the mountpaths
+// reported on it by the mount-discovery agent. Nodes without any mountpath are left out, so their targets
+// wait in the mountpaths init container until the agent reports them.
+// Returns nil when the cluster does not use mount discovery.
+func (r *Reconciler) discoverMountpaths(ctx context.Context, ais *aisv1.AIStore) (map[string][]string, error) {
+	md := ais.Spec.TargetSpec.MountDiscovery
+	if md == nil {
+		return nil, nil
+	}
+	nodes, err := r.listMatchingNodes(ctx, ais.Spec.TargetSpec.NodeSelector, ais.Spec.TargetSpec.Tolerations)
+	if err != nil {
+		return nil, err
+	}
+	logger := logf.FromContext(ctx)
+	nodeMountpaths := make(map[string][]string, len(nodes))
+	for i := range nodes {
+		mpaths, err := cmn.DiscoveredMountpaths(md, &nodes[i])
+		if err != nil {
+			// A malformed annotation only affects the targets on that node
+			logger.Error(err, "Failed to read discovered mountpaths", "node", nodes[i].Name)
+			continue
+		}
+		if len(mpaths) == 0 {
+			logger.Info("No mountpaths discovered on node", "node", nodes[i].Name, "root", md.Root)
+			continue
+		}
+		nodeMountpaths[nodes[i].Name] = mpaths
+	}
+	return nodeMountpaths, nil
+}
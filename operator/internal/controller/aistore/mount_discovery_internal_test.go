@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiscoverMountpaths(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	ais.Spec.TargetSpec.NodeSelector = map[string]string{"ais": "target"}
+	newNode := func(name string, labels map[string]string, annotation string) *corev1.Node {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		if annotation != "" {
+			node.Annotations = map[string]string{cmn.MountpathsAnnotation: annotation}
+		}
+		return node
+	}
+	c := newFakeClientBuilder(g).WithObjects(
+		newNode("node-12", ais.Spec.TargetSpec.NodeSelector, `[{"path": "/ais/nvme0n1"}, {"path": "/ais/nvme1n1"}]`),
+		newNode("node-8", ais.Spec.TargetSpec.NodeSelector, `[{"path": "/ais/nvme0n1"}]`),
+		// Not reported yet, or reported before the agent could read the mount table
+		newNode("node-new", ais.Spec.TargetSpec.NodeSelector, ""),
+		newNode("node-malformed", ais.Spec.TargetSpec.NodeSelector, "{"),
+		newNode("proxy-node", nil, `[{"path": "/ais/nvme0n1"}]`),
+	).Build()
+	r := newTestReconciler(c, nil)
+
+	nodeMountpaths, err := r.discoverMountpaths(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(nodeMountpaths).To(BeNil())
+
+	ais.Spec.TargetSpec.MountDiscovery = &aisv1.MountDiscoverySpec{Root: "/ais"}
+	nodeMountpaths, err = r.discoverMountpaths(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(nodeMountpaths).To(Equal(map[string][]string{
+		"node-12": {"/ais/nvme0n1", "/ais/nvme1n1"},
+		"node-8":  {"/ais/nvme0n1"},
+	}))
+}
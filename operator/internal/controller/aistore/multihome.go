@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// updateMultihomeAddresses reports the addresses multus assigned to each proxy and target pod on the
+// multihome attachments in the status, so they are included in generated certificates.
+func (r *Reconciler) updateMultihomeAddresses(ctx context.Context, ais *aisv1.AIStore) error {
+	var addresses []aisv1.MultihomeAddresses
+	if len(ais.MultihomeAttachments()) != 0 {
+		for _, labels := range []map[string]string{proxy.SelectorLabels(ais), target.SelectorLabels(ais)} {
+			pods, err := r.k8sClient.ListPods(ctx, ais, labels)
+			if err != nil {
+				return err
+			}
+			for i := range pods.Items {
+				addrs, err := cmn.MultihomeAddressesFromPod(ais, &pods.Items[i])
+				if err != nil {
+					// A malformed annotation only affects the reported addresses of that pod
+					logf.FromContext(ctx).Error(err, "Failed to parse pod network status", "pod", pods.Items[i].Name)
+					continue
+				}
+				if addrs != nil {
+					addresses = append(addresses, *addrs)
+				}
+			}
+		}
+		slices.SortFunc(addresses, func(a, b aisv1.MultihomeAddresses) int { return strings.Compare(a.Pod, b.Pod) })
+	}
+	if reflect.DeepEqual(ais.Status.MultihomeAddresses, addresses) {
+		return nil
+	}
+	ais.Status.MultihomeAddresses = addresses
+	return r.patchStatus(ctx, ais)
+}
+
+// multihomeHosts returns the multihome addresses of all pods in the status, for certificate SANs.
+func multihomeHosts(ais *aisv1.AIStore) (hosts []string) {
+	for i := range ais.Status.MultihomeAddresses {
+		addrs := &ais.Status.MultihomeAddresses[i]
+		hosts = append(hosts, addrs.Public...)
+		for _, addr := range []string{addrs.IntraControl, addrs.IntraData} {
+			if addr != "" {
+				hosts = append(hosts, addr)
+			}
+		}
+	}
+	return hosts
+}
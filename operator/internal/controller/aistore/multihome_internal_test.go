@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateMultihomeAddresses(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"},
+		Spec: aisv1.AIStoreSpec{
+			Multihome: &aisv1.MultihomeSpec{Attachments: []aisv1.NetworkAttachment{
+				{Name: "public-net", Usage: []aisv1.NetworkUsage{aisv1.NetworkUsagePublic}},
+			}},
+		},
+	}
+	newPod := func(name string, labels map[string]string, status string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   ais.Namespace,
+			Labels:      labels,
+			Annotations: map[string]string{nadv1.NetworkStatusAnnot: status},
+		}}
+	}
+	c := newFakeClientBuilder(g).WithObjects(
+		ais,
+		newPod("ais-target-0", target.SelectorLabels(ais), `[{"name": "ais-ns/public-net", "ips": ["192.168.1.2"]}]`),
+		newPod("ais-proxy-0", proxy.SelectorLabels(ais), `[{"name": "ais-ns/public-net", "ips": ["192.168.1.1"]}]`),
+		// Not reported until multus assigns the address
+		newPod("ais-target-1", target.SelectorLabels(ais), `[]`),
+	).WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+
+	g.Expect(r.updateMultihomeAddresses(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.MultihomeAddresses).To(Equal([]aisv1.MultihomeAddresses{
+		{Pod: "ais-proxy-0", Public: []string{"192.168.1.1"}},
+		{Pod: "ais-target-0", Public: []string{"192.168.1.2"}},
+	}))
+	g.Expect(multihomeHosts(ais)).To(Equal([]string{"192.168.1.1", "192.168.1.2"}))
+
+	// Removing the attachments clears the status
+	ais.Spec.Multihome = nil
+	g.Expect(r.updateMultihomeAddresses(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.MultihomeAddresses).To(BeEmpty())
+	stored := &aisv1.AIStore{}
+	g.Expect(c.Get(ctx, ais.NamespacedName(), stored)).To(Succeed())
+	g.Expect(stored.Status.MultihomeAddresses).To(BeEmpty())
+}
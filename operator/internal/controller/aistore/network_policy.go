@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/resources/aistore/adminclient"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+	// operatorPodLabel and operatorPodLabelValue match the operator pods deployed by the bundled manifests and chart
+	operatorPodLabel      = "control-plane"
+	operatorPodLabelValue = "controller-manager"
+)
+
+// detectOperatorNamespace returns the namespace the operator runs in, or "" when running outside the K8s cluster.
+func detectOperatorNamespace() string {
+	b, err := os.ReadFile(serviceAccountNamespaceFile)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// reconcileNetworkPolicies applies the NetworkPolicies of proxies and targets, or deletes them when disabled.
+func (r *Reconciler) reconcileNetworkPolicies(ctx context.Context, ais *aisv1.AIStore) error {
+	components := []struct {
+		daemonType string
+		spec       *aisv1.ServiceSpec
+		external   bool
+	}{
+		{aisapc.Proxy, &ais.Spec.ProxySpec.ServiceSpec, ais.ProxyExternalAccessEnabled()},
+		{aisapc.Target, &ais.Spec.TargetSpec.ServiceSpec, ais.TargetExternalAccessEnabled()},
+	}
+	if !ais.NetworkPolicyEnabled() {
+		for _, c := range components {
+			deleted, err := aisclient.DeleteResourceIfExists[*networkingv1.NetworkPolicy](r.k8sClient, ctx, cmn.NetworkPolicyNSName(ais, c.daemonType))
+			if err != nil {
+				return err
+			}
+			if deleted {
+				logf.FromContext(ctx).Info("Deleted NetworkPolicy", "component", c.daemonType)
+			}
+		}
+		return nil
+	}
+	peers := r.publicAccessPeers(ais)
+	for _, c := range components {
+		if err := r.applyNetworkPolicy(ctx, ais, cmn.NewNetworkPolicy(ais, c.daemonType, c.spec, peers, c.external)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyNetworkPolicy creates the policy, or replaces the spec of the existing one when it differs.
+// Unlike CreateOrUpdateResource, removed peers and rules are also detected.
+func (r *Reconciler) applyNetworkPolicy(ctx context.Context, ais *aisv1.AIStore, policy *networkingv1.NetworkPolicy) error {
+	existing := &networkingv1.NetworkPolicy{}
+	err := r.k8sClient.Get(ctx, k8sclient.ObjectKeyFromObject(policy), existing)
+	if k8serrors.IsNotFound(err) {
+		_, err = r.k8sClient.CreateResourceIfNotExists(ctx, ais, policy)
+		return err
+	}
+	if err != nil || equality.Semantic.DeepEqual(existing.Spec, policy.Spec) {
+		return err
+	}
+	existing.Spec = policy.Spec
+	if err = r.k8sClient.Update(ctx, existing); err != nil {
+		return err
+	}
+	logf.FromContext(ctx).Info("Updated NetworkPolicy", "name", policy.Name)
+	return nil
+}
+
+// publicAccessPeers returns the peers allowed to reach the public port of AIS pods besides the pods of the cluster.
+func (r *Reconciler) publicAccessPeers(ais *aisv1.AIStore) []networkingv1.NetworkPolicyPeer {
+	spec := ais.Spec.NetworkPolicy
+	operatorPeers := spec.OperatorAccess
+	if len(operatorPeers) == 0 {
+		operatorPeers = []networkingv1.NetworkPolicyPeer{r.defaultOperatorPeer()}
+	}
+	peers := make([]networkingv1.NetworkPolicyPeer, 0, len(operatorPeers)+len(spec.PublicAccess)+1)
+	if ais.AdminClientEnabled() {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			PodSelector: &metav1.LabelSelector{MatchLabels: adminclient.SelectorLabels(ais)},
+		})
+	}
+	peers = append(peers, operatorPeers...)
+	return append(peers, spec.PublicAccess...)
+}
+
+// defaultOperatorPeer matches the operator pods in the operator namespace, or in any namespace when it is unknown.
+func (r *Reconciler) defaultOperatorPeer() networkingv1.NetworkPolicyPeer {
+	namespaceSelector := &metav1.LabelSelector{}
+	if r.operatorNamespace != "" {
+		namespaceSelector.MatchLabels = map[string]string{corev1.LabelMetadataName: r.operatorNamespace}
+	}
+	return networkingv1.NetworkPolicyPeer{
+		PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{operatorPodLabel: operatorPodLabelValue}},
+		NamespaceSelector: namespaceSelector,
+	}
+}
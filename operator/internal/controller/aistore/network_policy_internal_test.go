@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/adminclient"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestReconcileNetworkPolicies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	serviceSpec := aisv1.ServiceSpec{
+		ServicePort:      intstr.FromInt32(51080),
+		PublicPort:       intstr.FromInt32(51081),
+		IntraControlPort: intstr.FromInt32(51082),
+		IntraDataPort:    intstr.FromInt32(51083),
+	}
+	monitoring := networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: "monitoring"}},
+	}
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"},
+		Spec: aisv1.AIStoreSpec{
+			ProxySpec:     aisv1.DaemonSpec{ServiceSpec: serviceSpec},
+			TargetSpec:    aisv1.TargetSpec{DaemonSpec: aisv1.DaemonSpec{ServiceSpec: serviceSpec, ExternalAccess: &aisv1.ExternalAccessSpec{}}},
+			AdminClient:   &aisv1.AdminClientSpec{},
+			NetworkPolicy: &aisv1.NetworkPolicySpec{PublicAccess: []networkingv1.NetworkPolicyPeer{monitoring}},
+		},
+	}
+	c := newFakeClientBuilder(g).WithObjects(ais).Build()
+	r := newTestReconciler(c, nil)
+	r.operatorNamespace = "ais-operator-system"
+	getPolicy := func(daemonType string) (*networkingv1.NetworkPolicy, error) {
+		policy := &networkingv1.NetworkPolicy{}
+		return policy, c.Get(ctx, cmn.NetworkPolicyNSName(ais, daemonType), policy)
+	}
+
+	g.Expect(r.reconcileNetworkPolicies(ctx, ais)).To(Succeed())
+	policy, err := getPolicy(aisapc.Proxy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(policy.Spec.PodSelector.MatchLabels).To(Equal(cmn.SelectorLabels(ais.Name, aisapc.Proxy)))
+	g.Expect(policy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress))
+	g.Expect(policy.Spec.Ingress).To(HaveLen(2))
+	g.Expect(policy.Spec.Ingress[0].Ports).To(HaveLen(3))
+	public := policy.Spec.Ingress[1]
+	g.Expect(public.Ports).To(HaveLen(1))
+	g.Expect(*public.Ports[0].Port).To(Equal(serviceSpec.PublicPort))
+	g.Expect(public.From).To(HaveLen(3))
+	g.Expect(public.From[0].PodSelector.MatchLabels).To(Equal(adminclient.SelectorLabels(ais)))
+	g.Expect(public.From[1].NamespaceSelector.MatchLabels).To(HaveKeyWithValue(corev1.LabelMetadataName, "ais-operator-system"))
+	g.Expect(public.From[2]).To(Equal(monitoring))
+
+	// Targets with external access accept any peer on the public port
+	policy, err = getPolicy(aisapc.Target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(policy.Spec.Ingress).To(HaveLen(2))
+	g.Expect(policy.Spec.Ingress[1].From).To(BeEmpty())
+
+	// Port changes are applied
+	ais.Spec.ProxySpec.IntraDataPort = intstr.FromInt32(52083)
+	g.Expect(r.reconcileNetworkPolicies(ctx, ais)).To(Succeed())
+	policy, err = getPolicy(aisapc.Proxy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*policy.Spec.Ingress[0].Ports[2].Port).To(Equal(intstr.FromInt32(52083)))
+
+	// Removed peers are no longer allowed
+	ais.Spec.NetworkPolicy.PublicAccess = nil
+	g.Expect(r.reconcileNetworkPolicies(ctx, ais)).To(Succeed())
+	policy, err = getPolicy(aisapc.Proxy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(policy.Spec.Ingress[1].From).To(HaveLen(2))
+
+	// Disabling removes the policies
+	ais.Spec.NetworkPolicy.Enabled = aisapc.Ptr(false)
+	g.Expect(r.reconcileNetworkPolicies(ctx, ais)).To(Succeed())
+	for _, daemonType := range []string{aisapc.Proxy, aisapc.Target} {
+		_, err = getPolicy(daemonType)
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	}
+}
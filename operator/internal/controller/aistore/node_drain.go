@@ -0,0 +1,274 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aismeta "github.com/NVIDIA/aistore/core/meta"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	"github.com/ais-operator/internal/services"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// drainRebalanceCheckDelay is how often the rebalance after draining a node is checked
+const drainRebalanceCheckDelay = 15 * time.Second
+
+// isNodeDraining reports whether the node is cordoned, or has one of the given drain taints
+func isNodeDraining(node *corev1.Node, taints []string) bool {
+	if node.Spec.Unschedulable {
+		return true
+	}
+	for i := range node.Spec.Taints {
+		key := node.Spec.Taints[i].Key
+		if key == corev1.TaintNodeUnschedulable || slices.Contains(taints, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileTargetDrains coordinates drains of the nodes hosting targets with AIS maintenance mode.
+// Targets on nodes being drained are put into maintenance, so their data is rebalanced to the other targets while
+// the target PDBs block their eviction. Once rebalanced, each target is released from the PDBs to be evicted. They
+// are taken out of maintenance once their node is uncordoned or deleted, or when drain coordination is disabled. Targets beyond the size of targetSpec or of their pool are left to the
+// scale-down.
+func (r *Reconciler) reconcileTargetDrains(ctx context.Context, ais *aisv1.AIStore) (ctrl.Result, error) {
+	if !ais.NodeDrainEnabled() && len(ais.Status.TargetDrains) == 0 {
+		return ctrl.Result{}, nil
+	}
+	nodes := map[string]bool{}
+	draining := func(name string) (bool, error) {
+		if !ais.NodeDrainEnabled() {
+			return false, nil
+		}
+		if isDraining, ok := nodes[name]; ok {
+			return isDraining, nil
+		}
+		node := &corev1.Node{}
+		err := r.k8sClient.Get(ctx, types.NamespacedName{Name: name}, node)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return false, err
+		}
+		nodes[name] = err == nil && isNodeDraining(node, ais.Spec.TargetSpec.NodeDrain.Taints)
+		return nodes[name], nil
+	}
+	// Targets of pools are resolved against the StatefulSet and size of their pool
+	sizes := map[string]int32{target.StatefulSetNSName(ais).Name: ais.GetTargetSize()}
+	for _, view := range ais.TargetPoolViews() {
+		sizes[target.StatefulSetNSName(view).Name] = view.GetTargetSize()
+	}
+	inCluster := func(podName string) bool {
+		for ssName, size := range sizes {
+			if ordinal, ok := cmn.ResourceOrdinal(podName, ssName); ok {
+				return ordinal < size
+			}
+		}
+		return false
+	}
+
+	// Check whether the API is needed before reaching the cluster. The labels of targetSpec also select the targets
+	// of pools.
+	pods, err := r.k8sClient.ListPods(ctx, ais, target.BasicLabels(ais))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var toDrain []*corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		tracked := slices.ContainsFunc(ais.Status.TargetDrains, func(d aisv1.TargetDrainStatus) bool { return d.Pod == pod.Name })
+		if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil || tracked || !inCluster(pod.Name) {
+			continue
+		}
+		isDraining, err := draining(pod.Spec.NodeName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if isDraining {
+			toDrain = append(toDrain, pod)
+		}
+	}
+	needsAPI := len(toDrain) > 0
+	for i := range ais.Status.TargetDrains {
+		drain := &ais.Status.TargetDrains[i]
+		isDraining, err := draining(drain.Node)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		needsAPI = needsAPI || !isDraining || !drain.RebalanceCompleted || !inCluster(drain.Pod)
+	}
+	if !needsAPI {
+		return ctrl.Result{}, r.releaseDrainedTargets(ctx, ais, pods)
+	}
+
+	logger := logf.FromContext(ctx)
+	apiClient, err := r.clientManager.GetClient(ctx, ais)
+	if err != nil {
+		logger.Info("Failed to reach the cluster to coordinate node drains", "err", err.Error())
+		return ctrl.Result{RequeueAfter: drainRebalanceCheckDelay}, nil
+	}
+	smap, err := apiClient.GetClusterMap()
+	if err != nil {
+		logger.Info("Failed to get the cluster map to coordinate node drains", "err", err.Error())
+		return ctrl.Result{RequeueAfter: drainRebalanceCheckDelay}, nil
+	}
+
+	// Drains failing to start or stop are retried on the next pass
+	var failed bool
+	drains := make([]aisv1.TargetDrainStatus, 0, len(ais.Status.TargetDrains)+len(toDrain))
+	for _, drain := range ais.Status.TargetDrains {
+		if !inCluster(drain.Pod) {
+			logger.Info("Target of drained node is scaled down", "pod", drain.Pod, "node", drain.Node)
+			continue
+		}
+		if isDraining, _ := draining(drain.Node); !isDraining {
+			if err := r.stopDrainMaintenance(ctx, ais, apiClient, smap, &drain); err != nil {
+				logger.Error(err, "Failed to take target out of maintenance", "pod", drain.Pod, "daemonID", drain.DaemonID)
+				failed = true
+				drains = append(drains, drain)
+			}
+			continue
+		}
+		if !drain.RebalanceCompleted && isDrainRebalanced(smap, &drain) {
+			drain.RebalanceCompleted = true
+			logger.Info("Rebalance after draining node completed", "pod", drain.Pod, "node", drain.Node)
+			r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonDrainRebalanced, ActionCoordinateDrain,
+				"Data of target %s was rebalanced, pod can be evicted from node %s", drain.Pod, drain.Node)
+		}
+		drains = append(drains, drain)
+	}
+	for _, pod := range toDrain {
+		drain, err := r.startDrainMaintenance(ctx, ais, apiClient, smap, pod)
+		if err != nil {
+			logger.Error(err, "Failed to put target of drained node into maintenance", "pod", pod.Name, "node", pod.Spec.NodeName)
+			failed = true
+			continue
+		}
+		if drain != nil {
+			drains = append(drains, *drain)
+		}
+	}
+	slices.SortFunc(drains, func(a, b aisv1.TargetDrainStatus) int { return cmp.Compare(a.Pod, b.Pod) })
+	if len(drains) == 0 {
+		drains = nil
+	}
+
+	if !slices.Equal(drains, ais.Status.TargetDrains) {
+		ais.Status.TargetDrains = drains
+		if err := r.patchStatus(ctx, ais); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if err := r.releaseDrainedTargets(ctx, ais, pods); err != nil {
+		return ctrl.Result{}, err
+	}
+	if failed || len(ais.TargetDrainsRebalancing()) > 0 {
+		return ctrl.Result{RequeueAfter: drainRebalanceCheckDelay}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// releaseDrainedTargets labels the targets rebalanced off their drained node with LabelDrainReleased, which leaves
+// them out of the target PDBs, and removes the label from targets no longer drained. A target recreated on another
+// node is not released again.
+func (r *Reconciler) releaseDrainedTargets(ctx context.Context, ais *aisv1.AIStore, pods *corev1.PodList) error {
+	logger := logf.FromContext(ctx)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		release := ais.NodeDrainEnabled() && slices.ContainsFunc(ais.Status.TargetDrains, func(d aisv1.TargetDrainStatus) bool {
+			return d.Pod == pod.Name && d.Node == pod.Spec.NodeName && d.RebalanceCompleted
+		})
+		if _, released := pod.Labels[cmn.LabelDrainReleased]; release == released {
+			continue
+		}
+		patched := pod.DeepCopy()
+		if release {
+			if patched.Labels == nil {
+				patched.Labels = map[string]string{}
+			}
+			patched.Labels[cmn.LabelDrainReleased] = "true"
+		} else {
+			delete(patched.Labels, cmn.LabelDrainReleased)
+		}
+		if err := r.k8sClient.Patch(ctx, patched, k8sclient.MergeFrom(pod)); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		logger.Info("Updated the release of drained target from the PDB", "pod", pod.Name, "node", pod.Spec.NodeName, "released", release)
+	}
+	return nil
+}
+
+// startDrainMaintenance puts the target of the pod into maintenance, rebalancing its data to the other targets.
+// Targets not in the cluster map have no data to move, and those already in maintenance or decommissioning are
+// managed by someone else, so neither is tracked.
+func (r *Reconciler) startDrainMaintenance(ctx context.Context, ais *aisv1.AIStore, apiClient services.AIStoreClientInterface,
+	smap *aismeta.Smap, pod *corev1.Pod,
+) (*aisv1.TargetDrainStatus, error) {
+	logger := logf.FromContext(ctx).WithValues("pod", pod.Name, "node", pod.Spec.NodeName)
+	node, err := findAISNodeByPodName(smap.Tmap, pod.Name)
+	if err != nil {
+		logger.Info("Target of drained node is not in the cluster map")
+		return nil, nil
+	}
+	if node.InMaintOrDecomm() {
+		logger.Info("Target of drained node is already in maintenance or decommissioning", "daemonID", node.ID())
+		return nil, nil
+	}
+	xid, err := apiClient.StartMaintenance(&aisapc.ActValRmNode{DaemonID: node.ID()})
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Put target of drained node into maintenance", "daemonID", node.ID(), "rebalanceID", xid)
+	r.recorder.Eventf(ais, pod, corev1.EventTypeNormal, EventReasonNodeDraining, ActionCoordinateDrain,
+		"Node %s is being drained, put target %s into maintenance", pod.Spec.NodeName, node.ID())
+	return &aisv1.TargetDrainStatus{
+		Pod:                pod.Name,
+		Node:               pod.Spec.NodeName,
+		DaemonID:           node.ID(),
+		RebalanceID:        xid,
+		RebalanceCompleted: xid == "",
+		Since:              metav1.Now(),
+	}, nil
+}
+
+// stopDrainMaintenance takes the target of the drain out of maintenance, if it is still in it
+func (r *Reconciler) stopDrainMaintenance(ctx context.Context, ais *aisv1.AIStore, apiClient services.AIStoreClientInterface,
+	smap *aismeta.Smap, drain *aisv1.TargetDrainStatus,
+) error {
+	logger := logf.FromContext(ctx).WithValues("pod", drain.Pod, "node", drain.Node, "daemonID", drain.DaemonID)
+	if node := smap.GetTarget(drain.DaemonID); node == nil || !node.InMaint() {
+		logger.Info("Target of drained node is no longer in maintenance")
+		return nil
+	}
+	if _, err := apiClient.StopMaintenance(&aisapc.ActValRmNode{DaemonID: drain.DaemonID}); err != nil {
+		return err
+	}
+	logger.Info("Took target of uncordoned node out of maintenance")
+	r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonNodeUncordoned, ActionCoordinateDrain,
+		"Node %s is no longer drained, took target %s out of maintenance", drain.Node, drain.DaemonID)
+	return nil
+}
+
+// isDrainRebalanced reports whether the data of the target was rebalanced to the other targets, which AIS marks in
+// the cluster map. A target that left the cluster map has no data to protect.
+func isDrainRebalanced(smap *aismeta.Smap, drain *aisv1.TargetDrainStatus) bool {
+	node := smap.GetTarget(drain.DaemonID)
+	return node == nil || !node.InMaint() || node.InMaintPostReb()
+}
@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aismeta "github.com/NVIDIA/aistore/core/meta"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	mocks "github.com/ais-operator/internal/services/mocks"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestIsNodeDraining(t *testing.T) {
+	tests := []struct {
+		name string
+		spec corev1.NodeSpec
+		want bool
+	}{
+		{name: "schedulable", spec: corev1.NodeSpec{}},
+		{name: "cordoned", spec: corev1.NodeSpec{Unschedulable: true}, want: true},
+		{
+			name: "unschedulable taint",
+			spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: corev1.TaintNodeUnschedulable, Effect: corev1.TaintEffectNoSchedule}}},
+			want: true,
+		},
+		{
+			name: "drain taint",
+			spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: "ToBeDeletedByClusterAutoscaler", Effect: corev1.TaintEffectNoSchedule}}},
+			want: true,
+		},
+		{
+			name: "other taint",
+			spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{Spec: tt.spec}
+			if got := isNodeDraining(node, []string{"ToBeDeletedByClusterAutoscaler"}); got != tt.want {
+				t.Errorf("isNodeDraining() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileTargetDrains(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	apiClient := mocks.NewMockAIStoreClientInterface(mockCtrl)
+	clientManager := mocks.NewMockAISClientManagerInterface(mockCtrl)
+	clientManager.EXPECT().GetClient(gomock.Any(), gomock.Any()).Return(apiClient, nil).AnyTimes()
+
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"}}
+	ais.Spec.Size = aisapc.Ptr(int32(2))
+	ais.Spec.TargetSpec.NodeDrain = &aisv1.NodeDrainSpec{}
+	newPod := func(name, node string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ais.Namespace, Labels: target.BasicLabels(ais)},
+			Spec:       corev1.PodSpec{NodeName: node},
+		}
+	}
+	nodeA := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}, Spec: corev1.NodeSpec{Unschedulable: true}}
+	nodeB := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-b"}}
+	c := newFakeClientBuilder(g).
+		WithObjects(ais, nodeA, nodeB, newPod("ais-target-0", "node-a"), newPod("ais-target-1", "node-b")).
+		WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, clientManager)
+
+	t0 := &aismeta.Snode{DaeID: "t0", DaeType: aisapc.Target, ControlNet: aismeta.NetInfo{Hostname: "ais-target-0"}}
+	t1 := &aismeta.Snode{DaeID: "t1", DaeType: aisapc.Target, ControlNet: aismeta.NetInfo{Hostname: "ais-target-1"}}
+	smap := &aismeta.Smap{Tmap: aismeta.NodeMap{"t0": t0, "t1": t1}}
+	apiClient.EXPECT().GetClusterMap().Return(smap, nil).AnyTimes()
+	targetPDB := func() intstr.IntOrString {
+		pdb, err := r.k8sClient.GetPDB(ctx, target.PDBNSName(ais))
+		g.Expect(err).NotTo(HaveOccurred())
+		return *pdb.Spec.MaxUnavailable
+	}
+	released := func(name string) bool {
+		pod := &corev1.Pod{}
+		g.Expect(c.Get(ctx, types.NamespacedName{Namespace: ais.Namespace, Name: name}, pod)).To(Succeed())
+		_, ok := pod.Labels[cmn.LabelDrainReleased]
+		return ok
+	}
+
+	// Before any node is cordoned, the PDB already allows no eviction, so a drain cannot evict a target first
+	nodeA.Spec.Unschedulable = false
+	g.Expect(c.Update(ctx, nodeA)).To(Succeed())
+	result, err := r.reconcileTargetDrains(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.IsZero()).To(BeTrue())
+	g.Expect(r.reconcileTargetPDB(ctx, ais)).To(Succeed())
+	g.Expect(targetPDB()).To(Equal(intstr.FromInt32(0)))
+	nodeA.Spec.Unschedulable = true
+	g.Expect(c.Update(ctx, nodeA)).To(Succeed())
+
+	// The target of the cordoned node is put into maintenance, and its eviction blocked while rebalancing
+	apiClient.EXPECT().StartMaintenance(&aisapc.ActValRmNode{DaemonID: "t0"}).Return("reb-1", nil).Times(1)
+	result, err = r.reconcileTargetDrains(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{RequeueAfter: drainRebalanceCheckDelay}))
+	g.Expect(ais.Status.TargetDrains).To(HaveLen(1))
+	drain := ais.Status.TargetDrains[0]
+	g.Expect(drain.Pod).To(Equal("ais-target-0"))
+	g.Expect(drain.Node).To(Equal("node-a"))
+	g.Expect(drain.DaemonID).To(Equal("t0"))
+	g.Expect(drain.RebalanceID).To(Equal("reb-1"))
+	g.Expect(drain.RebalanceCompleted).To(BeFalse())
+	g.Expect(r.reconcileTargetPDB(ctx, ais)).To(Succeed())
+	g.Expect(targetPDB()).To(Equal(intstr.FromInt32(0)))
+
+	// Still rebalancing
+	t0.Flags = aismeta.SnodeMaint
+	result, err = r.reconcileTargetDrains(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(drainRebalanceCheckDelay))
+	g.Expect(ais.TargetDrainsRebalancing()).To(Equal([]string{"node-a"}))
+	g.Expect(released("ais-target-0")).To(BeFalse())
+
+	// Once rebalanced, only the target of the drained node is released from the PDB
+	t0.Flags = aismeta.SnodeMaint | aismeta.SnodeMaintPostReb
+	result, err = r.reconcileTargetDrains(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.IsZero()).To(BeTrue())
+	g.Expect(ais.Status.TargetDrains[0].RebalanceCompleted).To(BeTrue())
+	g.Expect(released("ais-target-0")).To(BeTrue())
+	g.Expect(released("ais-target-1")).To(BeFalse())
+	g.Expect(r.reconcileTargetPDB(ctx, ais)).To(Succeed())
+	g.Expect(targetPDB()).To(Equal(intstr.FromInt32(0)))
+
+	// Uncordoning the node takes the target out of maintenance
+	nodeA.Spec.Unschedulable = false
+	g.Expect(c.Update(ctx, nodeA)).To(Succeed())
+	apiClient.EXPECT().StopMaintenance(&aisapc.ActValRmNode{DaemonID: "t0"}).Return("", nil).Times(1)
+	result, err = r.reconcileTargetDrains(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.IsZero()).To(BeTrue())
+	g.Expect(ais.Status.TargetDrains).To(BeEmpty())
+	g.Expect(released("ais-target-0")).To(BeFalse())
+
+	// Targets already in maintenance, e.g. during a scale-down, are left alone
+	t1.Flags = aismeta.SnodeMaint
+	nodeB.Spec.Unschedulable = true
+	g.Expect(c.Update(ctx, nodeB)).To(Succeed())
+	result, err = r.reconcileTargetDrains(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.IsZero()).To(BeTrue())
+	g.Expect(ais.Status.TargetDrains).To(BeEmpty())
+}
+
+func TestReconcileTargetDrainsOfTargetPools(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	apiClient := mocks.NewMockAIStoreClientInterface(mockCtrl)
+	clientManager := mocks.NewMockAISClientManagerInterface(mockCtrl)
+	clientManager.EXPECT().GetClient(gomock.Any(), gomock.Any()).Return(apiClient, nil).AnyTimes()
+
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"}}
+	ais.Spec.Size = aisapc.Ptr(int32(1))
+	ais.Spec.TargetSpec.NodeDrain = &aisv1.NodeDrainSpec{}
+	ais.Spec.TargetPools = []aisv1.TargetPoolSpec{{Name: "nvme", Size: 1}}
+	pool := ais.ForTargetPool(&ais.Spec.TargetPools[0])
+	newPod := func(name, node string, view *aisv1.AIStore) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ais.Namespace, Labels: target.BasicLabels(view)},
+			Spec:       corev1.PodSpec{NodeName: node},
+		}
+	}
+	nodeA := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}}
+	nodeB := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-b"}, Spec: corev1.NodeSpec{Unschedulable: true}}
+	nodeC := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-c"}, Spec: corev1.NodeSpec{Unschedulable: true}}
+	c := newFakeClientBuilder(g).
+		WithObjects(ais, nodeA, nodeB, nodeC, newPod("ais-target-0", "node-a", ais),
+			newPod("ais-target-nvme-0", "node-b", pool), newPod("ais-target-nvme-1", "node-c", pool)).
+		WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, clientManager)
+
+	smap := &aismeta.Smap{Tmap: aismeta.NodeMap{}}
+	for _, host := range []string{"ais-target-0", "ais-target-nvme-0", "ais-target-nvme-1"} {
+		smap.Tmap[host] = &aismeta.Snode{DaeID: host, DaeType: aisapc.Target, ControlNet: aismeta.NetInfo{Hostname: host}}
+	}
+	apiClient.EXPECT().GetClusterMap().Return(smap, nil).AnyTimes()
+
+	// The target of the pool on a cordoned node is put into maintenance, not the one beyond the size of the pool
+	apiClient.EXPECT().StartMaintenance(&aisapc.ActValRmNode{DaemonID: "ais-target-nvme-0"}).Return("reb-1", nil).Times(1)
+	result, err := r.reconcileTargetDrains(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(drainRebalanceCheckDelay))
+	g.Expect(ais.Status.TargetDrains).To(HaveLen(1))
+	g.Expect(ais.Status.TargetDrains[0].Pod).To(Equal("ais-target-nvme-0"))
+
+	// The PDB of the pool blocks its evictions while rebalancing
+	pool = ais.ForTargetPool(&ais.Spec.TargetPools[0])
+	g.Expect(r.reconcileTargetPDB(ctx, pool)).To(Succeed())
+	pdb, err := r.k8sClient.GetPDB(ctx, target.PDBNSName(pool))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*pdb.Spec.MaxUnavailable).To(Equal(intstr.FromInt32(0)))
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"time"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/services"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// oidcRetryMin and oidcRetryMax bound the delay before failed OIDC issuers are checked again
+	oidcRetryMin = 30 * time.Second
+	oidcRetryMax = 10 * time.Minute
+)
+
+// reconcileOIDCIssuers verifies the issuers in spec.auth.oidc by fetching their discovery documents and
+// reports the result in the OIDCIssuersDiscovered condition. Issuers are checked again after a spec change,
+// or after a failure once a backoff has passed, returning a requeue for the next check. A failure does not
+// block reconciliation, since AIS resolves issuer keys on its own.
+func (r *Reconciler) reconcileOIDCIssuers(ctx context.Context, ais *aisv1.AIStore) (ctrl.Result, error) {
+	conditionType := string(aisv1.ConditionOIDCIssuersDiscovered)
+	condition := meta.FindStatusCondition(ais.Status.Conditions, conditionType)
+	key := types.NamespacedName{Namespace: ais.Namespace, Name: ais.Name}
+	if ais.GetOIDC() == nil {
+		r.oidcChecks.Delete(key)
+		if condition == nil {
+			return ctrl.Result{}, nil
+		}
+		meta.RemoveStatusCondition(&ais.Status.Conditions, conditionType)
+		return ctrl.Result{}, r.patchStatus(ctx, ais)
+	}
+	if condition != nil && condition.ObservedGeneration == ais.Generation {
+		if condition.Status == metav1.ConditionTrue {
+			return ctrl.Result{}, nil
+		}
+		if lastCheck, ok := r.oidcChecks.Load(key); ok {
+			if wait := time.Until(lastCheck.(time.Time).Add(oidcRetryDelay(condition))); wait > 0 {
+				return ctrl.Result{RequeueAfter: wait}, nil
+			}
+		}
+	}
+
+	var result ctrl.Result
+	if err := services.CheckOIDCIssuers(ctx, r.k8sClient, ais); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to discover OIDC issuers")
+		if condition == nil || condition.Status != metav1.ConditionFalse {
+			r.recorder.Eventf(ais, nil, corev1.EventTypeWarning, EventReasonOIDCDiscoveryFailed, ActionReconcile, "%s", err.Error())
+		}
+		ais.SetConditionFalse(aisv1.ConditionOIDCIssuersDiscovered, aisv1.ReasonOIDCDiscoveryFailed, err.Error())
+		r.oidcChecks.Store(key, time.Now())
+		result.RequeueAfter = oidcRetryDelay(meta.FindStatusCondition(ais.Status.Conditions, conditionType))
+	} else {
+		ais.SetCondition(aisv1.ConditionOIDCIssuersDiscovered)
+		r.oidcChecks.Delete(key)
+	}
+	return result, r.patchStatus(ctx, ais)
+}
+
+// oidcRetryDelay grows the delay between checks with how long the issuers have been failing
+func oidcRetryDelay(condition *metav1.Condition) time.Duration {
+	return min(max(time.Since(condition.LastTransitionTime.Time), oidcRetryMin), oidcRetryMax)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestReconcileOIDCIssuersBacksOff(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	var requests atomic.Int32
+	var healthy atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		issuer := "http://" + req.Host
+		_ = json.NewEncoder(w).Encode(&authn.OIDCConfiguration{Issuer: issuer, JWKSURI: issuer + "/certs"})
+	}))
+	defer server.Close()
+
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", Generation: 1},
+		Spec: aisv1.AIStoreSpec{Auth: &aisv1.AuthSpec{
+			OIDC: &aisv1.OIDCAuthSpec{Issuers: []string{server.URL}},
+		}},
+	}
+	c := newFakeClientBuilder(g).WithObjects(ais).WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+	key := types.NamespacedName{Namespace: ais.Namespace, Name: ais.Name}
+
+	result, err := r.reconcileOIDCIssuers(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Load()).To(BeEquivalentTo(1))
+	g.Expect(result.RequeueAfter).To(Equal(oidcRetryMin))
+	g.Expect(meta.IsStatusConditionFalse(ais.Status.Conditions, string(aisv1.ConditionOIDCIssuersDiscovered))).To(BeTrue())
+
+	// Reconciles within the backoff do not fetch the issuers again
+	result, err = r.reconcileOIDCIssuers(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Load()).To(BeEquivalentTo(1))
+	g.Expect(result.RequeueAfter).To(And(BeNumerically(">", 0), BeNumerically("<=", oidcRetryMin)))
+
+	// The backoff grows with how long the issuers have been failing
+	condition := meta.FindStatusCondition(ais.Status.Conditions, string(aisv1.ConditionOIDCIssuersDiscovered))
+	condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-5 * time.Minute))
+	r.oidcChecks.Store(key, time.Now().Add(-time.Minute))
+	result, err = r.reconcileOIDCIssuers(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Load()).To(BeEquivalentTo(1))
+	g.Expect(result.RequeueAfter).To(BeNumerically("~", 4*time.Minute, time.Second))
+
+	r.oidcChecks.Store(key, time.Now().Add(-oidcRetryMax))
+	result, err = r.reconcileOIDCIssuers(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Load()).To(BeEquivalentTo(2))
+	g.Expect(result.RequeueAfter).To(BeNumerically("~", 5*time.Minute, time.Second))
+
+	// A spec change is checked right away
+	healthy.Store(true)
+	ais.Generation++
+	result, err = r.reconcileOIDCIssuers(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Load()).To(BeEquivalentTo(3))
+	g.Expect(result.IsZero()).To(BeTrue())
+	g.Expect(ais.IsConditionTrue(aisv1.ConditionOIDCIssuersDiscovered)).To(BeTrue())
+	_, found := r.oidcChecks.Load(key)
+	g.Expect(found).To(BeFalse())
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aiscmn "github.com/NVIDIA/aistore/cmn"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	mocks "github.com/ais-operator/internal/services/mocks"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestReconcileAutoPDBs(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	apiClient := mocks.NewMockAIStoreClientInterface(mockCtrl)
+	clientManager := mocks.NewMockAISClientManagerInterface(mockCtrl)
+	clientManager.EXPECT().GetClient(gomock.Any(), gomock.Any()).Return(apiClient, nil).AnyTimes()
+
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"}}
+	ais.Spec.Size = aisapc.Ptr(int32(5))
+	ais.Spec.TargetSpec.PodDisruptionBudget = &aisv1.PDBSpec{Enabled: true, Mode: aisapc.Ptr(aisv1.PDBModeAuto)}
+	c := newFakeClientBuilder(g).WithObjects(ais).Build()
+	r := newTestReconciler(c, clientManager)
+
+	getPDB := func(name types.NamespacedName) *policyv1.PodDisruptionBudget {
+		pdb, err := r.k8sClient.GetPDB(ctx, name)
+		g.Expect(err).NotTo(HaveOccurred())
+		return pdb
+	}
+	targetPDB := func() intstr.IntOrString { return *getPDB(target.PDBNSName(ais)).Spec.MaxUnavailable }
+
+	// Derived from the parity slices of the live config
+	live := &aiscmn.ClusterConfig{}
+	live.EC.Enabled, live.EC.ParitySlices = true, 2
+	apiClient.EXPECT().GetClusterConfig().Return(live, nil).Times(1)
+	g.Expect(r.reconcileTargetPDB(ctx, ais)).To(Succeed())
+	g.Expect(targetPDB()).To(Equal(intstr.FromInt32(2)))
+
+	// Proxies keep a majority available
+	g.Expect(r.reconcileProxyPDB(ctx, ais)).To(Succeed())
+	g.Expect(*getPDB(proxy.PDBNSName(ais)).Spec.MinAvailable).To(Equal(intstr.FromInt32(3)))
+
+	// The current PDB is kept while the cluster config cannot be read
+	apiClient.EXPECT().GetClusterConfig().Return(nil, errors.New("unreachable")).Times(1)
+	g.Expect(r.reconcileTargetPDB(ctx, ais)).To(Succeed())
+	g.Expect(targetPDB()).To(Equal(intstr.FromInt32(2)))
+
+	// The spec config takes precedence, and maxUnavailable caps the derived value
+	ais.Spec.ConfigToUpdate = &aisv1.ConfigToUpdate{EC: &aisv1.ECConfToUpdate{Enabled: aisapc.Ptr(true), ParitySlices: aisapc.Ptr(4)}}
+	ais.Spec.TargetSpec.PodDisruptionBudget.MaxUnavailable = aisapc.Ptr(intstr.FromString("60%"))
+	g.Expect(r.reconcileTargetPDB(ctx, ais)).To(Succeed())
+	g.Expect(targetPDB()).To(Equal(intstr.FromInt32(3)))
+
+	ais.Spec.ConfigToUpdate.EC.Enabled = aisapc.Ptr(false)
+	g.Expect(r.reconcileTargetPDB(ctx, ais)).To(Succeed())
+	g.Expect(targetPDB()).To(Equal(intstr.FromInt32(0)))
+
+	// Two proxies cannot lose any without losing their majority, so they get no PDB to allow node drains
+	ais.Spec.ProxySpec.Size = aisapc.Ptr(int32(2))
+	g.Expect(r.reconcileProxyPDB(ctx, ais)).To(Succeed())
+	_, err := r.k8sClient.GetPDB(ctx, proxy.PDBNSName(ais))
+	g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	ais.Spec.ProxySpec.Size = nil
+	g.Expect(r.reconcileProxyPDB(ctx, ais)).To(Succeed())
+	g.Expect(*getPDB(proxy.PDBNSName(ais)).Spec.MinAvailable).To(Equal(intstr.FromInt32(3)))
+
+	// Back to manual mode, the proxy PDB is deleted
+	ais.Spec.TargetSpec.PodDisruptionBudget.Mode = aisapc.Ptr(aisv1.PDBModeManual)
+	g.Expect(r.reconcileTargetPDB(ctx, ais)).To(Succeed())
+	g.Expect(targetPDB()).To(Equal(intstr.FromString("60%")))
+	g.Expect(r.reconcileProxyPDB(ctx, ais)).To(Succeed())
+	_, err = r.k8sClient.GetPDB(ctx, proxy.PDBNSName(ais))
+	g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+}
@@ -58,8 +58,15 @@ func (r *Reconciler) initProxies(ctx context.Context, ais *aisv1.AIStore) (ctrl.
 		logger  = logf.FromContext(ctx)
 	)
 
-	// 1. Create a proxy statefulset with single replica as primary
-	ss := proxy.NewProxyStatefulSet(ais, 1)
+	// 1. Create a proxy statefulset with single replica as primary, or with all replicas to adopt
+	// the running proxies when the statefulset is recreated
+	replicas := int32(1)
+	if running, err := r.hasActiveProxyPods(ctx, ais); err != nil {
+		return ctrl.Result{}, err
+	} else if running {
+		replicas = ais.GetProxySize()
+	}
+	ss := proxy.NewProxyStatefulSet(ais, replicas)
 	if exists, err = r.k8sClient.CreateResourceIfNotExists(ctx, ais, ss); err != nil {
 		r.recordError(ctx, ais, err, "Failed to deploy Primary proxy")
 		return ctrl.Result{}, err
@@ -91,6 +98,19 @@ func (r *Reconciler) initProxies(ctx context.Context, ais *aisv1.AIStore) (ctrl.
 	return r.checkProxySvcEndpoints(ctx, ais)
 }
 
+func (r *Reconciler) hasActiveProxyPods(ctx context.Context, ais *aisv1.AIStore) (bool, error) {
+	pods, err := r.k8sClient.ListPods(ctx, ais, proxy.SelectorLabels(ais))
+	if err != nil {
+		return false, err
+	}
+	for i := range pods.Items {
+		if isPodActive(&pods.Items[i]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (r *Reconciler) checkProxySvcEndpoints(ctx context.Context, ais *aisv1.AIStore) (ctrl.Result, error) {
 	svcName := proxy.HeadlessSVCNSName(ais)
 	logger := logf.FromContext(ctx).WithValues("service", svcName.Name)
@@ -115,6 +135,7 @@ func (r *Reconciler) checkProxySvcEndpoints(ctx context.Context, ais *aisv1.AISt
 
 func (r *Reconciler) cleanupProxy(ctx context.Context, ais *aisv1.AIStore) (anyExisted bool, err error) {
 	return cmn.AnyFunc(
+		func() (bool, error) { return r.k8sClient.DeletePDBIfExists(ctx, proxy.PDBNSName(ais)) },
 		func() (bool, error) { return r.k8sClient.DeleteStatefulSetIfExists(ctx, proxy.StatefulSetNSName(ais)) },
 		func() (bool, error) { return r.k8sClient.DeleteServiceIfExists(ctx, proxy.HeadlessSVCNSName(ais)) },
 		func() (bool, error) { return r.k8sClient.DeleteServiceIfExists(ctx, proxy.LoadBalancerSVCNSName(ais)) },
@@ -122,6 +143,32 @@ func (r *Reconciler) cleanupProxy(ctx context.Context, ais *aisv1.AIStore) (anyE
 	)
 }
 
+// reconcileProxyPDB keeps a quorum of proxies available during voluntary disruptions when the target PDB is derived
+// from the data redundancy, and deletes the proxy PDB otherwise or when it would not allow any eviction
+func (r *Reconciler) reconcileProxyPDB(ctx context.Context, ais *aisv1.AIStore) error {
+	logger := logf.FromContext(ctx)
+	if ais.TargetPDBAuto() && proxy.NeedsPDB(ais) {
+		pdb := proxy.NewProxyPDB(ais)
+		changed, err := r.k8sClient.CreateOrUpdateResource(ctx, ais, pdb)
+		if err != nil {
+			return err
+		}
+		if changed {
+			logger.Info("Reconciled proxy PDB", "name", pdb.Name, "minAvailable", pdb.Spec.MinAvailable.String())
+		}
+		return nil
+	}
+	pdbName := proxy.PDBNSName(ais)
+	deleted, err := r.k8sClient.DeletePDBIfExists(ctx, pdbName)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		logger.Info("Deleted proxy PDB", "name", pdbName.Name)
+	}
+	return nil
+}
+
 func (r *Reconciler) handleProxyState(ctx context.Context, ais *aisv1.AIStore) (result ctrl.Result, err error) {
 	proxySSName := proxy.StatefulSetNSName(ais)
 	ss, err := r.k8sClient.GetStatefulSet(ctx, proxySSName)
@@ -132,7 +179,16 @@ func (r *Reconciler) handleProxyState(ctx context.Context, ais *aisv1.AIStore) (
 		return
 	}
 
+	if err = r.reconcileProxyPDB(ctx, ais); err != nil {
+		r.recordError(ctx, ais, err, "Failed to sync proxy PDB")
+		return
+	}
+
 	logger := logf.FromContext(ctx)
+	if !ss.DeletionTimestamp.IsZero() {
+		logger.Info("Waiting for proxy statefulset to be deleted before recreating it")
+		return ctrl.Result{RequeueAfter: statefulsetRequeueDelay}, nil
+	}
 	// Check status -- fields like UpdateRevision and CurrentRevision are unreliable when stale.
 	if !isStatusCurrent(ss) {
 		logger.V(1).Info("Proxy StatefulSet status is stale, re-queueing")
@@ -141,8 +197,10 @@ func (r *Reconciler) handleProxyState(ctx context.Context, ais *aisv1.AIStore) (
 
 	rolling := isRolloutInProgress(ss)
 	scaling := isScalingInProgress(ss)
-	rolloutNeeded, _ := shouldUpdatePodTemplate(&proxy.NewProxyStatefulSet(ais, ais.GetProxySize()).Spec.Template, &ss.Spec.Template)
+	desiredSS := proxy.NewProxyStatefulSet(ais, ais.GetProxySize())
+	rolloutNeeded, _ := shouldUpdatePodTemplate(&desiredSS.Spec.Template, &ss.Spec.Template)
 	scalingNeeded := isProxyScalingNeeded(ais, ss)
+	recreateNeeded := !cmn.StateClaimTemplatesMatch(ais, ss, desiredSS) && !scaling && !scalingNeeded
 
 	logger = logger.WithValues(
 		"statefulset", ss.Name,
@@ -152,6 +210,21 @@ func (r *Reconciler) handleProxyState(ctx context.Context, ais *aisv1.AIStore) (
 		"rolloutNeeded", rolloutNeeded, "scalingNeeded", scalingNeeded,
 	)
 
+	// Copy the state of proxies moving to a new state storage before any change rolls them
+	if (rolloutNeeded && !scaling) || recreateNeeded {
+		if copied, err := r.copyProxyState(ctx, ais); err != nil || !copied {
+			return ctrl.Result{RequeueAfter: proxyStartupInterval}, err
+		}
+	}
+
+	// Recreate the StatefulSet to change its state volume claim template (blocked by scaling)
+	if recreateNeeded {
+		if _, err := r.recreateForStateClaimTemplate(ctx, ais, ss, desiredSS); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: statefulsetRequeueDelay}, nil
+	}
+
 	// Apply template update (blocked by scaling in progress)
 	if rolloutNeeded && !scaling {
 		if updated, err := r.syncProxyPodSpec(ctx, ais, ss); err != nil {
@@ -170,7 +243,7 @@ func (r *Reconciler) handleProxyState(ctx context.Context, ais *aisv1.AIStore) (
 	// Apply scaling (blocked by rollout in progress)
 	if scalingNeeded && !rolling {
 		proceed, cErr := r.confirmScalingNeeded(ctx, proxy.StatefulSetNSName(ais), ss,
-			ais.GetProxySize(), ais.GetProxyMaxUnavailable(), ais.IsProxyAutoScaling())
+			ais.GetProxySize(), ais.GetProxyMaxUnavailable(), ais.IsProxyDynamicScaling())
 		if cErr != nil {
 			return ctrl.Result{}, cErr
 		}
@@ -203,11 +276,11 @@ func (r *Reconciler) handleProxyState(ctx context.Context, ais *aisv1.AIStore) (
 }
 
 func isProxyScalingNeeded(ais *aisv1.AIStore, ss *appsv1.StatefulSet) bool {
-	return statefulsetScalingNeeded(ss, ais.GetProxySize(), ais.GetProxyMaxUnavailable(), ais.IsProxyAutoScaling())
+	return statefulsetScalingNeeded(ss, ais.GetProxySize(), ais.GetProxyMaxUnavailable(), ais.IsProxyDynamicScaling())
 }
 
 func (r *Reconciler) isProxyStatefulSetReady(ais *aisv1.AIStore, ss *appsv1.StatefulSet) bool {
-	return r.isStatefulSetReady(ss, ais.GetProxySize(), ais.GetMinReadyProxies(), ais.IsProxyAutoScaling())
+	return r.isStatefulSetReady(ss, ais.GetProxySize(), ais.GetMinReadyProxies(), ais.IsProxyDynamicScaling())
 }
 
 func (r *Reconciler) handleProxyScale(ctx context.Context, ais *aisv1.AIStore, ss *appsv1.StatefulSet) error {
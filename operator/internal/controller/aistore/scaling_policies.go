@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	"github.com/ais-operator/internal/services"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// proxyMetricInterval is how often the load of proxies scaled by metric is read
+const proxyMetricInterval = 30 * time.Second
+
+// evaluateScalingPolicies records the target schedules in effect and the number of proxies needed for their load
+// in the autoscale status, which the daemon sizes are derived from. Scaling then follows the StatefulSet scaling of
+// fixed-size clusters, within autoScale.maxUnavailable and with the scale-down mode of targets.
+// Returns when the policies change next.
+func (r *Reconciler) evaluateScalingPolicies(ctx context.Context, ais *aisv1.AIStore) (ctrl.Result, error) {
+	now := time.Now()
+	status := ais.Status.AutoScaleStatus.DeepCopy()
+	var result ctrl.Result
+
+	status.TargetSchedules, status.TargetScheduleSize = nil, nil
+	if conf := ais.Spec.TargetSpec.AutoScaleConf; conf != nil && len(conf.Schedules) > 0 {
+		status.TargetSchedules, status.TargetScheduleSize = conf.ActiveSchedules(now)
+		if next := conf.NextScheduleChange(now); !next.IsZero() {
+			result.RequeueAfter = next.Sub(now)
+		}
+	}
+	if !slices.Equal(status.TargetSchedules, ais.Status.AutoScaleStatus.TargetSchedules) ||
+		!equalSize(status.TargetScheduleSize, ais.Status.AutoScaleStatus.TargetScheduleSize) {
+		msg := "No target schedule in effect"
+		if status.TargetScheduleSize != nil {
+			msg = fmt.Sprintf("Target schedules %v in effect with size %d", status.TargetSchedules, *status.TargetScheduleSize)
+		}
+		logf.FromContext(ctx).Info(msg)
+		r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonScheduleChanged, ActionScale, msg)
+	}
+
+	if conf := ais.Spec.ProxySpec.AutoScaleConf; conf == nil || conf.Metric == nil {
+		status.ProxyMetric = nil
+	} else {
+		if last := status.ProxyMetric; last == nil || now.Sub(last.LastEvaluationTime.Time) >= proxyMetricInterval {
+			status.ProxyMetric = r.evaluateProxyMetric(ctx, ais, conf.Metric, now)
+		}
+		result = earliestRequeue(result, ctrl.Result{RequeueAfter: proxyMetricInterval})
+	}
+
+	if equalAutoScaleStatus(status, &ais.Status.AutoScaleStatus) {
+		return result, nil
+	}
+	ais.Status.AutoScaleStatus = *status
+	return result, r.patchStatus(ctx, ais)
+}
+
+// evaluateProxyMetric reads the load of the proxies and returns the number of proxies it needs, between the
+// minimum size and the size without metric. Proxies are scaled up right away, but only scaled down once the
+// scale-down delay since they were last scaled elapsed. The last evaluation is kept if the metric cannot be read.
+func (r *Reconciler) evaluateProxyMetric(ctx context.Context, ais *aisv1.AIStore, metric *aisv1.MetricScalingSpec, now time.Time) *aisv1.MetricScalingStatus {
+	logger := logf.FromContext(ctx)
+	last := ais.Status.AutoScaleStatus.ProxyMetric
+	if last == nil {
+		// Proxies run at the size without metric until the first scale-down
+		last = &aisv1.MetricScalingStatus{Size: ais.GetProxyMaxSize(), LastScaleTime: metav1.NewTime(now)}
+	}
+	value, needed, err := r.readProxyLoad(ctx, ais, metric)
+	if err != nil {
+		logger.Info("Failed to read the proxy scaling metric", "err", err.Error())
+		r.recorder.Eventf(ais, nil, corev1.EventTypeWarning, EventReasonMetricUnavailable, ActionScale,
+			"Failed to read the proxy scaling metric: %v", err)
+		return last
+	}
+	size := min(max(needed, metric.GetMinSize()), ais.GetProxyMaxSize())
+	evaluated := &aisv1.MetricScalingStatus{
+		Size:               last.Size,
+		Value:              value,
+		LastEvaluationTime: metav1.NewTime(now),
+		LastScaleTime:      last.LastScaleTime,
+	}
+	if size > last.Size || (size < last.Size && now.Sub(last.LastScaleTime.Time) >= metric.GetScaleDownDelay()) {
+		evaluated.Size, evaluated.LastScaleTime = size, metav1.NewTime(now)
+		logger.Info("Scaling proxies by metric", "from", last.Size, "to", size, "load", value.String())
+		r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonProxyScaled, ActionScale,
+			"Scaling proxies from %d to %d for a load of %s", last.Size, size, value.String())
+	}
+	return evaluated
+}
+
+// readProxyLoad returns the load of the proxies and the number of proxies needed to handle it
+func (r *Reconciler) readProxyLoad(ctx context.Context, ais *aisv1.AIStore, metric *aisv1.MetricScalingSpec) (resource.Quantity, int32, error) {
+	if metric.Prometheus != nil {
+		value, err := services.QueryPrometheus(ctx, r.k8sClient, ais, metric.Prometheus)
+		if err != nil {
+			return resource.Quantity{}, 0, err
+		}
+		load := resource.NewMilliQuantity(int64(math.Round(value*1000)), resource.DecimalSI)
+		return *load, neededPods(value, metric.Prometheus.TargetValue.AsApproximateFloat64()), nil
+	}
+	podMetrics, err := r.k8sClient.ListPodMetrics(ctx, ais, proxy.SelectorLabels(ais))
+	if err != nil {
+		return resource.Quantity{}, 0, err
+	}
+	if len(podMetrics.Items) == 0 {
+		return resource.Quantity{}, 0, fmt.Errorf("no metrics reported for proxy pods")
+	}
+	usage := resource.NewMilliQuantity(0, resource.DecimalSI)
+	for i := range podMetrics.Items {
+		for j := range podMetrics.Items[i].Containers {
+			usage.Add(podMetrics.Items[i].Containers[j].Usage[corev1.ResourceCPU])
+		}
+	}
+	return *usage, neededPods(float64(usage.MilliValue()), float64(metric.CPU.MilliValue())), nil
+}
+
+// neededPods returns how many pods handling the target load each are needed for the total load
+func neededPods(load, perPod float64) int32 {
+	if perPod <= 0 || load <= 0 {
+		return 0
+	}
+	return int32(min(math.Ceil(load/perPod), math.MaxInt32))
+}
+
+func equalSize(a, b *int32) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func equalAutoScaleStatus(a, b *aisv1.AutoScaleStatus) bool {
+	if (a.ProxyMetric == nil) != (b.ProxyMetric == nil) {
+		return false
+	}
+	if a.ProxyMetric != nil && (a.ProxyMetric.Size != b.ProxyMetric.Size || !a.ProxyMetric.Value.Equal(b.ProxyMetric.Value) ||
+		!a.ProxyMetric.LastEvaluationTime.Equal(&b.ProxyMetric.LastEvaluationTime) ||
+		!a.ProxyMetric.LastScaleTime.Equal(&b.ProxyMetric.LastScaleTime)) {
+		return false
+	}
+	return slices.Equal(a.TargetSchedules, b.TargetSchedules) && equalSize(a.TargetScheduleSize, b.TargetScheduleSize)
+}
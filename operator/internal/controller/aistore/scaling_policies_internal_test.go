@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestNeededPods(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(neededPods(0, 500)).To(Equal(int32(0)))
+	g.Expect(neededPods(1200, 500)).To(Equal(int32(3)))
+	g.Expect(neededPods(1500, 500)).To(Equal(int32(3)))
+	g.Expect(neededPods(100, 0)).To(Equal(int32(0)))
+}
+
+func TestEvaluateScalingPolicies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	ais.Spec.Size = aisapc.Ptr(int32(4))
+	ais.Spec.ProxySpec.AutoScaleConf = &aisv1.AutoScaleConf{Metric: &aisv1.MetricScalingSpec{
+		MinSize:        aisapc.Ptr(int32(2)),
+		CPU:            aisapc.Ptr(resource.MustParse("500m")),
+		ScaleDownDelay: &metav1.Duration{Duration: 10 * time.Minute},
+	}}
+	ais.Spec.TargetSpec.AutoScaleConf = &aisv1.AutoScaleConf{Schedules: []aisv1.ScalingSchedule{
+		{Name: "campaign", Start: metav1.NewTime(now.Add(-time.Hour)), End: metav1.NewTime(now.Add(time.Hour)), Size: 6},
+	}}
+	proxyMetrics := func(name, cpu string) *metricsv1beta1.PodMetrics {
+		return &metricsv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ais.Namespace, Labels: proxy.SelectorLabels(ais)},
+			Containers: []metricsv1beta1.ContainerMetrics{{Name: "ais-node", Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)}}},
+		}
+	}
+	c := newFakeClientBuilder(g, metricsv1beta1.AddToScheme).
+		WithObjects(ais, proxyMetrics("ais-proxy-0", "700m"), proxyMetrics("ais-proxy-1", "500m")).
+		WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+
+	// Proxies are not scaled down before the scale-down delay, while the schedule in effect sets the target size
+	result, err := r.evaluateScalingPolicies(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(proxyMetricInterval))
+	status := ais.Status.AutoScaleStatus
+	g.Expect(status.TargetSchedules).To(Equal([]string{"campaign"}))
+	g.Expect(ais.GetTargetSize()).To(Equal(int32(6)))
+	g.Expect(status.ProxyMetric.Value.MilliValue()).To(Equal(int64(1200)))
+	g.Expect(ais.GetProxySize()).To(Equal(int32(4)))
+
+	// Scaled down to the 3 proxies needed for 1200m once the delay elapsed
+	ais.Status.AutoScaleStatus.ProxyMetric.LastEvaluationTime = metav1.NewTime(now.Add(-time.Minute))
+	ais.Status.AutoScaleStatus.ProxyMetric.LastScaleTime = metav1.NewTime(now.Add(-20 * time.Minute))
+	_, err = r.evaluateScalingPolicies(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ais.GetProxySize()).To(Equal(int32(3)))
+
+	// Scaled up right away, up to the size without metric
+	g.Expect(c.Delete(ctx, proxyMetrics("ais-proxy-1", "0"))).To(Succeed())
+	g.Expect(c.Create(ctx, proxyMetrics("ais-proxy-1", "3"))).To(Succeed())
+	ais.Status.AutoScaleStatus.ProxyMetric.LastEvaluationTime = metav1.NewTime(now.Add(-time.Minute))
+	_, err = r.evaluateScalingPolicies(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ais.GetProxySize()).To(Equal(int32(4)))
+
+	// The last size is kept while the metric cannot be read
+	g.Expect(c.Delete(ctx, proxyMetrics("ais-proxy-0", "0"))).To(Succeed())
+	g.Expect(c.Delete(ctx, proxyMetrics("ais-proxy-1", "0"))).To(Succeed())
+	ais.Status.AutoScaleStatus.ProxyMetric.LastEvaluationTime = metav1.NewTime(now.Add(-time.Minute))
+	_, err = r.evaluateScalingPolicies(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ais.GetProxySize()).To(Equal(int32(4)))
+
+	// Without policies, the status is cleared
+	ais.Spec.ProxySpec.AutoScaleConf, ais.Spec.TargetSpec.AutoScaleConf = nil, nil
+	result, err = r.evaluateScalingPolicies(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.IsZero()).To(BeTrue())
+	g.Expect(ais.Status.AutoScaleStatus.ProxyMetric).To(BeNil())
+	g.Expect(ais.Status.AutoScaleStatus.TargetScheduleSize).To(BeNil())
+	g.Expect(ais.GetTargetSize()).To(Equal(int32(4)))
+}
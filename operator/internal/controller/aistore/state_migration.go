@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func podSelectorLabels(ais *aisv1.AIStore, daeType string) map[string]string {
+	if daeType == aisapc.Target {
+		return target.SelectorLabels(ais)
+	}
+	return proxy.SelectorLabels(ais)
+}
+
+// recreateForStateClaimTemplate deletes the StatefulSet when the state storage adds, removes, or changes its state
+// PVC template, which cannot be updated in place. Its pods are orphaned and adopted by the StatefulSet recreated
+// from the spec on the next reconcile, then migrated to the new state storage by the rollout.
+func (r *Reconciler) recreateForStateClaimTemplate(ctx context.Context, ais *aisv1.AIStore, ss, desired *appsv1.StatefulSet) (recreating bool, err error) {
+	if cmn.StateClaimTemplatesMatch(ais, ss, desired) {
+		return false, nil
+	}
+	logf.FromContext(ctx).Info("Recreating statefulset to update its state volume claim template", "statefulset", ss.Name)
+	if _, err = r.k8sClient.DeleteResourceIfExists(ctx, ss, k8sclient.PropagationPolicy(metav1.DeletePropagationOrphan)); err != nil {
+		return false, fmt.Errorf("failed to delete statefulset %s: %w", ss.Name, err)
+	}
+	return true, nil
+}
+
+// migratePodState copies the state of the pod into the state storage in the spec before the pod is recreated with it,
+// returning whether the copy is done. The state of pods moving from or to emptyDir storage is not copied.
+func (r *Reconciler) migratePodState(ctx context.Context, ais *aisv1.AIStore, pod *corev1.Pod, daeType string) (copied bool, err error) {
+	src, dst := cmn.PodStateBacking(ais, pod, daeType), cmn.DesiredStateBacking(ais, pod.Name, daeType)
+	if src == dst || src.IsEphemeral() || dst.IsEphemeral() || pod.Spec.NodeName == "" {
+		return true, nil
+	}
+	logger := logf.FromContext(ctx).WithValues("pod", pod.Name)
+
+	job := &batchv1.Job{}
+	err = r.k8sClient.Get(ctx, types.NamespacedName{Name: cmn.StateCopyJobName(pod.Name), Namespace: ais.Namespace}, job)
+	if k8serrors.IsNotFound(err) {
+		if dst.Claim != "" {
+			// Create the claim the StatefulSet would create for the pod, so the state is copied into it
+			if _, err = r.k8sClient.CreateResourceIfNotExists(ctx, nil, cmn.NewStateClaim(ais, pod.Name, podSelectorLabels(ais, daeType))); err != nil {
+				return false, err
+			}
+		}
+		job = cmn.NewStateCopyJob(ais, pod, src, dst)
+		if _, err = r.k8sClient.CreateResourceIfNotExists(ctx, ais, job); err != nil {
+			return false, err
+		}
+		logger.Info("Started state copy job", "job", job.Name, "src", src, "dst", dst)
+		r.recorder.Eventf(ais, job, corev1.EventTypeNormal, EventReasonStateCopyStarted, ActionMigrateState,
+			"Copying state of pod %s to the new state storage", pod.Name)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	expected := cmn.NewStateCopyJob(ais, pod, src, dst)
+	if !equality.Semantic.DeepEqual(job.Spec.Template.Spec.Volumes, expected.Spec.Template.Spec.Volumes) {
+		// The state storage changed again since the job was created
+		logger.Info("Deleting outdated state copy job", "job", job.Name)
+		_, err = r.k8sClient.DeleteResourceIfExists(ctx, job, k8sclient.PropagationPolicy(metav1.DeletePropagationBackground))
+		return false, err
+	}
+
+	// Copy jobs report their progress like cleanup jobs
+	result := cleanupJobResult(job)
+	switch result.Phase {
+	case aisv1.HostCleanupSucceeded:
+		return true, nil
+	case aisv1.HostCleanupFailed:
+		r.recorder.Eventf(ais, job, corev1.EventTypeWarning, EventReasonStateCopyFailed, ActionMigrateState,
+			"State copy job %s failed: %s", job.Name, result.Message)
+		return false, fmt.Errorf("state copy job %s for pod %s failed, delete it to retry: %s", job.Name, pod.Name, result.Message)
+	}
+	logger.V(1).Info("Waiting for state copy job", "job", job.Name)
+	return false, nil
+}
+
+// copyProxyState copies the state of all proxies ahead of the rollout moving them to the new state storage,
+// returning whether all copies are done. Proxies keep running meanwhile, as they rejoin the cluster with
+// the latest metadata.
+func (r *Reconciler) copyProxyState(ctx context.Context, ais *aisv1.AIStore) (copied bool, err error) {
+	pods, err := r.k8sClient.ListPods(ctx, ais, proxy.SelectorLabels(ais))
+	if err != nil {
+		return false, err
+	}
+	copied = true
+	for i := range pods.Items {
+		if !isPodActive(&pods.Items[i]) {
+			continue
+		}
+		done, err := r.migratePodState(ctx, ais, &pods.Items[i], aisapc.Proxy)
+		if err != nil {
+			return false, err
+		}
+		copied = copied && done
+	}
+	return copied, nil
+}
+
+// listPodsPendingStateMigration returns the names of the active pods keeping their state in another volume
+// than the one in the spec.
+func (r *Reconciler) listPodsPendingStateMigration(ctx context.Context, ais *aisv1.AIStore, daeType string) ([]string, error) {
+	pods, err := r.k8sClient.ListPods(ctx, ais, podSelectorLabels(ais, daeType))
+	if err != nil {
+		return nil, err
+	}
+	var pending []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if isPodActive(pod) && cmn.NeedsStateMigration(ais, pod, daeType) {
+			pending = append(pending, pod.Name)
+		}
+	}
+	return pending, nil
+}
+
+// updateStateMigrationStatus reports the pods still using a previous state storage and the failed copy job, if any.
+// Copy jobs of pods that no longer need a migration are deleted, while failed jobs are kept until deleted to retry.
+func (r *Reconciler) updateStateMigrationStatus(ctx context.Context, ais *aisv1.AIStore) error {
+	var pending []string
+	for _, daeType := range []string{aisapc.Proxy, aisapc.Target} {
+		pods, err := r.listPodsPendingStateMigration(ctx, ais, daeType)
+		if err != nil {
+			return err
+		}
+		pending = append(pending, pods...)
+	}
+
+	jobs := &batchv1.JobList{}
+	err := r.k8sClient.List(ctx, jobs, k8sclient.InNamespace(ais.Namespace),
+		k8sclient.MatchingLabels(cmn.SelectorLabels(ais.Name, cmn.StateMigrationPrefix)))
+	if err != nil {
+		return err
+	}
+	var status *aisv1.StateMigrationStatus
+	if len(pending) > 0 {
+		status = &aisv1.StateMigrationStatus{PendingPods: pending}
+	}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		podName := job.Annotations[cmn.StateMigrationPodAnnotation]
+		if status == nil || !slices.Contains(pending, podName) {
+			if _, err := r.k8sClient.DeleteResourceIfExists(ctx, job, k8sclient.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+				return err
+			}
+			logf.FromContext(ctx).Info("Deleted state copy job of migrated pod", "job", job.Name, "pod", podName)
+			continue
+		}
+		if result := cleanupJobResult(job); result.Phase == aisv1.HostCleanupFailed {
+			status.FailedJob, status.Message = job.Name, result.Message
+		}
+	}
+
+	if reflect.DeepEqual(ais.Status.StateMigration, status) {
+		return nil
+	}
+	ais.Status.StateMigration = status
+	return r.patchStatus(ctx, ais)
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newStateMigrationTest(t *testing.T) (*WithT, *aisv1.AIStore, *corev1.Pod) {
+	g := NewWithT(t)
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	ais.Spec.Size = aisapc.Ptr(int32(1))
+	ais.Spec.StateStorage = &aisv1.StateStorage{HostPath: &aisv1.StateHostPathConfig{Prefix: "/etc/ais"}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: target.PodName(ais, 0), Namespace: ais.Namespace, Labels: target.SelectorLabels(ais)},
+		Spec:       corev1.PodSpec{NodeName: "node-1", Volumes: cmn.NewAISVolumes(ais, aisapc.Target)},
+	}
+	// Move the cluster to PVC state after the pod was created with hostPath state
+	ais.Spec.StateStorage = &aisv1.StateStorage{PVC: &aisv1.StatePVCConfig{StorageClass: "local-path"}}
+	return g, ais, pod
+}
+
+func TestMigratePodState(t *testing.T) {
+	g, ais, pod := newStateMigrationTest(t)
+	ctx := context.Background()
+	c := newFakeClientBuilder(g).WithObjects(ais, pod).Build()
+	r := newTestReconciler(c, nil)
+
+	// The claim of the pod is created ahead of the statefulset, and the state copied into it
+	copied, err := r.migratePodState(ctx, ais, pod, aisapc.Target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(copied).To(BeFalse())
+	pvc := &corev1.PersistentVolumeClaim{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: cmn.StateClaimName(ais, pod.Name), Namespace: ais.Namespace}, pvc)).To(Succeed())
+	g.Expect(pvc.Labels).To(Equal(target.SelectorLabels(ais)))
+	job := &batchv1.Job{}
+	jobName := types.NamespacedName{Name: cmn.StateCopyJobName(pod.Name), Namespace: ais.Namespace}
+	g.Expect(c.Get(ctx, jobName, job)).To(Succeed())
+	g.Expect(job.OwnerReferences).To(HaveLen(1))
+
+	// Running
+	job.CreationTimestamp = metav1.Now()
+	g.Expect(c.Update(ctx, job)).To(Succeed())
+	copied, err = r.migratePodState(ctx, ais, pod, aisapc.Target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(copied).To(BeFalse())
+
+	// Failed jobs block the migration until they are deleted
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"}}
+	g.Expect(c.Status().Update(ctx, job)).To(Succeed())
+	_, err = r.migratePodState(ctx, ais, pod, aisapc.Target)
+	g.Expect(err).To(MatchError(ContainSubstring("BackoffLimitExceeded")))
+
+	job.Status.Conditions = nil
+	job.Status.Succeeded = 1
+	g.Expect(c.Status().Update(ctx, job)).To(Succeed())
+	copied, err = r.migratePodState(ctx, ais, pod, aisapc.Target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(copied).To(BeTrue())
+
+	// A job copying into a previous state storage is replaced
+	ais.Spec.StateStorage = &aisv1.StateStorage{HostPath: &aisv1.StateHostPathConfig{Prefix: "/var/ais"}}
+	copied, err = r.migratePodState(ctx, ais, pod, aisapc.Target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(copied).To(BeFalse())
+	g.Expect(k8serrors.IsNotFound(c.Get(ctx, jobName, job))).To(BeTrue())
+
+	// Nothing to copy into emptyDir state
+	ais.Spec.StateStorage = &aisv1.StateStorage{EmptyDir: &aisv1.StateEmptyDirConfig{}}
+	copied, err = r.migratePodState(ctx, ais, pod, aisapc.Target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(copied).To(BeTrue())
+	g.Expect(k8serrors.IsNotFound(c.Get(ctx, jobName, job))).To(BeTrue())
+}
+
+func TestUpdateStateMigrationStatus(t *testing.T) {
+	g, ais, pod := newStateMigrationTest(t)
+	ctx := context.Background()
+	failed := cmn.NewStateCopyJob(ais, pod, cmn.PodStateBacking(ais, pod, aisapc.Target), cmn.DesiredStateBacking(ais, pod.Name, aisapc.Target))
+	failed.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "DeadlineExceeded"}}
+	stale := failed.DeepCopy()
+	stale.Name, stale.Annotations[cmn.StateMigrationPodAnnotation] = cmn.StateCopyJobName("ais-target-1"), "ais-target-1"
+
+	c := newFakeClientBuilder(g).WithObjects(ais, pod, failed, stale).WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+
+	g.Expect(r.updateStateMigrationStatus(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.StateMigration).To(Equal(&aisv1.StateMigrationStatus{
+		PendingPods: []string{pod.Name},
+		FailedJob:   failed.Name,
+		Message:     "DeadlineExceeded",
+	}))
+	// The job of a pod no longer migrating is deleted
+	jobs := &batchv1.JobList{}
+	g.Expect(c.List(ctx, jobs, k8sclient.InNamespace(ais.Namespace))).To(Succeed())
+	g.Expect(jobs.Items).To(HaveLen(1))
+	g.Expect(jobs.Items[0].Name).To(Equal(failed.Name))
+
+	// Once the pod uses the new state storage the status is cleared
+	ais.Spec.StateStorage = &aisv1.StateStorage{HostPath: &aisv1.StateHostPathConfig{Prefix: "/etc/ais"}}
+	g.Expect(r.updateStateMigrationStatus(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.StateMigration).To(BeNil())
+	g.Expect(c.List(ctx, jobs, k8sclient.InNamespace(ais.Namespace))).To(Succeed())
+	g.Expect(jobs.Items).To(BeEmpty())
+}
+
+func TestRecreateForStateClaimTemplate(t *testing.T) {
+	g, ais, _ := newStateMigrationTest(t)
+	ctx := context.Background()
+	ais.Spec.StateStorage = &aisv1.StateStorage{HostPath: &aisv1.StateHostPathConfig{Prefix: "/etc/ais"}}
+	ss := target.NewTargetSS(ais, 1)
+	c := newFakeClientBuilder(g).WithObjects(ais, ss).Build()
+	r := newTestReconciler(c, nil)
+
+	recreating, err := r.recreateForStateClaimTemplate(ctx, ais, ss, target.NewTargetSS(ais, 1))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(recreating).To(BeFalse())
+
+	ais.Spec.StateStorage = &aisv1.StateStorage{PVC: &aisv1.StatePVCConfig{StorageClass: "local-path"}}
+	recreating, err = r.recreateForStateClaimTemplate(ctx, ais, ss, target.NewTargetSS(ais, 1))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(recreating).To(BeTrue())
+	g.Expect(k8serrors.IsNotFound(c.Get(ctx, k8sclient.ObjectKeyFromObject(ss), &appsv1.StatefulSet{}))).To(BeTrue())
+}
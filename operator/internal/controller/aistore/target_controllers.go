@@ -7,9 +7,12 @@ package aistore
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aiscmn "github.com/NVIDIA/aistore/cmn"
 	aismeta "github.com/NVIDIA/aistore/core/meta"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	"github.com/ais-operator/internal/resources/aistore/cmn"
@@ -18,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -26,9 +30,41 @@ import (
 const targetLongRequeueDelay = 10 * time.Second
 const targetShortRequeueDelay = 2 * time.Second
 
-func (r *Reconciler) ensureTargetPrereqs(ctx context.Context, ais *aisv1.AIStore) (err error) {
+// ensureTargetPrereqs deploys the ConfigMap and headless service of the targets of targetSpec and of each pool
+func (r *Reconciler) ensureTargetPrereqs(ctx context.Context, ais *aisv1.AIStore) error {
+	if err := r.ensureTargetPoolPrereqs(ctx, ais); err != nil {
+		return err
+	}
+	for i := range ais.Spec.TargetPools {
+		view := ais.ForTargetPool(&ais.Spec.TargetPools[i])
+		err := r.ensureTargetPoolPrereqs(ctx, view)
+		syncFromTargetPool(ais, view)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncFromTargetPool keeps the status and annotations updated while reconciling the view of a target pool
+func syncFromTargetPool(ais, view *aisv1.AIStore) {
+	ais.Status = view.Status
+	ais.Annotations = view.Annotations
+}
+
+func (r *Reconciler) ensureTargetPoolPrereqs(ctx context.Context, ais *aisv1.AIStore) (err error) {
 	// 1. Deploy required ConfigMap
-	cm, err := target.NewTargetCM(ais)
+	nodeMountpaths, err := r.discoverMountpaths(ctx, ais)
+	if err != nil {
+		r.recordError(ctx, ais, err, "Failed to discover target mountpaths")
+		return
+	}
+	nodeTopology, err := r.labelNodeTopology(ctx, ais)
+	if err != nil {
+		r.recordError(ctx, ais, err, "Failed to read the topology of target nodes")
+		return
+	}
+	cm, err := target.NewTargetCM(ais, nodeMountpaths, nodeTopology)
 	if err != nil {
 		r.recordError(ctx, ais, err, "Failed to generate valid target ConfigMap")
 		return
@@ -68,15 +104,23 @@ func (r *Reconciler) initTargets(ctx context.Context, ais *aisv1.AIStore) (resul
 // reconcileTargetPDB handles creating, updating, or deleting the target PDB
 func (r *Reconciler) reconcileTargetPDB(ctx context.Context, ais *aisv1.AIStore) error {
 	logger := logf.FromContext(ctx)
-	if ais.TargetPDBEnabled() {
-		pdb := target.NewTargetPDB(ais)
+	// Targets of drained nodes are protected until their data is rebalanced, even without a PDB in the spec
+	if ais.TargetPDBEnabled() || ais.NodeDrainEnabled() || len(ais.TargetDrainsRebalancing()) > 0 {
+		maxUnavailable, reason, err := r.targetPDBMaxUnavailable(ctx, ais)
+		if err != nil {
+			return err
+		}
+		pdb := target.NewTargetPDB(ais, maxUnavailable)
 		changed, err := r.k8sClient.CreateOrUpdateResource(ctx, ais, pdb)
 		if err != nil {
 			return err
 		}
 		if changed {
-			maxUnavailable := ais.GetTargetPDBMaxUnavailable()
-			logger.Info("Reconciled target PDB", "name", pdb.Name, "maxUnavailable", maxUnavailable.String())
+			logger.Info("Reconciled target PDB", "name", pdb.Name, "maxUnavailable", maxUnavailable.String(), "reason", reason)
+			if ais.TargetPDBAuto() || ais.NodeDrainEnabled() {
+				r.recorder.Eventf(ais, pdb, corev1.EventTypeNormal, EventReasonPDBUpdated, ActionReconcile,
+					"Target PDB allows %s unavailable targets with %s", maxUnavailable.String(), reason)
+			}
 		}
 		return nil
 	}
@@ -92,25 +136,112 @@ func (r *Reconciler) reconcileTargetPDB(ctx context.Context, ais *aisv1.AIStore)
 	return nil
 }
 
+// targetPDBMaxUnavailable returns the maximum number of unavailable targets of the PDB, and what it is derived from.
+// No target is allowed to be unavailable while data is rebalanced off the targets of drained nodes. With node drain
+// coordination, none is at any time, as the targets are released one by one once rebalanced, see LabelDrainReleased.
+// In `Auto` mode, values missing from spec.configToUpdate are read from the cluster config. If the cluster cannot
+// be reached, the current PDB is kept, or no target is allowed to be unavailable until it can.
+func (r *Reconciler) targetPDBMaxUnavailable(ctx context.Context, ais *aisv1.AIStore) (intstr.IntOrString, string, error) {
+	if nodes := ais.TargetDrainsRebalancing(); len(nodes) > 0 {
+		return intstr.FromInt32(0), "the rebalance after draining nodes " + strings.Join(nodes, ", "), nil
+	}
+	if ais.NodeDrainEnabled() {
+		return intstr.FromInt32(0), "spec.targetSpec.nodeDrain, releasing targets once rebalanced", nil
+	}
+	if !ais.TargetPDBAuto() {
+		return ais.GetTargetPDBMaxUnavailable(), "spec.targetSpec.pdb.maxUnavailable", nil
+	}
+	var live *aiscmn.ClusterConfig
+	if target.RedundancyNeedsLiveConfig(ais) {
+		var err error
+		live, err = r.getClusterConfig(ctx, ais)
+		if err != nil {
+			logf.FromContext(ctx).Info("Failed to read cluster config for the target PDB", "err", err.Error())
+			pdb, getErr := r.k8sClient.GetPDB(ctx, target.PDBNSName(ais))
+			switch {
+			case getErr == nil && pdb.Spec.MaxUnavailable != nil:
+				return *pdb.Spec.MaxUnavailable, "the last known cluster config", nil
+			case getErr != nil && !k8serrors.IsNotFound(getErr):
+				return intstr.IntOrString{}, "", getErr
+			}
+			return intstr.FromInt32(0), "an unknown cluster config", nil
+		}
+	}
+	redundancy := target.ResolveRedundancy(ais, live)
+	reason := redundancy.String()
+	if ais.GetClusterTargetSize() > ais.GetTargetSize() {
+		reason += ", split across the target pools"
+	}
+	return target.AutoPDBMaxUnavailable(ais, redundancy), reason, nil
+}
+
+func (r *Reconciler) getClusterConfig(ctx context.Context, ais *aisv1.AIStore) (*aiscmn.ClusterConfig, error) {
+	apiClient, err := r.clientManager.GetClient(ctx, ais)
+	if err != nil {
+		return nil, err
+	}
+	return apiClient.GetClusterConfig()
+}
+
+// cleanupTarget deletes the resources of the targets of targetSpec and of each pool, including removed pools
 func (r *Reconciler) cleanupTarget(ctx context.Context, ais *aisv1.AIStore) (updated bool, err error) {
+	pools, err := r.listTargetPoolViews(ctx, ais)
+	if err != nil {
+		return false, err
+	}
+	for _, view := range pools {
+		poolUpdated, poolErr := r.cleanupTargetPool(ctx, view)
+		if poolErr != nil {
+			return updated, poolErr
+		}
+		updated = updated || poolUpdated
+	}
+	poolUpdated, err := cmn.AnyFunc(
+		func() (bool, error) { return r.cleanupTargetPool(ctx, ais) },
+		func() (bool, error) {
+			return r.k8sClient.DeleteAllServicesIfExist(ctx, ais.Namespace, cmn.NewServiceLabels(ais.Name, target.ServiceLabelLB))
+		},
+	)
+	return updated || poolUpdated, err
+}
+
+// cleanupTargetPool deletes the PDB, StatefulSet, headless service and ConfigMap of the targets of a pool, or of
+// targetSpec when given the cluster itself
+func (r *Reconciler) cleanupTargetPool(ctx context.Context, ais *aisv1.AIStore) (updated bool, err error) {
 	return cmn.AnyFunc(
 		func() (bool, error) { return r.k8sClient.DeletePDBIfExists(ctx, target.PDBNSName(ais)) },
 		func() (bool, error) { return r.cleanupTargetSS(ctx, ais) },
 		func() (bool, error) { return r.k8sClient.DeleteServiceIfExists(ctx, target.HeadlessSVCNSName(ais)) },
-		func() (bool, error) {
-			return r.k8sClient.DeleteAllServicesIfExist(ctx, ais.Namespace, cmn.NewServiceLabels(ais.Name, target.ServiceLabelLB))
-		},
 		func() (bool, error) { return r.k8sClient.DeleteConfigMapIfExists(ctx, target.ConfigMapNSName(ais)) },
 	)
 }
 
 func (r *Reconciler) cleanupTargetSS(ctx context.Context, ais *aisv1.AIStore) (anyUpdated bool, err error) {
-	logf.FromContext(ctx).Info("Cleaning up target statefulset")
+	logf.FromContext(ctx).Info("Cleaning up target statefulset", "pool", ais.Spec.TargetSpec.Pool)
 	targetSS := target.StatefulSetNSName(ais)
 	return r.k8sClient.DeleteStatefulSetIfExists(ctx, targetSS)
 }
 
+// handleTargetState reconciles the StatefulSet of the targets of targetSpec, then the one of each pool, so each
+// is rolled out and scaled on its own. Pools removed from the spec are deleted once scaled down.
 func (r *Reconciler) handleTargetState(ctx context.Context, ais *aisv1.AIStore) (result ctrl.Result, err error) {
+	if result, err = r.handleTargetPoolState(ctx, ais); err != nil {
+		return result, err
+	}
+	for i := range ais.Spec.TargetPools {
+		view := ais.ForTargetPool(&ais.Spec.TargetPools[i])
+		poolResult, poolErr := r.handleTargetPoolState(logf.IntoContext(ctx, logf.FromContext(ctx).WithValues("pool", view.Spec.TargetSpec.Pool)), view)
+		syncFromTargetPool(ais, view)
+		if poolErr != nil {
+			return poolResult, poolErr
+		}
+		result = earliestRequeue(result, poolResult)
+	}
+	removedResult, err := r.deleteRemovedTargetPools(ctx, ais)
+	return earliestRequeue(result, removedResult), err
+}
+
+func (r *Reconciler) handleTargetPoolState(ctx context.Context, ais *aisv1.AIStore) (result ctrl.Result, err error) {
 	ss, err := r.k8sClient.GetStatefulSet(ctx, target.StatefulSetNSName(ais))
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
@@ -125,15 +256,31 @@ func (r *Reconciler) handleTargetState(ctx context.Context, ais *aisv1.AIStore)
 	}
 
 	logger := logf.FromContext(ctx)
+	if !ss.DeletionTimestamp.IsZero() {
+		logger.Info("Waiting for target statefulset to be deleted before recreating it")
+		return ctrl.Result{RequeueAfter: statefulsetRequeueDelay}, nil
+	}
 	// Check status -- fields like UpdateRevision and CurrentRevision are unreliable when stale.
 	if !isStatusCurrent(ss) {
 		logger.V(1).Info("Target StatefulSet status is stale, re-queueing")
 		return ctrl.Result{RequeueAfter: statefulsetRequeueDelay}, nil
 	}
 
-	rolling := isRolloutInProgress(ss)
+	// Pods adopted by a recreated StatefulSet are on its current revision, so they are tracked by their state volume
+	pendingStateMigration, err := r.listPodsPendingStateMigration(ctx, ais, aisapc.Target)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	// The targets of pools are also selected for targetSpec, and are migrated by their own StatefulSet
+	pendingStateMigration = slices.DeleteFunc(pendingStateMigration, func(podName string) bool {
+		_, ok := cmn.ResourceOrdinal(podName, ss.Name)
+		return !ok
+	})
+	migrating := len(pendingStateMigration) > 0
+	rolling := isRolloutInProgress(ss) || migrating
 	scaling := isScalingInProgress(ss)
-	rolloutNeeded, _ := shouldUpdatePodTemplate(&target.NewTargetSS(ais, ais.GetTargetSize()).Spec.Template, &ss.Spec.Template)
+	desiredSS := target.NewTargetSS(ais, ais.GetTargetSize())
+	rolloutNeeded, _ := shouldUpdatePodTemplate(&desiredSS.Spec.Template, &ss.Spec.Template)
 	scalingNeeded := isTargetScalingNeeded(ais, ss)
 
 	logger = logger.WithValues(
@@ -150,6 +297,13 @@ func (r *Reconciler) handleTargetState(ctx context.Context, ais *aisv1.AIStore)
 		return ctrl.Result{RequeueAfter: statefulsetRequeueDelay}, nil
 	}
 
+	// Recreate the StatefulSet to change its state volume claim template (blocked by scaling)
+	if !scaling && !scalingNeeded {
+		if recreating, err := r.recreateForStateClaimTemplate(ctx, ais, ss, desiredSS); err != nil || recreating {
+			return ctrl.Result{RequeueAfter: statefulsetRequeueDelay}, err
+		}
+	}
+
 	// Apply template update (blocked by scaling in progress)
 	if rolloutNeeded && !scaling {
 		if updated, err := r.syncTargetPodSpec(ctx, ais, ss); err != nil {
@@ -166,7 +320,7 @@ func (r *Reconciler) handleTargetState(ctx context.Context, ais *aisv1.AIStore)
 
 	// Drive ongoing rollout
 	if rolling {
-		if res, err := r.handleTargetRollout(ctx, ais, ss); err != nil || !res.IsZero() {
+		if res, err := r.handleTargetRollout(ctx, ais, ss, migrating); err != nil || !res.IsZero() {
 			return res, err
 		}
 		return ctrl.Result{RequeueAfter: targetLongRequeueDelay}, nil
@@ -189,7 +343,7 @@ func (r *Reconciler) handleTargetState(ctx context.Context, ais *aisv1.AIStore)
 func (r *Reconciler) handleTargetScaling(ctx context.Context, ais *aisv1.AIStore, ss *appsv1.StatefulSet) (ctrl.Result, error) {
 	logger := logf.FromContext(ctx)
 	proceed, err := r.confirmScalingNeeded(ctx, target.StatefulSetNSName(ais), ss,
-		ais.GetTargetSize(), ais.GetTargetMaxUnavailable(), ais.IsTargetAutoScaling())
+		ais.GetTargetSize(), ais.GetTargetMaxUnavailable(), ais.IsTargetDynamicScaling())
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -207,11 +361,11 @@ func (r *Reconciler) handleTargetScaling(ctx context.Context, ais *aisv1.AIStore
 }
 
 func isTargetScalingNeeded(ais *aisv1.AIStore, ss *appsv1.StatefulSet) bool {
-	return statefulsetScalingNeeded(ss, ais.GetTargetSize(), ais.GetTargetMaxUnavailable(), ais.IsTargetAutoScaling())
+	return statefulsetScalingNeeded(ss, ais.GetTargetSize(), ais.GetTargetMaxUnavailable(), ais.IsTargetDynamicScaling())
 }
 
 func (r *Reconciler) isTargetStatefulSetReady(ais *aisv1.AIStore, ss *appsv1.StatefulSet) bool {
-	return r.isStatefulSetReady(ss, ais.GetTargetSize(), ais.GetMinReadyTargets(), ais.IsTargetAutoScaling())
+	return r.isStatefulSetReady(ss, ais.GetTargetSize(), ais.GetMinReadyTargets(), ais.IsTargetDynamicScaling())
 }
 
 func (r *Reconciler) resolveStatefulSetScaling(ctx context.Context, ais *aisv1.AIStore) error {
@@ -269,7 +423,7 @@ func (r *Reconciler) isReadyToScaleDown(ctx context.Context, ais *aisv1.AIStore,
 	if ais.Spec.TargetSpec.RetainOnScaleDown() {
 		return isReadyToScaleDownRetain(ctx, ais, smap, currentSize), nil
 	}
-	return isReadyToScaleDownDecommission(ctx, smap, currentSize), nil
+	return isReadyToScaleDownDecommission(ctx, ais, smap, currentSize), nil
 }
 
 // isReadyToScaleDownRetain reports whether every target being removed is in maintenance. Maintenance is a
@@ -295,7 +449,7 @@ func isReadyToScaleDownRetain(ctx context.Context, ais *aisv1.AIStore, smap *ais
 // isReadyToScaleDownDecommission reports whether a decommission-path scale-down can proceed. A
 // decommissioned target leaves the cluster map, so wait until none are mid-decommission and the node
 // count has dropped.
-func isReadyToScaleDownDecommission(ctx context.Context, smap *aismeta.Smap, currentSize int32) bool {
+func isReadyToScaleDownDecommission(ctx context.Context, ais *aisv1.AIStore, smap *aismeta.Smap, currentSize int32) bool {
 	logger := logf.FromContext(ctx)
 	// If any targets are still in the smap as decommissioning, delay scaling
 	for _, targetNode := range smap.Tmap {
@@ -305,13 +459,30 @@ func isReadyToScaleDownDecommission(ctx context.Context, smap *aismeta.Smap, cur
 		}
 	}
 	// If we have the same number of target nodes as current replicas and none showed as decommissioned, don't scale
-	if int32(len(smap.Tmap)) == currentSize {
+	if countStatefulSetTargets(ais, smap) == currentSize {
 		logger.Info("Delaying scaling. All target nodes are still listed as active")
 		return false
 	}
 	return true
 }
 
+// countStatefulSetTargets returns the number of targets in the cluster map run by the StatefulSet of the targets.
+// Without target pools, all targets are run by it.
+func countStatefulSetTargets(ais *aisv1.AIStore, smap *aismeta.Smap) int32 {
+	if ais.Spec.TargetSpec.Pool == "" && len(ais.Spec.TargetPools) == 0 {
+		return int32(len(smap.Tmap))
+	}
+	ssName := target.StatefulSetNSName(ais).Name
+	var count int32
+	for _, node := range smap.Tmap {
+		podName, _, _ := strings.Cut(node.ControlNet.Hostname, ".")
+		if _, ok := cmn.ResourceOrdinal(podName, ssName); ok {
+			count++
+		}
+	}
+	return count
+}
+
 func (r *Reconciler) startTargetScaling(ctx context.Context, ais *aisv1.AIStore, ss *appsv1.StatefulSet) error {
 	if *ss.Spec.Replicas < ais.GetTargetSize() {
 		// Current SS has fewer replicas than expected size - scale up.
@@ -488,7 +659,7 @@ func (r *Reconciler) findPodNeedingUpdate(ctx context.Context, ais *aisv1.AIStor
 
 		// Proceed to checking next pod if current pod is up-to-date
 		podRevision := pod.Labels[appsv1.ControllerRevisionHashLabelKey]
-		if podRevision == ss.Status.UpdateRevision {
+		if podRevision == ss.Status.UpdateRevision && !cmn.NeedsStateMigration(ais, pod, aisapc.Target) {
 			continue
 		}
 
@@ -505,16 +676,20 @@ func (r *Reconciler) findPodNeedingUpdate(ctx context.Context, ais *aisv1.AIStor
 	return ""
 }
 
-func (r *Reconciler) handleTargetRollout(ctx context.Context, ais *aisv1.AIStore, ss *appsv1.StatefulSet) (ctrl.Result, error) {
+// handleTargetRollout recreates the next outdated target pod, or the next pod keeping its state in a previous
+// state storage when migrating, after putting its AIS target into maintenance and copying its state.
+func (r *Reconciler) handleTargetRollout(ctx context.Context, ais *aisv1.AIStore, ss *appsv1.StatefulSet, migrating bool) (ctrl.Result, error) {
 	logger := logf.FromContext(ctx)
-	// Only handle rollouts if there's a revision mismatch
-	if ss.Status.UpdateRevision == "" || ss.Status.CurrentRevision == ss.Status.UpdateRevision {
-		return ctrl.Result{}, nil
-	}
+	if !migrating {
+		// Only handle rollouts if there's a revision mismatch
+		if ss.Status.UpdateRevision == "" || ss.Status.CurrentRevision == ss.Status.UpdateRevision {
+			return ctrl.Result{}, nil
+		}
 
-	// If all pods are updated and ready, rollout is complete
-	if ss.Status.UpdatedReplicas >= *ss.Spec.Replicas && ss.Status.ReadyReplicas >= *ss.Spec.Replicas {
-		return ctrl.Result{}, nil
+		// If all pods are updated and ready, rollout is complete
+		if ss.Status.UpdatedReplicas >= *ss.Spec.Replicas && ss.Status.ReadyReplicas >= *ss.Spec.Replicas {
+			return ctrl.Result{}, nil
+		}
 	}
 
 	podName := r.findPodNeedingUpdate(ctx, ais, ss)
@@ -530,6 +705,14 @@ func (r *Reconciler) handleTargetRollout(ctx context.Context, ais *aisv1.AIStore
 		return ctrl.Result{RequeueAfter: targetShortRequeueDelay}, nil
 	}
 
+	pod, err := r.k8sClient.GetPod(ctx, types.NamespacedName{Name: podName, Namespace: ais.Namespace})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get pod %s: %w", podName, err)
+	}
+	if copied, err := r.migratePodState(ctx, ais, pod, aisapc.Target); err != nil || !copied {
+		return ctrl.Result{RequeueAfter: targetShortRequeueDelay}, err
+	}
+
 	_, err = r.k8sClient.DeletePodIfExists(ctx, types.NamespacedName{
 		Name:      podName,
 		Namespace: ais.Namespace,
@@ -587,14 +770,14 @@ func (r *Reconciler) prepareTargetForRollout(ctx context.Context, ais *aisv1.AIS
 }
 
 func (r *Reconciler) scaleUpLB(ctx context.Context, ais *aisv1.AIStore) error {
-	if !ais.TargetExternalAccessEnabled() {
+	if !ais.TargetLoadBalancerEnabled() {
 		return nil
 	}
 	return r.createTargetExternalServices(ctx, ais)
 }
 
 func (r *Reconciler) scaleDownLB(ctx context.Context, ais *aisv1.AIStore, ss *appsv1.StatefulSet) error {
-	if !ais.TargetExternalAccessEnabled() {
+	if !ais.TargetLoadBalancerEnabled() {
 		return nil
 	}
 	for idx := *ss.Spec.Replicas; idx > ais.GetTargetSize(); idx-- {
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"slices"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// listRemovedTargetPools returns the StatefulSets of the target pools no longer in the spec
+func (r *Reconciler) listRemovedTargetPools(ctx context.Context, ais *aisv1.AIStore) ([]appsv1.StatefulSet, error) {
+	ssList := &appsv1.StatefulSetList{}
+	err := r.k8sClient.List(ctx, ssList,
+		client.InNamespace(ais.Namespace),
+		client.MatchingLabels(cmn.SelectorLabels(ais.Name, aisapc.Target)),
+		client.HasLabels{cmn.LabelTargetPool},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(ssList.Items, func(ss appsv1.StatefulSet) bool {
+		return slices.ContainsFunc(ais.Spec.TargetPools, func(pool aisv1.TargetPoolSpec) bool {
+			return pool.Name == ss.Labels[cmn.LabelTargetPool]
+		})
+	}), nil
+}
+
+// listTargetPoolViews returns the view of each target pool in the spec, followed by the view of each pool
+// removed from the spec whose StatefulSet still exists
+func (r *Reconciler) listTargetPoolViews(ctx context.Context, ais *aisv1.AIStore) ([]*aisv1.AIStore, error) {
+	removed, err := r.listRemovedTargetPools(ctx, ais)
+	if err != nil {
+		return nil, err
+	}
+	views := ais.TargetPoolViews()
+	for i := range removed {
+		views = append(views, ais.ForTargetPool(&aisv1.TargetPoolSpec{Name: removed[i].Labels[cmn.LabelTargetPool]}))
+	}
+	return views, nil
+}
+
+// deleteRemovedTargetPools deletes the resources of the target pools removed from the spec. A pool is only
+// removed from the spec once scaled to 0, so the StatefulSet of a pool still running targets is kept, as deleting
+// it would remove them from the cluster without migrating their data.
+func (r *Reconciler) deleteRemovedTargetPools(ctx context.Context, ais *aisv1.AIStore) (result ctrl.Result, err error) {
+	removed, err := r.listRemovedTargetPools(ctx, ais)
+	if err != nil {
+		return result, err
+	}
+	logger := logf.FromContext(ctx)
+	for i := range removed {
+		ss := &removed[i]
+		pool := ss.Labels[cmn.LabelTargetPool]
+		if (ss.Spec.Replicas != nil && *ss.Spec.Replicas > 0) || ss.Status.Replicas > 0 {
+			logger.Info("Keeping the statefulset of a removed target pool still running targets", "pool", pool, "statefulset", ss.Name)
+			r.recorder.Eventf(ais, ss, corev1.EventTypeWarning, EventReasonWaiting, ActionReconcile,
+				"Target pool %s was removed while running targets; add it back with its previous spec and size 0 to scale it down", pool)
+			result = earliestRequeue(result, ctrl.Result{RequeueAfter: targetLongRequeueDelay})
+			continue
+		}
+		if _, err = r.cleanupTargetPool(ctx, ais.ForTargetPool(&aisv1.TargetPoolSpec{Name: pool})); err != nil {
+			return result, err
+		}
+		logger.Info("Deleted removed target pool", "pool", pool)
+		r.recorder.Eventf(ais, ss, corev1.EventTypeNormal, EventReasonDeleted, ActionDelete, "Deleted removed target pool %s", pool)
+	}
+	return result, nil
+}
+
+// listTargetPoolNodes returns the names of the nodes running targets of pools, including pools removed from the spec
+func (r *Reconciler) listTargetPoolNodes(ctx context.Context, ais *aisv1.AIStore) ([]string, error) {
+	podList := &corev1.PodList{}
+	err := r.k8sClient.List(ctx, podList,
+		client.InNamespace(ais.Namespace),
+		client.MatchingLabels(cmn.SelectorLabels(ais.Name, aisapc.Target)),
+		client.HasLabels{cmn.LabelTargetPool},
+	)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]string, 0, len(podList.Items))
+	for i := range podList.Items {
+		if node := podList.Items[i].Spec.NodeName; node != "" && !slices.Contains(nodes, node) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aismeta "github.com/NVIDIA/aistore/core/meta"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestCountStatefulSetTargets(t *testing.T) {
+	g := NewWithT(t)
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	ais.Spec.Size = aisapc.Ptr(int32(2))
+	smap := &aismeta.Smap{Tmap: aismeta.NodeMap{}}
+	for _, host := range []string{"ais-target-0.ais-target.ais-ns", "ais-target-1.ais-target.ais-ns", "ais-target-nvme-0.ais-target-nvme.ais-ns"} {
+		smap.Tmap[host] = &aismeta.Snode{DaeID: host, DaeType: aisapc.Target, ControlNet: aismeta.NetInfo{Hostname: host}}
+	}
+	g.Expect(countStatefulSetTargets(ais, smap)).To(BeEquivalentTo(3))
+
+	ais.Spec.TargetPools = []aisv1.TargetPoolSpec{{Name: "nvme", Size: 1}}
+	g.Expect(countStatefulSetTargets(ais, smap)).To(BeEquivalentTo(2))
+	g.Expect(countStatefulSetTargets(ais.ForTargetPool(&ais.Spec.TargetPools[0]), smap)).To(BeEquivalentTo(1))
+}
+
+func TestDeleteRemovedTargetPools(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"}}
+	ais.Spec.Size = aisapc.Ptr(int32(1))
+	ais.Spec.TargetPools = []aisv1.TargetPoolSpec{{Name: "nvme", Size: 1}}
+	newPoolSS := func(pool string, replicas int32) *appsv1.StatefulSet {
+		view := ais.ForTargetPool(&aisv1.TargetPoolSpec{Name: pool})
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: target.StatefulSetNSName(view).Name, Namespace: ais.Namespace, Labels: target.BasicLabels(view)},
+			Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+		}
+	}
+	hddCM := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      target.ConfigMapNSName(ais.ForTargetPool(&aisv1.TargetPoolSpec{Name: "hdd"})).Name,
+		Namespace: ais.Namespace,
+	}}
+	c := newFakeClientBuilder(g).
+		WithObjects(ais, newPoolSS("nvme", 1), newPoolSS("hdd", 0), newPoolSS("ssd", 2), hddCM).
+		WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+
+	removed, err := r.listRemovedTargetPools(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(removed).To(HaveLen(2))
+
+	result, err := r.deleteRemovedTargetPools(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	// The pool still running targets is kept until added back and scaled down
+	g.Expect(result.RequeueAfter).To(Equal(targetLongRequeueDelay))
+	ss := &appsv1.StatefulSet{}
+	g.Expect(c.Get(ctx, target.StatefulSetNSName(ais.ForTargetPool(&aisv1.TargetPoolSpec{Name: "ssd"})), ss)).To(Succeed())
+	g.Expect(c.Get(ctx, target.StatefulSetNSName(ais.ForTargetPool(&ais.Spec.TargetPools[0])), ss)).To(Succeed())
+
+	err = c.Get(ctx, target.StatefulSetNSName(ais.ForTargetPool(&aisv1.TargetPoolSpec{Name: "hdd"})), ss)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	err = c.Get(ctx, target.ConfigMapNSName(ais.ForTargetPool(&aisv1.TargetPoolSpec{Name: "hdd"})), &corev1.ConfigMap{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestDetermineAutoScaleStatusSkipsTargetPoolNodes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	ais.Spec.Size = aisapc.Ptr(int32(2))
+	ais.Spec.TargetSpec.Size = aisapc.Ptr(int32(-1))
+	ais.Spec.TargetPools = []aisv1.TargetPoolSpec{{Name: "nvme", Size: 1}}
+	newPod := func(name, node string, view *aisv1.AIStore) *corev1.Pod {
+		labels := target.BasicLabels(view)
+		labels[cmn.LabelManagedBy] = cmn.LabelManagedByValue
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ais.Namespace, Labels: labels},
+			Spec:       corev1.PodSpec{NodeName: node},
+		}
+	}
+	objects := []client.Object{
+		ais,
+		newPod("ais-target-0", "node-a", ais),
+		newPod("ais-target-nvme-0", "node-c", ais.ForTargetPool(&ais.Spec.TargetPools[0])),
+	}
+	for _, name := range []string{"node-a", "node-b", "node-c"} {
+		objects = append(objects, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	c := newFakeClientBuilder(g).WithObjects(objects...).WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+
+	g.Expect(r.determineAutoScaleStatus(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.AutoScaleStatus.ExpectedTargetNodes).To(Equal([]string{"node-a", "node-b"}))
+}
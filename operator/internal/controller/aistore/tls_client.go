@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"bytes"
+	"context"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	certres "github.com/ais-operator/internal/resources/certificates"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcileOperatorClientCertificate requests the operator's client certificate from cert-manager when
+// spec.tls.operatorClientCertificate sets an issuer, and removes the certificate once it is unset.
+// Certificates from the operator's own CA are issued together with the cluster's certificate.
+func (r *Reconciler) reconcileOperatorClientCertificate(ctx context.Context, ais *aisv1.AIStore) error {
+	if ais.UseOperatorClientCertificate() && ais.Spec.TLS.OperatorClientCertificate.IssuerRef != nil {
+		return r.k8sClient.Apply(ctx, cmn.NewOperatorClientCertificate(ais))
+	}
+	if _, err := r.k8sClient.DeleteResourceIfExists(ctx, cmn.OperatorClientCertificate(ais)); err != nil {
+		return err
+	}
+	if ais.UseOperatorClientCertificate() {
+		return nil
+	}
+	return r.deleteIfControlled(ctx, ais, cmn.OperatorClientCertSecretNSName(ais), &corev1.Secret{})
+}
+
+// ensureSelfSignedClientCertificate issues the operator's client certificate from the cluster's CA when
+// spec.tls.operatorClientCertificate has no issuer, reissuing it under the same conditions as the
+// cluster's certificate
+func (r *Reconciler) ensureSelfSignedClientCertificate(ctx context.Context, ais *aisv1.AIStore, ca *certres.KeyPair, caBundle []byte) (ctrl.Result, error) {
+	if !ais.UseOperatorClientCertificate() || ais.Spec.TLS.OperatorClientCertificate.IssuerRef != nil {
+		return ctrl.Result{}, nil
+	}
+	duration, renewBefore := ais.OperatorClientCertLifetime()
+	nn := cmn.OperatorClientCertSecretNSName(ais)
+
+	existing, err := r.k8sClient.GetSecretDirect(ctx, nn)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		current := &certres.KeyPair{CertPEM: existing.Data[cmn.TLSCertFileName], KeyPEM: existing.Data[cmn.TLSKeyFileName]}
+		reason, reissue := certificateReissueReason(current, caBundle, nil, nil, renewBefore)
+		if !reissue {
+			if !bytes.Equal(existing.Data[cmn.TLSCAFileName], caBundle) {
+				_, err = r.k8sClient.CreateOrUpdateResource(ctx, ais, cmn.NewOperatorClientCertSecret(ais, current, caBundle))
+			}
+			return renewalResult(current, renewBefore), err
+		}
+		logf.FromContext(ctx).Info("Reissuing operator client certificate", "secret", nn.Name, "reason", reason)
+	}
+
+	cert, err := certres.NewClientCertificate(ca, cmn.OperatorClientCommonName, duration)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if _, err = r.k8sClient.CreateOrUpdateResource(ctx, ais, cmn.NewOperatorClientCertSecret(ais, cert, caBundle)); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonCertificateIssued, ActionReconcile,
+		"Issued operator client certificate in Secret %s", nn.Name)
+	return renewalResult(cert, renewBefore), nil
+}
@@ -0,0 +1,255 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	certres "github.com/ais-operator/internal/resources/certificates"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// tlsSecretPropagationDelay gives kubelet time to sync a renewed Secret into pod volumes
+	// before AIS is asked to reload the certificate.
+	tlsSecretPropagationDelay = 2 * time.Minute
+	// tlsReloadTimeout is how long pods may keep serving a previous certificate after a reload
+	// before they are restarted.
+	tlsReloadTimeout     = 2 * time.Minute
+	tlsProbeRequeueDelay = 30 * time.Second
+	// tlsProbeConcurrency is how many pods are probed for their served certificate at once
+	tlsProbeConcurrency = 16
+)
+
+// reconcileServedCertificate compares the certificate each AIS pod serves with the one in the cluster's
+// TLS Secret and reports the result in status.tls and the TLSCertificateServed condition. When pods
+// still serve a previous certificate after a renewal, AIS is first asked to reload it from disk, and
+// pods that keep serving it are then restarted through a rolling update of the StatefulSets.
+// Pods are only probed while a rotation is in progress, until they all serve the certificate in the Secret.
+func (r *Reconciler) reconcileServedCertificate(ctx context.Context, ais *aisv1.AIStore) (ctrl.Result, error) {
+	secretName := ais.GetTLSSecretName()
+	// With the CSI driver, every pod has its own certificate
+	if !ais.UseHTTPS() || secretName == "" || ais.UseTLSCSI() {
+		return ctrl.Result{}, r.clearServedCertificateStatus(ctx, ais)
+	}
+
+	nn := types.NamespacedName{Namespace: ais.Namespace, Name: secretName}
+	secret, err := r.k8sClient.GetSecret(ctx, nn)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	cert, err := certres.ParseCertificatePEM(secret.Data[cmn.TLSCertFileName])
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("invalid certificate in Secret %s: %w", nn, err)
+	}
+
+	original := ais.Status.DeepCopy()
+	status, rotated := r.observeTLSCertificate(ais, secretName, cert)
+	if !rotated && len(status.StalePods) == 0 && ais.IsConditionTrue(aisv1.ConditionTLSCertificateServed) {
+		return ctrl.Result{}, nil
+	}
+	status.StalePods, err = r.findStaleCertificatePods(ctx, ais, status.Fingerprint)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var result ctrl.Result
+	if len(status.StalePods) == 0 {
+		ais.SetCondition(aisv1.ConditionTLSCertificateServed)
+	} else {
+		result, err = r.handleStaleCertificate(ctx, ais, status)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if equality.Semantic.DeepEqual(original, &ais.Status) {
+		return result, nil
+	}
+	return result, r.patchStatus(ctx, ais)
+}
+
+// observeTLSCertificate returns status.tls for the certificate in the Secret, starting a new rotation
+// when the certificate differs from the last one observed
+func (r *Reconciler) observeTLSCertificate(ais *aisv1.AIStore, secretName string, cert *x509.Certificate) (status *aisv1.TLSStatus, rotated bool) {
+	fingerprint := certres.Fingerprint(cert)
+	status = ais.Status.TLS
+	if status != nil && status.SecretName == secretName && status.Fingerprint == fingerprint {
+		return status, false
+	}
+	if status != nil && status.SecretName == secretName {
+		r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonCertificateRotated, ActionReconcile,
+			"TLS certificate in Secret %s was renewed, expires at %s", secretName, cert.NotAfter.Format(time.RFC3339))
+	}
+	now := metav1.Now().Rfc3339Copy()
+	ais.Status.TLS = &aisv1.TLSStatus{
+		SecretName:       secretName,
+		Fingerprint:      fingerprint,
+		NotAfter:         &metav1.Time{Time: cert.NotAfter},
+		LastRotationTime: &now,
+	}
+	return ais.Status.TLS, true
+}
+
+// findStaleCertificatePods probes the public endpoint of every running AIS pod, tlsProbeConcurrency at a
+// time, and returns the pods serving a certificate with a different fingerprint. Pods that cannot be
+// probed are skipped, since they are either starting or already reported as not ready.
+func (r *Reconciler) findStaleCertificatePods(ctx context.Context, ais *aisv1.AIStore, fingerprint string) ([]string, error) {
+	logger := logf.FromContext(ctx)
+	daemons := []struct {
+		labels map[string]string
+		port   int
+	}{
+		{labels: proxy.SelectorLabels(ais), port: ais.Spec.ProxySpec.PublicPort.IntValue()},
+		{labels: target.SelectorLabels(ais), port: ais.Spec.TargetSpec.PublicPort.IntValue()},
+	}
+	var pods []*corev1.Pod
+	var addrs []string
+	for _, daemon := range daemons {
+		podList, err := r.k8sClient.ListPods(ctx, ais, daemon.labels)
+		if err != nil {
+			return nil, err
+		}
+		for i := range podList.Items {
+			pod := &podList.Items[i]
+			if !isPodReady(pod) || pod.Status.PodIP == "" {
+				continue
+			}
+			pods = append(pods, pod)
+			addrs = append(addrs, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(daemon.port)))
+		}
+	}
+
+	isStale := make([]bool, len(pods))
+	var group errgroup.Group
+	group.SetLimit(tlsProbeConcurrency)
+	for i := range pods {
+		group.Go(func() error {
+			served, err := r.tlsProber.ServedCertificate(ctx, addrs[i])
+			if err != nil {
+				logger.Info("Failed to probe served TLS certificate", "pod", pods[i].Name, "err", err.Error())
+				return nil
+			}
+			isStale[i] = certres.Fingerprint(served) != fingerprint
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	var stale []string
+	for i := range pods {
+		if isStale[i] {
+			stale = append(stale, pods[i].Name)
+		}
+	}
+	return stale, nil
+}
+
+// handleStaleCertificate moves pods serving a previous certificate to the current one. It waits for the
+// Secret to propagate to pod volumes, asks AIS to reload the certificate once per rotation, and rolls
+// the pods if they still serve the previous certificate after tlsReloadTimeout.
+func (r *Reconciler) handleStaleCertificate(ctx context.Context, ais *aisv1.AIStore, status *aisv1.TLSStatus) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	now := metav1.Now().Rfc3339Copy()
+	msg := fmt.Sprintf("%d pod(s) serve a previous certificate", len(status.StalePods))
+
+	if wait := time.Until(status.LastRotationTime.Add(tlsSecretPropagationDelay)); wait > 0 {
+		ais.SetConditionFalse(aisv1.ConditionTLSCertificateServed, aisv1.ReasonStaleCertificate, msg)
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	if status.LastReloadTime == nil || status.LastReloadTime.Before(status.LastRotationTime) {
+		logger.Info("Reloading TLS certificate", "stalePods", status.StalePods)
+		if err := r.reloadCertificate(ctx, ais); err != nil {
+			// Pods are restarted after the reload timeout regardless
+			logger.Error(err, "Failed to reload TLS certificate")
+			r.recorder.Eventf(ais, nil, corev1.EventTypeWarning, EventReasonFailed, ActionReconcile,
+				"Failed to reload TLS certificate, err: %v", err)
+		} else {
+			r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonCertificateReloaded, ActionReconcile,
+				"Reloaded TLS certificate served by %d pod(s)", len(status.StalePods))
+		}
+		status.LastReloadTime = &now
+		ais.SetConditionFalse(aisv1.ConditionTLSCertificateServed, aisv1.ReasonCertificateReloading, msg)
+		return ctrl.Result{RequeueAfter: tlsProbeRequeueDelay}, nil
+	}
+
+	if wait := time.Until(status.LastReloadTime.Add(tlsReloadTimeout)); wait > 0 {
+		ais.SetConditionFalse(aisv1.ConditionTLSCertificateServed, aisv1.ReasonCertificateReloading, msg)
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	if ais.Annotations[cmn.TLSRestartAnnotation] != status.Fingerprint {
+		logger.Info("Restarting pods serving a previous TLS certificate", "stalePods", status.StalePods)
+		// Patch a copy, since the response would overwrite the status being updated
+		patched := ais.DeepCopy()
+		if patched.Annotations == nil {
+			patched.Annotations = map[string]string{}
+		}
+		patched.Annotations[cmn.TLSRestartAnnotation] = status.Fingerprint
+		if err := r.k8sClient.Patch(ctx, patched, k8sclient.MergeFrom(ais)); err != nil {
+			return ctrl.Result{}, err
+		}
+		ais.ObjectMeta = patched.ObjectMeta
+		r.recorder.Eventf(ais, nil, corev1.EventTypeWarning, EventReasonCertificateRestart, ActionReconcile,
+			"Restarting pods still serving a previous TLS certificate after reload: %v", status.StalePods)
+	}
+	ais.SetConditionFalse(aisv1.ConditionTLSCertificateServed, aisv1.ReasonCertificateRestart, msg)
+	return ctrl.Result{RequeueAfter: tlsProbeRequeueDelay}, nil
+}
+
+func (r *Reconciler) reloadCertificate(ctx context.Context, ais *aisv1.AIStore) error {
+	apiClient, err := r.clientManager.GetClient(ctx, ais)
+	if err != nil {
+		return err
+	}
+	return apiClient.LoadX509Cert()
+}
+
+// clearServedCertificateStatus removes status.tls and the TLSCertificateServed condition once the
+// cluster no longer serves a certificate from a Secret
+func (r *Reconciler) clearServedCertificateStatus(ctx context.Context, ais *aisv1.AIStore) error {
+	conditionType := string(aisv1.ConditionTLSCertificateServed)
+	if ais.Status.TLS == nil && meta.FindStatusCondition(ais.Status.Conditions, conditionType) == nil {
+		return nil
+	}
+	ais.Status.TLS = nil
+	meta.RemoveStatusCondition(&ais.Status.Conditions, conditionType)
+	return r.patchStatus(ctx, ais)
+}
+
+// findAISClustersForSecret maps a Secret to the clusters mounting it for TLS or using it as a trust
+// source, so a renewed certificate is rolled out without waiting for the next resync
+func (r *Reconciler) findAISClustersForSecret(ctx context.Context, o k8sclient.Object) []reconcile.Request {
+	aisList := &aisv1.AIStoreList{}
+	if err := r.k8sClient.List(ctx, aisList, k8sclient.InNamespace(o.GetNamespace())); err != nil {
+		r.log.Error(err, "Failed to list ais crs", "secret", o.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range aisList.Items {
+		ais := &aisList.Items[i]
+		if ais.GetTLSSecretName() == o.GetName() || referencesTrustSource(ais, o) {
+			requests = append(requests, reconcile.Request{NamespacedName: ais.NamespacedName()})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"crypto/x509"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/proxy"
+	certres "github.com/ais-operator/internal/resources/certificates"
+	mocks "github.com/ais-operator/internal/services/mocks"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// fakeTLSProber serves the same certificate at every address
+type fakeTLSProber struct {
+	served *x509.Certificate
+	probes atomic.Int32
+}
+
+func (p *fakeTLSProber) ServedCertificate(context.Context, string) (*x509.Certificate, error) {
+	p.probes.Add(1)
+	return p.served, nil
+}
+
+func newTestCertificate(g *WithT) (*certres.KeyPair, *x509.Certificate) {
+	ca, err := certres.NewSelfSignedCA("test-ca", 24*time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	kp, err := certres.NewLeafCertificate(ca, "ais", []string{"ais-proxy"}, nil, time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	cert, err := kp.Certificate()
+	g.Expect(err).NotTo(HaveOccurred())
+	return kp, cert
+}
+
+func TestReconcileServedCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	apiClient := mocks.NewMockAIStoreClientInterface(mockCtrl)
+	clientManager := mocks.NewMockAISClientManagerInterface(mockCtrl)
+	clientManager.EXPECT().GetClient(gomock.Any(), gomock.Any()).Return(apiClient, nil).AnyTimes()
+
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"},
+		Spec: aisv1.AIStoreSpec{
+			Size: aisapc.Ptr(int32(1)),
+			TLS:  &aisv1.TLSSpec{SecretName: aisapc.Ptr("ais-tls")},
+			ConfigToUpdate: &aisv1.ConfigToUpdate{
+				Net: &aisv1.NetConfToUpdate{HTTP: &aisv1.HTTPConfToUpdate{UseHTTPS: aisapc.Ptr(true)}},
+			},
+			ProxySpec: aisv1.DaemonSpec{ServiceSpec: aisv1.ServiceSpec{PublicPort: intstr.FromInt32(51080)}},
+		},
+	}
+	current, currentCert := newTestCertificate(g)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais-tls", Namespace: ais.Namespace},
+		Data:       map[string][]byte{cmn.TLSCertFileName: current.CertPEM, cmn.TLSKeyFileName: current.KeyPEM},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais-proxy-0", Namespace: ais.Namespace, Labels: proxy.SelectorLabels(ais)},
+		Status: corev1.PodStatus{
+			PodIP:      "10.0.0.1",
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	c := newFakeClientBuilder(g).WithObjects(ais, secret, pod).WithStatusSubresource(ais).Build()
+	prober := &fakeTLSProber{served: currentCert}
+	r := newTestReconciler(c, clientManager)
+	r.tlsProber = prober
+	condition := func() *metav1.Condition {
+		c := meta.FindStatusCondition(ais.Status.Conditions, string(aisv1.ConditionTLSCertificateServed))
+		g.Expect(c).NotTo(BeNil())
+		return c
+	}
+
+	// All pods serve the certificate in the Secret
+	result, err := r.reconcileServedCertificate(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.IsZero()).To(BeTrue())
+	g.Expect(ais.Status.TLS.Fingerprint).To(Equal(certres.Fingerprint(currentCert)))
+	g.Expect(ais.Status.TLS.NotAfter.Time).To(BeTemporally("==", currentCert.NotAfter))
+	g.Expect(ais.Status.TLS.StalePods).To(BeEmpty())
+	g.Expect(condition().Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(prober.probes.Load()).To(BeEquivalentTo(1))
+
+	// Pods are not probed again until the Secret is renewed
+	_, err = r.reconcileServedCertificate(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(prober.probes.Load()).To(BeEquivalentTo(1))
+
+	// The Secret is renewed, but pods still serve the previous certificate
+	renewed, renewedCert := newTestCertificate(g)
+	secret.Data[cmn.TLSCertFileName] = renewed.CertPEM
+	g.Expect(c.Update(ctx, secret)).To(Succeed())
+	result, err = r.reconcileServedCertificate(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+	g.Expect(ais.Status.TLS.Fingerprint).To(Equal(certres.Fingerprint(renewedCert)))
+	g.Expect(ais.Status.TLS.StalePods).To(ConsistOf("ais-proxy-0"))
+	g.Expect(condition().Reason).To(Equal(string(aisv1.ReasonStaleCertificate)))
+
+	// After the Secret propagated, AIS is asked to reload the certificate once
+	ais.Status.TLS.LastRotationTime = &metav1.Time{Time: time.Now().Add(-tlsSecretPropagationDelay)}
+	apiClient.EXPECT().LoadX509Cert().Return(nil).Times(1)
+	_, err = r.reconcileServedCertificate(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ais.Status.TLS.LastReloadTime).NotTo(BeNil())
+	g.Expect(condition().Reason).To(Equal(string(aisv1.ReasonCertificateReloading)))
+	_, err = r.reconcileServedCertificate(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// Pods still serving the previous certificate after the reload timeout are restarted
+	ais.Status.TLS.LastReloadTime = &metav1.Time{Time: time.Now().Add(-tlsReloadTimeout)}
+	_, err = r.reconcileServedCertificate(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ais.Annotations).To(HaveKeyWithValue(cmn.TLSRestartAnnotation, certres.Fingerprint(renewedCert)))
+	g.Expect(condition().Reason).To(Equal(string(aisv1.ReasonCertificateRestart)))
+	g.Expect(proxy.NewProxyStatefulSet(ais, 1).Spec.Template.Annotations).To(HaveKey(cmn.TLSRestartAnnotation))
+
+	// Once pods serve the renewed certificate, the rotation is complete
+	prober.served = renewedCert
+	_, err = r.reconcileServedCertificate(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ais.Status.TLS.StalePods).To(BeEmpty())
+	g.Expect(condition().Status).To(Equal(metav1.ConditionTrue))
+	probes := prober.probes.Load()
+	_, err = r.reconcileServedCertificate(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(prober.probes.Load()).To(Equal(probes))
+
+	// Disabling HTTPS clears the status
+	ais.Spec.ConfigToUpdate = nil
+	_, err = r.reconcileServedCertificate(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ais.Status.TLS).To(BeNil())
+	g.Expect(meta.FindStatusCondition(ais.Status.Conditions, string(aisv1.ConditionTLSCertificateServed))).To(BeNil())
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"slices"
+	"time"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	certres "github.com/ais-operator/internal/resources/certificates"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcileSelfSignedTLS issues the cluster's TLS certificate from an operator-generated CA when
+// spec.tls.selfSigned is set, and publishes the CA bundle for clients. The CA and certificates are
+// renewed once they are within renewBefore of expiring, and the result requeues when the first of them
+// is due. Without selfSigned, the CA and bundle left over from the mode are removed.
+func (r *Reconciler) reconcileSelfSignedTLS(ctx context.Context, ais *aisv1.AIStore) (ctrl.Result, error) {
+	if !ais.UseTLSSelfSigned() {
+		return ctrl.Result{}, r.cleanupSelfSignedTLS(ctx, ais)
+	}
+	ca, caBundle, err := r.ensureSelfSignedCA(ctx, ais)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	result := renewalResult(ca, ais.Spec.TLS.SelfSigned.GetRenewBefore()*cmn.SelfSignedCAValidityFactor)
+	publicHosts, err := r.discoverPublicNetHosts(ctx, ais)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	certResult, err := r.ensureSelfSignedCertificate(ctx, ais, ca, caBundle, publicHosts)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	clientResult, err := r.ensureSelfSignedClientCertificate(ctx, ais, ca, caBundle)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if _, err = r.k8sClient.CreateOrUpdateResource(ctx, ais, cmn.NewCABundleConfigMap(ais, caBundle)); err != nil {
+		return ctrl.Result{}, err
+	}
+	return earliestRequeue(earliestRequeue(result, certResult), clientResult), nil
+}
+
+// renewalResult requeues when the certificate is due for renewal, since no other event may trigger
+// a reconcile before it expires
+func renewalResult(cert *certres.KeyPair, renewBefore time.Duration) ctrl.Result {
+	parsed, err := cert.Certificate()
+	if err != nil {
+		return ctrl.Result{}
+	}
+	return ctrl.Result{RequeueAfter: max(time.Until(parsed.NotAfter.Add(-renewBefore)), time.Second)}
+}
+
+// ensureSelfSignedCA returns the cluster's CA and CA bundle, generating the CA on first use and
+// rotating it before it expires. After a rotation, the bundle still includes the previous CA.
+func (r *Reconciler) ensureSelfSignedCA(ctx context.Context, ais *aisv1.AIStore) (*certres.KeyPair, []byte, error) {
+	config := ais.Spec.TLS.SelfSigned
+	caDuration := config.GetDuration() * cmn.SelfSignedCAValidityFactor
+	caRenewBefore := config.GetRenewBefore() * cmn.SelfSignedCAValidityFactor
+	nn := cmn.SelfSignedCASecretNSName(ais)
+
+	// Read from the API server, since regenerating a CA that already exists would invalidate its certificates
+	secret, err := r.k8sClient.GetSecretDirect(ctx, nn)
+	if k8serrors.IsNotFound(err) {
+		ca, genErr := certres.NewSelfSignedCA(fmt.Sprintf("%s-ca.%s", ais.Name, ais.Namespace), caDuration)
+		if genErr != nil {
+			return nil, nil, genErr
+		}
+		exists, createErr := r.k8sClient.CreateResourceIfNotExists(ctx, ais, cmn.NewSelfSignedCASecret(ais, ca, ca.CertPEM))
+		if createErr != nil {
+			return nil, nil, createErr
+		}
+		if exists {
+			return nil, nil, fmt.Errorf("CA Secret %s was created concurrently", nn)
+		}
+		logf.FromContext(ctx).Info("Generated TLS CA", "secret", nn.Name)
+		return ca, ca.CertPEM, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ca := &certres.KeyPair{CertPEM: secret.Data[corev1.TLSCertKey], KeyPEM: secret.Data[corev1.TLSPrivateKeyKey]}
+	caCert, err := ca.Certificate()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CA in Secret %s: %w", nn, err)
+	}
+	if !certres.NeedsRenewal(caCert, caRenewBefore) {
+		return ca, secret.Data[cmn.TLSCAFileName], nil
+	}
+
+	newCA, err := certres.NewSelfSignedCA(caCert.Subject.CommonName, caDuration)
+	if err != nil {
+		return nil, nil, err
+	}
+	caBundle := newCA.CertPEM
+	if time.Now().Before(caCert.NotAfter) {
+		caBundle = append(bytes.Clone(caBundle), ca.CertPEM...)
+	}
+	rotated := cmn.NewSelfSignedCASecret(ais, newCA, caBundle)
+	rotated.ObjectMeta = *secret.ObjectMeta.DeepCopy()
+	if err = r.k8sClient.Update(ctx, rotated); err != nil {
+		return nil, nil, err
+	}
+	r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonCARotated, ActionReconcile,
+		"Rotated TLS CA expiring at %s", caCert.NotAfter.Format(time.RFC3339))
+	return newCA, caBundle, nil
+}
+
+// ensureSelfSignedCertificate (re)issues the certificate mounted by AIS pods when it is missing, was
+// not signed by a CA in the bundle, does not match the SANs, or is due for renewal. It also keeps the
+// CA bundle in the Secret up to date. A certificate signed by the previous CA is kept until it is due
+// for renewal, so clients have time to pick up the new bundle after a CA rotation.
+func (r *Reconciler) ensureSelfSignedCertificate(ctx context.Context, ais *aisv1.AIStore, ca *certres.KeyPair, caBundle []byte, publicHosts []string) (ctrl.Result, error) {
+	config := ais.Spec.TLS.SelfSigned
+	dnsNames, ipAddresses := cmn.CertificateSANs(ais, publicHosts)
+	nn := types.NamespacedName{Namespace: ais.Namespace, Name: ais.GetTLSSecretName()}
+
+	existing, err := r.k8sClient.GetSecretDirect(ctx, nn)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		current := &certres.KeyPair{CertPEM: existing.Data[cmn.TLSCertFileName], KeyPEM: existing.Data[cmn.TLSKeyFileName]}
+		reason, reissue := certificateReissueReason(current, caBundle, dnsNames, ipAddresses, config.GetRenewBefore())
+		if !reissue {
+			if !bytes.Equal(existing.Data[cmn.TLSCAFileName], caBundle) {
+				_, err = r.k8sClient.CreateOrUpdateResource(ctx, ais, cmn.NewSelfSignedTLSSecret(ais, current, caBundle))
+			}
+			return renewalResult(current, config.GetRenewBefore()), err
+		}
+		logf.FromContext(ctx).Info("Reissuing TLS certificate", "secret", nn.Name, "reason", reason)
+	}
+
+	cert, err := certres.NewLeafCertificate(ca, cmn.SelfSignedCommonName(ais), dnsNames, ipAddresses, config.GetDuration())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if _, err = r.k8sClient.CreateOrUpdateResource(ctx, ais, cmn.NewSelfSignedTLSSecret(ais, cert, caBundle)); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonCertificateIssued, ActionReconcile,
+		"Issued TLS certificate in Secret %s", nn.Name)
+	return renewalResult(cert, config.GetRenewBefore()), nil
+}
+
+// certificateReissueReason reports whether the certificate must be reissued, and why
+func certificateReissueReason(cert *certres.KeyPair, caBundle []byte, dnsNames, ipAddresses []string, renewBefore time.Duration) (string, bool) {
+	leaf, err := cert.Certificate()
+	if err != nil {
+		return "invalid certificate", true
+	}
+	caCerts, err := certres.ParseCertificatesPEM(caBundle)
+	if err != nil || !slices.ContainsFunc(caCerts, func(ca *x509.Certificate) bool { return certres.IsSignedBy(leaf, ca) }) {
+		return "not signed by a trusted CA", true
+	}
+	if !certres.HasSANs(leaf, dnsNames, ipAddresses) {
+		return "SANs changed", true
+	}
+	if certres.NeedsRenewal(leaf, renewBefore) {
+		return "expiring", true
+	}
+	return "", false
+}
+
+// cleanupSelfSignedTLS removes the CA Secret and bundle ConfigMap created for spec.tls.selfSigned.
+// The certificate Secret is left in place, since other TLS modes may use the same name.
+func (r *Reconciler) cleanupSelfSignedTLS(ctx context.Context, ais *aisv1.AIStore) error {
+	if err := r.deleteIfControlled(ctx, ais, cmn.SelfSignedCASecretNSName(ais), &corev1.Secret{}); err != nil {
+		return err
+	}
+	return r.deleteIfControlled(ctx, ais, cmn.CABundleConfigMapNSName(ais), &corev1.ConfigMap{})
+}
+
+// deleteIfControlled deletes the named object if it exists and is controlled by the AIStore
+func (r *Reconciler) deleteIfControlled(ctx context.Context, ais *aisv1.AIStore, nn types.NamespacedName, obj k8sclient.Object) error {
+	if err := r.k8sClient.Get(ctx, nn, obj); err != nil {
+		return k8sclient.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(obj, ais) {
+		return nil
+	}
+	_, err := r.k8sClient.DeleteResourceIfExists(ctx, obj)
+	return err
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	certres "github.com/ais-operator/internal/resources/certificates"
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newSelfSignedTestReconciler(g *WithT) (*Reconciler, *aisv1.AIStore) {
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"},
+		Spec: aisv1.AIStoreSpec{
+			TLS: &aisv1.TLSSpec{SelfSigned: &aisv1.TLSSelfSignedConfig{AdditionalDNSNames: []string{"ais.example.com"}}},
+		},
+	}
+	c := newFakeClientBuilder(g, certmanagerv1.AddToScheme).WithObjects(ais).Build()
+	return newTestReconciler(c, nil), ais
+}
+
+func getTestSecret(ctx context.Context, g *WithT, r *Reconciler, nn types.NamespacedName) *corev1.Secret {
+	secret, err := r.k8sClient.GetSecret(ctx, nn)
+	g.Expect(err).NotTo(HaveOccurred())
+	return secret
+}
+
+func TestReconcileSelfSignedTLS(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	r, ais := newSelfSignedTestReconciler(g)
+	tlsName := types.NamespacedName{Namespace: ais.Namespace, Name: ais.GetTLSSecretName()}
+
+	g.Expect(r.reconcileSelfSignedTLS(ctx, ais)).NotTo(BeZero())
+
+	caSecret := getTestSecret(ctx, g, r, cmn.SelfSignedCASecretNSName(ais))
+	caCert, err := certres.ParseCertificatePEM(caSecret.Data[corev1.TLSCertKey])
+	g.Expect(err).NotTo(HaveOccurred())
+	tlsSecret := getTestSecret(ctx, g, r, tlsName)
+	g.Expect(tlsSecret.OwnerReferences).To(HaveLen(1))
+	leaf, err := certres.ParseCertificatePEM(tlsSecret.Data[cmn.TLSCertFileName])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(certres.IsSignedBy(leaf, caCert)).To(BeTrue())
+	dnsNames, ipAddresses := cmn.CertificateSANs(ais, nil)
+	g.Expect(dnsNames).To(ContainElement("ais.example.com"))
+	g.Expect(certres.HasSANs(leaf, dnsNames, ipAddresses)).To(BeTrue())
+	g.Expect(tlsSecret.Data[cmn.TLSCAFileName]).To(Equal(caSecret.Data[corev1.TLSCertKey]))
+
+	bundle := &corev1.ConfigMap{}
+	g.Expect(r.k8sClient.Get(ctx, cmn.CABundleConfigMapNSName(ais), bundle)).To(Succeed())
+	g.Expect(bundle.Data[cmn.TLSCAFileName]).To(Equal(string(caSecret.Data[corev1.TLSCertKey])))
+
+	// Nothing changes while the certificate is valid
+	g.Expect(r.reconcileSelfSignedTLS(ctx, ais)).NotTo(BeZero())
+	g.Expect(getTestSecret(ctx, g, r, tlsName).Data).To(Equal(tlsSecret.Data))
+
+	// New SANs reissue the certificate from the same CA
+	ais.Spec.TLS.SelfSigned.AdditionalDNSNames = []string{"other.example.com"}
+	g.Expect(r.reconcileSelfSignedTLS(ctx, ais)).NotTo(BeZero())
+	reissued, err := certres.ParseCertificatePEM(getTestSecret(ctx, g, r, tlsName).Data[cmn.TLSCertFileName])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reissued.DNSNames).To(ContainElement("other.example.com"))
+	g.Expect(certres.IsSignedBy(reissued, caCert)).To(BeTrue())
+
+	// Switching modes removes the CA and bundle
+	ais.Spec.TLS = &aisv1.TLSSpec{SecretName: aisapc.Ptr("user-tls")}
+	g.Expect(r.reconcileSelfSignedTLS(ctx, ais)).To(BeZero())
+	_, err = r.k8sClient.GetSecret(ctx, cmn.SelfSignedCASecretNSName(ais))
+	g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(k8serrors.IsNotFound(r.k8sClient.Get(ctx, cmn.CABundleConfigMapNSName(ais), &corev1.ConfigMap{}))).To(BeTrue())
+}
+
+func TestReconcileSelfSignedTLSRotatesCA(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	r, ais := newSelfSignedTestReconciler(g)
+	tlsName := types.NamespacedName{Namespace: ais.Namespace, Name: ais.GetTLSSecretName()}
+
+	// A CA within its renewal window, with a certificate it issued that is not yet due
+	ais.Spec.TLS.SelfSigned.Duration = &metav1.Duration{Duration: 48 * time.Hour}
+	ais.Spec.TLS.SelfSigned.RenewBefore = &metav1.Duration{Duration: time.Hour}
+	oldCA, err := certres.NewSelfSignedCA("old-ca", 5*time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	caSecret := cmn.NewSelfSignedCASecret(ais, oldCA, oldCA.CertPEM)
+	_, err = r.k8sClient.CreateResourceIfNotExists(ctx, ais, caSecret)
+	g.Expect(err).NotTo(HaveOccurred())
+	dnsNames, ipAddresses := cmn.CertificateSANs(ais, nil)
+	leaf, err := certres.NewLeafCertificate(oldCA, "ais", dnsNames, ipAddresses, 4*time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = r.k8sClient.CreateResourceIfNotExists(ctx, ais, cmn.NewSelfSignedTLSSecret(ais, leaf, oldCA.CertPEM))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	result, err := r.reconcileSelfSignedTLS(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	// The kept certificate is the first due for renewal, an hour before it expires
+	g.Expect(result.RequeueAfter).To(BeNumerically("~", 3*time.Hour, time.Minute))
+
+	rotated := getTestSecret(ctx, g, r, cmn.SelfSignedCASecretNSName(ais))
+	g.Expect(rotated.Data[corev1.TLSCertKey]).NotTo(Equal(oldCA.CertPEM))
+	bundle, err := certres.ParseCertificatesPEM(rotated.Data[cmn.TLSCAFileName])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(bundle).To(HaveLen(2))
+
+	// The certificate from the previous CA is kept until it is due, with the updated bundle
+	tlsSecret := getTestSecret(ctx, g, r, tlsName)
+	g.Expect(tlsSecret.Data[cmn.TLSCertFileName]).To(Equal(leaf.CertPEM))
+	g.Expect(tlsSecret.Data[cmn.TLSCAFileName]).To(Equal(rotated.Data[cmn.TLSCAFileName]))
+}
+
+func TestReconcileSelfSignedClientCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	r, ais := newSelfSignedTestReconciler(g)
+	ais.Spec.TLS.OperatorClientCertificate = &aisv1.TLSOperatorClientCertificate{}
+	clientName := cmn.OperatorClientCertSecretNSName(ais)
+
+	g.Expect(r.reconcileSelfSignedTLS(ctx, ais)).NotTo(BeZero())
+	g.Expect(r.reconcileOperatorClientCertificate(ctx, ais)).To(Succeed())
+
+	caSecret := getTestSecret(ctx, g, r, cmn.SelfSignedCASecretNSName(ais))
+	caCert, err := certres.ParseCertificatePEM(caSecret.Data[corev1.TLSCertKey])
+	g.Expect(err).NotTo(HaveOccurred())
+	clientSecret := getTestSecret(ctx, g, r, clientName)
+	g.Expect(clientSecret.Type).To(Equal(corev1.SecretTypeTLS))
+	clientCert, err := certres.ParseCertificatePEM(clientSecret.Data[cmn.TLSCertFileName])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(certres.IsSignedBy(clientCert, caCert)).To(BeTrue())
+	g.Expect(clientCert.Subject.CommonName).To(Equal(cmn.OperatorClientCommonName))
+	g.Expect(clientSecret.Data[cmn.TLSCAFileName]).To(Equal(caSecret.Data[cmn.TLSCAFileName]))
+
+	// Nothing changes while the certificate is valid
+	g.Expect(r.reconcileSelfSignedTLS(ctx, ais)).NotTo(BeZero())
+	g.Expect(getTestSecret(ctx, g, r, clientName).Data).To(Equal(clientSecret.Data))
+
+	// A certificate due for renewal is reissued
+	ais.Spec.TLS.OperatorClientCertificate.RenewBefore = &metav1.Duration{Duration: 365 * 24 * time.Hour}
+	g.Expect(r.reconcileSelfSignedTLS(ctx, ais)).NotTo(BeZero())
+	g.Expect(getTestSecret(ctx, g, r, clientName).Data[cmn.TLSCertFileName]).NotTo(Equal(clientSecret.Data[cmn.TLSCertFileName]))
+
+	// Disabling the client certificate removes its Secret
+	ais.Spec.TLS.OperatorClientCertificate = nil
+	g.Expect(r.reconcileOperatorClientCertificate(ctx, ais)).To(Succeed())
+	_, err = r.k8sClient.GetSecret(ctx, clientName)
+	g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+}
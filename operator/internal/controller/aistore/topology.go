@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"slices"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// labelNodeTopology maps every node matching the target node selector and tolerations to the mountpath label of
+// its domain. Nodes without any topology key map to an empty label, so their targets do not wait for one.
+// Returns nil when mountpaths are not labeled with their domain.
+func (r *Reconciler) labelNodeTopology(ctx context.Context, ais *aisv1.AIStore) (map[string]string, error) {
+	spread := ais.Spec.TargetSpec.TopologySpread
+	if spread == nil || !spread.LabelMountpaths {
+		return nil, nil
+	}
+	nodes, err := r.listMatchingNodes(ctx, ais.Spec.TargetSpec.NodeSelector, ais.Spec.TargetSpec.Tolerations)
+	if err != nil {
+		return nil, err
+	}
+	nodeTopology := make(map[string]string, len(nodes))
+	for i := range nodes {
+		nodeTopology[nodes[i].Name] = cmn.NodeTopologyLabel(spread, &nodes[i])
+	}
+	return nodeTopology, nil
+}
+
+// updateTargetDomains reports how many targets are scheduled, and ready, in each domain of the topology keys
+func (r *Reconciler) updateTargetDomains(ctx context.Context, ais *aisv1.AIStore) error {
+	keys := ais.TopologyKeys()
+	if len(keys) == 0 && len(ais.Status.TargetDomains) == 0 {
+		return nil
+	}
+	var domains []aisv1.TopologyDomainStatus
+	if len(keys) > 0 {
+		pods, err := r.k8sClient.ListPods(ctx, ais, target.BasicLabels(ais))
+		if err != nil {
+			return err
+		}
+		nodes := map[string]*corev1.Node{}
+		for i := range pods.Items {
+			name := pods.Items[i].Spec.NodeName
+			if _, ok := nodes[name]; ok || name == "" {
+				continue
+			}
+			node := &corev1.Node{}
+			if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: name}, node); err != nil {
+				if !k8serrors.IsNotFound(err) {
+					return err
+				}
+				node = nil
+			}
+			nodes[name] = node
+		}
+		domains = countTopologyDomains(keys, pods.Items, nodes)
+	}
+	if slices.Equal(domains, ais.Status.TargetDomains) {
+		return nil
+	}
+	ais.Status.TargetDomains = domains
+	return r.patchStatus(ctx, ais)
+}
+
+// countTopologyDomains counts the pods in each domain of the topology keys, in the order of the keys and then of
+// the values. Pods on nodes without a key are not counted for it.
+func countTopologyDomains(keys []string, pods []corev1.Pod, nodes map[string]*corev1.Node) []aisv1.TopologyDomainStatus {
+	var domains []aisv1.TopologyDomainStatus
+	for _, key := range keys {
+		byValue := map[string]*aisv1.TopologyDomainStatus{}
+		for i := range pods {
+			node := nodes[pods[i].Spec.NodeName]
+			if node == nil || pods[i].DeletionTimestamp != nil {
+				continue
+			}
+			value, ok := node.Labels[key]
+			if !ok {
+				continue
+			}
+			domain := byValue[value]
+			if domain == nil {
+				domain = &aisv1.TopologyDomainStatus{Key: key, Value: value}
+				byValue[value] = domain
+			}
+			domain.Targets++
+			if isPodReady(&pods[i]) {
+				domain.ReadyTargets++
+			}
+		}
+		values := make([]string, 0, len(byValue))
+		for value := range byValue {
+			values = append(values, value)
+		}
+		slices.Sort(values)
+		for _, value := range values {
+			domains = append(domains, *byValue[value])
+		}
+	}
+	return domains
+}
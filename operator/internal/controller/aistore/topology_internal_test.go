@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/target"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	testZoneKey = "topology.kubernetes.io/zone"
+	testRackKey = "example.com/rack"
+)
+
+func TestUpdateTargetDomains(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	ais.Spec.Size = aisapc.Ptr(int32(4))
+	ais.Spec.TargetSpec.TopologySpread = &aisv1.TopologySpreadSpec{ZoneKey: aisapc.Ptr(testZoneKey), RackKey: aisapc.Ptr(testRackKey)}
+	node := func(name, zone, rack string) *corev1.Node {
+		labels := map[string]string{testZoneKey: zone}
+		if rack != "" {
+			labels[testRackKey] = rack
+		}
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	pod := func(name, nodeName string, ready bool) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ais.Namespace, Labels: target.BasicLabels(ais)},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+		}
+		if ready {
+			p.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		return p
+	}
+	c := newFakeClientBuilder(g).WithObjects(
+		ais,
+		node("node-1", "zone-b", "r1"), node("node-2", "zone-a", "r2"), node("node-3", "zone-a", ""),
+		pod("ais-target-0", "node-1", true), pod("ais-target-1", "node-2", true),
+		pod("ais-target-2", "node-3", false), pod("ais-target-3", "", false),
+	).WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+
+	g.Expect(r.updateTargetDomains(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.TargetDomains).To(Equal([]aisv1.TopologyDomainStatus{
+		{Key: testZoneKey, Value: "zone-a", Targets: 2, ReadyTargets: 1},
+		{Key: testZoneKey, Value: "zone-b", Targets: 1, ReadyTargets: 1},
+		{Key: testRackKey, Value: "r1", Targets: 1, ReadyTargets: 1},
+		{Key: testRackKey, Value: "r2", Targets: 1, ReadyTargets: 1},
+	}))
+	stored := &aisv1.AIStore{}
+	g.Expect(c.Get(ctx, ais.NamespacedName(), stored)).To(Succeed())
+	g.Expect(stored.Status.TargetDomains).To(Equal(ais.Status.TargetDomains))
+
+	// Domains are cleared once targets are no longer spread
+	ais.Spec.TargetSpec.TopologySpread = nil
+	g.Expect(r.updateTargetDomains(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.TargetDomains).To(BeNil())
+}
+
+func TestLabelNodeTopology(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	ais.Spec.TargetSpec.NodeSelector = map[string]string{"nvidia.com/ais-target": "ais"}
+	ais.Spec.TargetSpec.TopologySpread = &aisv1.TopologySpreadSpec{ZoneKey: aisapc.Ptr(testZoneKey), RackKey: aisapc.Ptr(testRackKey)}
+	c := newFakeClientBuilder(g).WithObjects(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{
+			"nvidia.com/ais-target": "ais", testZoneKey: "zone-a", testRackKey: "r1",
+		}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2", Labels: map[string]string{"nvidia.com/ais-target": "ais"}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-3", Labels: map[string]string{testZoneKey: "zone-b"}}},
+	).Build()
+	r := newTestReconciler(c, nil)
+
+	nodeTopology, err := r.labelNodeTopology(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(nodeTopology).To(BeNil())
+
+	ais.Spec.TargetSpec.TopologySpread.LabelMountpaths = true
+	nodeTopology, err = r.labelNodeTopology(ctx, ais)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(nodeTopology).To(Equal(map[string]string{"node-1": "zone=zone-a,rack=r1", "node-2": ""}))
+}
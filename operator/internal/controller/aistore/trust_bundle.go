@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	certres "github.com/ais-operator/internal/resources/certificates"
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// reconcileTrustBundle merges the CA certificates from spec.trust.sources into the trust bundle ConfigMap
+// and reports it in status.trustBundle. AIS pods and the admin client are rolled when the bundle hash
+// changes, and the operator's clients for the cluster are recreated.
+func (r *Reconciler) reconcileTrustBundle(ctx context.Context, ais *aisv1.AIStore) error {
+	if !ais.UseTrustBundle() {
+		if err := r.deleteIfControlled(ctx, ais, cmn.TrustBundleConfigMapNSName(ais), &corev1.ConfigMap{}); err != nil {
+			return err
+		}
+		if ais.Status.TrustBundle == nil {
+			return nil
+		}
+		ais.Status.TrustBundle = nil
+		return r.patchStatus(ctx, ais)
+	}
+
+	var certs []*x509.Certificate
+	for i := range ais.Spec.Trust.Sources {
+		sourceCerts, err := r.loadTrustSource(ctx, ais, &ais.Spec.Trust.Sources[i])
+		if err != nil {
+			return err
+		}
+		certs = append(certs, sourceCerts...)
+	}
+	if len(certs) == 0 {
+		return errors.New("no CA certificates found in spec.trust.sources")
+	}
+	bundle, count := certres.EncodeBundlePEM(certs)
+	if _, err := r.k8sClient.CreateOrUpdateResource(ctx, ais, cmn.NewTrustBundleConfigMap(ais, bundle)); err != nil {
+		return err
+	}
+
+	hash := certres.BundleHash(bundle)
+	if ais.TrustBundleHash() == hash {
+		return nil
+	}
+	if ais.Status.TrustBundle != nil {
+		r.recorder.Eventf(ais, nil, corev1.EventTypeNormal, EventReasonTrustBundleUpdated, ActionReconcile,
+			"Trust bundle updated with %d certificate(s)", count)
+	}
+	now := metav1.Now().Rfc3339Copy()
+	ais.Status.TrustBundle = &aisv1.TrustBundleStatus{Hash: hash, Certificates: count, LastUpdateTime: &now}
+	return r.patchStatus(ctx, ais)
+}
+
+// loadTrustSource returns the certificates held by a single trust source. Missing optional sources
+// yield no certificates.
+func (r *Reconciler) loadTrustSource(ctx context.Context, ais *aisv1.AIStore, source *aisv1.TrustSource) ([]*x509.Certificate, error) {
+	var (
+		desc     string
+		data     []byte
+		optional bool
+		err      error
+	)
+	switch {
+	case source.ConfigMap != nil:
+		desc = "ConfigMap " + source.ConfigMap.Name
+		optional = source.ConfigMap.IsOptional()
+		data, err = r.trustConfigMapData(ctx, types.NamespacedName{Namespace: ais.Namespace, Name: source.ConfigMap.Name}, source.ConfigMap.GetKey())
+	case source.Secret != nil:
+		desc = "Secret " + source.Secret.Name
+		optional = source.Secret.IsOptional()
+		data, err = r.trustSecretData(ctx, types.NamespacedName{Namespace: ais.Namespace, Name: source.Secret.Name}, source.Secret.GetKey())
+	case source.Certificate != nil:
+		desc = "Certificate " + *source.Certificate
+		data, err = r.trustCertificateData(ctx, ais, *source.Certificate)
+	default:
+		return nil, errors.New("trust source has no configMap, secret, or certificate")
+	}
+	if k8serrors.IsNotFound(err) && optional {
+		logf.FromContext(ctx).Info("Skipping missing optional trust source", "source", desc)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust source %s: %w", desc, err)
+	}
+	certs, err := certres.ParseCertificatesPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificates in trust source %s: %w", desc, err)
+	}
+	return certs, nil
+}
+
+func (r *Reconciler) trustConfigMapData(ctx context.Context, nn types.NamespacedName, key string) ([]byte, error) {
+	configMap, err := r.k8sClient.GetConfigMap(ctx, nn)
+	if err != nil {
+		return nil, err
+	}
+	if data, ok := configMap.Data[key]; ok {
+		return []byte(data), nil
+	}
+	if data, ok := configMap.BinaryData[key]; ok {
+		return data, nil
+	}
+	return nil, fmt.Errorf("no key %q", key)
+}
+
+func (r *Reconciler) trustSecretData(ctx context.Context, nn types.NamespacedName, key string) ([]byte, error) {
+	secret, err := r.k8sClient.GetSecret(ctx, nn)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("no key %q", key)
+	}
+	return data, nil
+}
+
+// trustCertificateData reads the issuing CA that cert-manager publishes in a Certificate's Secret
+func (r *Reconciler) trustCertificateData(ctx context.Context, ais *aisv1.AIStore, name string) ([]byte, error) {
+	cert := &certmanagerv1.Certificate{}
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Namespace: ais.Namespace, Name: name}, cert); err != nil {
+		return nil, err
+	}
+	return r.trustSecretData(ctx, types.NamespacedName{Namespace: ais.Namespace, Name: cert.Spec.SecretName}, aisv1.DefaultTrustSourceKey)
+}
+
+// referencesTrustSource reports whether the ConfigMap or Secret is one of the cluster's trust sources.
+// Secrets issued for a Certificate source are matched through the annotation cert-manager sets on them.
+func referencesTrustSource(ais *aisv1.AIStore, o k8sclient.Object) bool {
+	if !ais.UseTrustBundle() || o.GetNamespace() != ais.Namespace {
+		return false
+	}
+	_, isConfigMap := o.(*corev1.ConfigMap)
+	for _, source := range ais.Spec.Trust.Sources {
+		switch {
+		case source.ConfigMap != nil && isConfigMap:
+			if source.ConfigMap.Name == o.GetName() {
+				return true
+			}
+		case source.Secret != nil && !isConfigMap:
+			if source.Secret.Name == o.GetName() {
+				return true
+			}
+		case source.Certificate != nil && !isConfigMap:
+			if o.GetAnnotations()[certmanagerv1.CertificateNameKey] == *source.Certificate {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findAISClustersForConfigMap maps a ConfigMap to the clusters using it as a trust source, so the
+// trust bundle is updated without waiting for the next resync
+func (r *Reconciler) findAISClustersForConfigMap(ctx context.Context, o k8sclient.Object) []reconcile.Request {
+	aisList := &aisv1.AIStoreList{}
+	if err := r.k8sClient.List(ctx, aisList, k8sclient.InNamespace(o.GetNamespace())); err != nil {
+		r.log.Error(err, "Failed to list ais crs", "configMap", o.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range aisList.Items {
+		ais := &aisList.Items[i]
+		if referencesTrustSource(ais, o) {
+			requests = append(requests, reconcile.Request{NamespacedName: ais.NamespacedName()})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aistore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	certres "github.com/ais-operator/internal/resources/certificates"
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestCA(g *WithT, commonName string) []byte {
+	ca, err := certres.NewSelfSignedCA(commonName, time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	return ca.CertPEM
+}
+
+func TestReconcileTrustBundle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	corpCA, issuerCA := newTestCA(g, "corp-ca"), newTestCA(g, "issuer-ca")
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"},
+		Spec: aisv1.AIStoreSpec{Trust: &aisv1.TrustSpec{Sources: []aisv1.TrustSource{
+			{ConfigMap: &aisv1.TrustSourceKeySelector{Name: "corp-ca", Key: aisapc.Ptr("bundle.pem")}},
+			{Certificate: aisapc.Ptr("ais-cert")},
+			// Duplicates the CA of the Certificate
+			{Secret: &aisv1.TrustSourceKeySelector{Name: "ais-tls"}},
+			{Secret: &aisv1.TrustSourceKeySelector{Name: "missing", Optional: aisapc.Ptr(true)}},
+		}}},
+	}
+	corpConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "corp-ca", Namespace: ais.Namespace},
+		Data:       map[string]string{"bundle.pem": string(corpCA)},
+	}
+	certificate := &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais-cert", Namespace: ais.Namespace},
+		Spec:       certmanagerv1.CertificateSpec{SecretName: "ais-tls"},
+	}
+	tlsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "ais-tls", Namespace: ais.Namespace,
+			Annotations: map[string]string{certmanagerv1.CertificateNameKey: "ais-cert"},
+		},
+		Data: map[string][]byte{cmn.TLSCAFileName: issuerCA},
+	}
+	c := newFakeClientBuilder(g, certmanagerv1.AddToScheme).
+		WithObjects(ais, corpConfigMap, certificate, tlsSecret).WithStatusSubresource(ais).Build()
+	r := newTestReconciler(c, nil)
+	getBundle := func() []byte {
+		configMap := &corev1.ConfigMap{}
+		g.Expect(r.k8sClient.Get(ctx, cmn.TrustBundleConfigMapNSName(ais), configMap)).To(Succeed())
+		return []byte(configMap.Data[cmn.TrustBundleKey])
+	}
+
+	g.Expect(r.reconcileTrustBundle(ctx, ais)).To(Succeed())
+	certs, err := certres.ParseCertificatesPEM(getBundle())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(certs).To(HaveLen(2))
+	g.Expect(certs[0].Subject.CommonName).To(Equal("corp-ca"))
+	g.Expect(ais.Status.TrustBundle.Certificates).To(Equal(2))
+	g.Expect(ais.Status.TrustBundle.Hash).To(Equal(certres.BundleHash(getBundle())))
+	g.Expect(referencesTrustSource(ais, corpConfigMap)).To(BeTrue())
+	g.Expect(referencesTrustSource(ais, tlsSecret)).To(BeTrue())
+
+	// A changed source updates the bundle and its hash
+	hash := ais.TrustBundleHash()
+	corpConfigMap.Data["bundle.pem"] = string(newTestCA(g, "corp-ca-2"))
+	g.Expect(c.Update(ctx, corpConfigMap)).To(Succeed())
+	g.Expect(r.reconcileTrustBundle(ctx, ais)).To(Succeed())
+	g.Expect(ais.TrustBundleHash()).NotTo(Equal(hash))
+	g.Expect(ais.TrustBundleHash()).To(Equal(certres.BundleHash(getBundle())))
+
+	// A missing required source fails the bundle
+	ais.Spec.Trust.Sources = append(ais.Spec.Trust.Sources, aisv1.TrustSource{ConfigMap: &aisv1.TrustSourceKeySelector{Name: "missing"}})
+	g.Expect(r.reconcileTrustBundle(ctx, ais)).To(MatchError(ContainSubstring("ConfigMap missing")))
+
+	// Removing spec.trust deletes the bundle and clears the status
+	ais.Spec.Trust = nil
+	g.Expect(r.reconcileTrustBundle(ctx, ais)).To(Succeed())
+	g.Expect(ais.Status.TrustBundle).To(BeNil())
+	g.Expect(k8serrors.IsNotFound(r.k8sClient.Get(ctx, cmn.TrustBundleConfigMapNSName(ais), &corev1.ConfigMap{}))).To(BeTrue())
+}
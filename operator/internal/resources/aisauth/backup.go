@@ -0,0 +1,264 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aisauth
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
+	"github.com/ais-operator/internal/resources/ownerref"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	batchv1ac "k8s.io/client-go/applyconfigurations/batch/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+const (
+	componentLabel = "app.kubernetes.io/component"
+	backupLabel    = "backup"
+
+	// BackupTimeAnnotation records when a backup was taken (RFC 3339), on both backup Jobs and
+	// VolumeSnapshots.
+	BackupTimeAnnotation = "auth.ais.nvidia.com/backup-time"
+
+	backupContainerName  = "backup"
+	backupVolumeName     = "backup"
+	backupMountPath      = "/backup"
+	dataMountPath        = "/data"
+	backupTimeFormat     = "20060102-150405"
+	backupJobBackoff     = 2
+	backupCopyAttempts   = 5
+	restoreContainerName = "restore"
+	restoreVolumeName    = "restore"
+	restoreMountPath     = "/restore"
+)
+
+// backupScript copies the database into the backup PVC and prunes copies beyond retention.
+//
+// AuthN keeps the database in a BuntDB append-only file, writing each transaction with a single
+// append and compacting it by renaming a rewritten file over it. A copy is consistent if the
+// file kept its inode and size while it was read, i.e. no transaction was written meanwhile;
+// otherwise the copy is retried. The file is written under a temporary name first so a partial
+// copy is never listed.
+const backupScript = `set -eu
+mkdir -p "$BACKUP_DIR"
+tmp="$BACKUP_DIR/.$BACKUP_FILE"
+attempt=1
+while :; do
+  before=$(stat -c '%i:%s' "$DB_PATH")
+  cp "$DB_PATH" "$tmp"
+  after=$(stat -c '%i:%s' "$DB_PATH")
+  if [ "$before" = "$after" ] && [ "${after#*:}" = "$(stat -c '%s' "$tmp")" ]; then
+    break
+  fi
+  if [ "$attempt" -ge "$COPY_ATTEMPTS" ]; then
+    echo "database changed during each of $COPY_ATTEMPTS copies" >&2
+    rm -f "$tmp"
+    exit 1
+  fi
+  attempt=$((attempt + 1))
+  sleep 1
+done
+mv "$tmp" "$BACKUP_DIR/$BACKUP_FILE"
+ls -1 "$BACKUP_DIR" | grep '^authn-.*\.db$' | sort -r | tail -n +$((RETENTION + 1)) | \
+  while read -r f; do rm -f "$BACKUP_DIR/$f"; done
+`
+
+// restoreScript seeds the database from a backup unless the volume already holds one.
+const restoreScript = `set -eu
+if [ -e "$DB_PATH" ]; then
+  echo "database already present, skipping restore"
+  exit 0
+fi
+cp "$RESTORE_PATH" "$DB_PATH.tmp"
+mv "$DB_PATH.tmp" "$DB_PATH"
+`
+
+// VolumeSnapshotGVK is the VolumeSnapshot kind used for snapshot backups. The operator does not
+// depend on the snapshot client, so snapshots are handled as unstructured objects.
+var VolumeSnapshotGVK = schema.GroupVersionKind{
+	Group:   "snapshot.storage.k8s.io",
+	Version: "v1",
+	Kind:    "VolumeSnapshot",
+}
+
+// BackupLabels returns the labels set on every backup Job and VolumeSnapshot of the AIStoreAuth.
+func BackupLabels(authn *authv1alpha1.AIStoreAuth) map[string]string {
+	labels := resourceLabels(authn)
+	labels[componentLabel] = backupLabel
+	return labels
+}
+
+// BackupName returns the backup Job or VolumeSnapshot name for a backup taken at the given time.
+func BackupName(authn *authv1alpha1.AIStoreAuth, at time.Time) string {
+	return fmt.Sprintf("%s-backup-%s", authn.Name, at.UTC().Format(backupTimeFormat))
+}
+
+// BackupFilePath returns the database copy path, relative to the backup PVC root, for a backup
+// taken at the given time.
+func BackupFilePath(authn *authv1alpha1.AIStoreAuth, at time.Time) string {
+	return authn.Name + "/" + backupFileName(at)
+}
+
+func backupFileName(at time.Time) string {
+	return fmt.Sprintf("authn-%s.db", at.UTC().Format(backupTimeFormat))
+}
+
+func backupAnnotations(at time.Time) map[string]string {
+	return map[string]string{BackupTimeAnnotation: at.UTC().Format(time.RFC3339)}
+}
+
+// BackupTime returns the time recorded on a backup Job or VolumeSnapshot.
+func BackupTime(annotations map[string]string) (time.Time, error) {
+	value, ok := annotations[BackupTimeAnnotation]
+	if !ok {
+		return time.Time{}, fmt.Errorf("missing %s annotation", BackupTimeAnnotation)
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// NewBackupJob builds the apply configuration for a Job that copies the AuthN database into the
+// backup PVC. The Job is scheduled next to the AuthN pod, since the data PVC is ReadWriteOnce.
+func NewBackupJob(authn *authv1alpha1.AIStoreAuth, at time.Time) (*batchv1ac.JobApplyConfiguration, error) {
+	backup := authn.Spec.Backup
+	if backup == nil || backup.PVC == nil {
+		return nil, fmt.Errorf("spec.backup.pvc must be set to copy the AuthN database")
+	}
+
+	container := corev1ac.Container().
+		WithName(backupContainerName).
+		WithImage(backup.PVC.GetImage()).
+		WithCommand("/bin/sh", "-c", backupScript).
+		WithEnv(
+			corev1ac.EnvVar().WithName("DB_PATH").WithValue(dataMountPath+"/"+authnDBFile),
+			corev1ac.EnvVar().WithName("BACKUP_DIR").WithValue(backupMountPath+"/"+authn.Name),
+			corev1ac.EnvVar().WithName("BACKUP_FILE").WithValue(backupFileName(at)),
+			corev1ac.EnvVar().WithName("RETENTION").WithValue(strconv.Itoa(backup.GetRetention())),
+			corev1ac.EnvVar().WithName("COPY_ATTEMPTS").WithValue(strconv.Itoa(backupCopyAttempts)),
+		).
+		WithVolumeMounts(
+			corev1ac.VolumeMount().WithName(storageVolumeName).
+				WithMountPath(dataMountPath).
+				WithReadOnly(true),
+			corev1ac.VolumeMount().WithName(backupVolumeName).
+				WithMountPath(backupMountPath),
+		)
+
+	pod := corev1ac.PodSpec().
+		WithRestartPolicy(corev1.RestartPolicyNever).
+		WithContainers(container).
+		WithVolumes(
+			corev1ac.Volume().WithName(storageVolumeName).
+				WithPersistentVolumeClaim(corev1ac.PersistentVolumeClaimVolumeSource().
+					WithClaimName(PVCName(authn)).
+					WithReadOnly(true)),
+			corev1ac.Volume().WithName(backupVolumeName).
+				WithPersistentVolumeClaim(corev1ac.PersistentVolumeClaimVolumeSource().
+					WithClaimName(backup.PVC.ClaimName)),
+		).
+		WithAffinity(corev1ac.Affinity().
+			WithPodAffinity(corev1ac.PodAffinity().
+				WithRequiredDuringSchedulingIgnoredDuringExecution(corev1ac.PodAffinityTerm().
+					WithLabelSelector(metav1ac.LabelSelector().WithMatchLabels(selectorLabels(authn))).
+					WithTopologyKey(corev1.LabelHostname))))
+	if err := withPodScheduling(pod, authn.Spec.Deployment.Pod); err != nil {
+		return nil, err
+	}
+
+	return batchv1ac.Job(BackupName(authn, at), authn.Namespace).
+		WithOwnerReferences(ownerref.NewAIStoreAuthControllerRef(authn)).
+		WithLabels(BackupLabels(authn)).
+		WithAnnotations(backupAnnotations(at)).
+		WithSpec(batchv1ac.JobSpec().
+			WithBackoffLimit(backupJobBackoff).
+			WithTemplate(corev1ac.PodTemplateSpec().
+				WithLabels(BackupLabels(authn)).
+				WithSpec(pod))), nil
+}
+
+// withPodScheduling copies the AuthN pod security context, tolerations, and pull secrets, so
+// helper pods run under the same constraints as AuthN itself.
+func withPodScheduling(pod *corev1ac.PodSpecApplyConfiguration, podSpec *authv1alpha1.PodSpec) error {
+	if podSpec == nil {
+		return nil
+	}
+	if podSpec.SecurityContext != nil {
+		securityContext, err := toApplyConfiguration[*corev1ac.PodSecurityContextApplyConfiguration](podSpec.SecurityContext)
+		if err != nil {
+			return err
+		}
+		pod.WithSecurityContext(securityContext)
+	}
+	if len(podSpec.Tolerations) > 0 {
+		tolerations, err := toApplyConfiguration[[]*corev1ac.TolerationApplyConfiguration](podSpec.Tolerations)
+		if err != nil {
+			return err
+		}
+		pod.WithTolerations(tolerations...)
+	}
+	if len(podSpec.ImagePullSecrets) > 0 {
+		imagePullSecrets, err := toApplyConfiguration[[]*corev1ac.LocalObjectReferenceApplyConfiguration](podSpec.ImagePullSecrets)
+		if err != nil {
+			return err
+		}
+		pod.WithImagePullSecrets(imagePullSecrets...)
+	}
+	return nil
+}
+
+// NewVolumeSnapshot builds a VolumeSnapshot of the AuthN data PVC taken at the given time.
+//
+// Snapshots carry no owner reference: they are backups and must outlive the AIStoreAuth so a
+// later deployment can restore from them.
+func NewVolumeSnapshot(authn *authv1alpha1.AIStoreAuth, at time.Time) *unstructured.Unstructured {
+	spec := map[string]any{
+		"source": map[string]any{
+			"persistentVolumeClaimName": PVCName(authn),
+		},
+	}
+	if backup := authn.Spec.Backup; backup != nil && backup.VolumeSnapshot != nil && backup.VolumeSnapshot.ClassName != nil {
+		spec["volumeSnapshotClassName"] = *backup.VolumeSnapshot.ClassName
+	}
+
+	snapshot := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	snapshot.SetGroupVersionKind(VolumeSnapshotGVK)
+	snapshot.SetName(BackupName(authn, at))
+	snapshot.SetNamespace(authn.Namespace)
+	snapshot.SetLabels(BackupLabels(authn))
+	snapshot.SetAnnotations(backupAnnotations(at))
+	return snapshot
+}
+
+// restoreInitContainer builds the init container seeding the database from a backup PVC.
+func restoreInitContainer(authn *authv1alpha1.AIStoreAuth) *corev1ac.ContainerApplyConfiguration {
+	restore := authn.Spec.Persistence.RestoreFrom.PVC
+	return corev1ac.Container().
+		WithName(restoreContainerName).
+		WithImage(restore.GetImage()).
+		WithCommand("/bin/sh", "-c", restoreScript).
+		WithEnv(
+			corev1ac.EnvVar().WithName("DB_PATH").WithValue(configPaths.Database),
+			corev1ac.EnvVar().WithName("RESTORE_PATH").WithValue(restoreMountPath+"/"+restore.Path),
+		).
+		WithVolumeMounts(
+			corev1ac.VolumeMount().WithName(storageVolumeName).
+				WithMountPath(stateMountPath),
+			corev1ac.VolumeMount().WithName(restoreVolumeName).
+				WithMountPath(restoreMountPath).
+				WithReadOnly(true),
+		)
+}
+
+// restoreVolume mounts the backup PVC the database is restored from.
+func restoreVolume(authn *authv1alpha1.AIStoreAuth) *corev1ac.VolumeApplyConfiguration {
+	return corev1ac.Volume().WithName(restoreVolumeName).
+		WithPersistentVolumeClaim(corev1ac.PersistentVolumeClaimVolumeSource().
+			WithClaimName(authn.Spec.Persistence.RestoreFrom.PVC.ClaimName).
+			WithReadOnly(true))
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package aisauth_test
+
+import (
+	"time"
+
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
+	authnres "github.com/ais-operator/internal/resources/aisauth"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Backup", func() {
+	var (
+		authn *authv1alpha1.AIStoreAuth
+		at    time.Time
+	)
+
+	BeforeEach(func() {
+		sc := "openebs-hostpath"
+		authn = &authv1alpha1.AIStoreAuth{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ais-authn",
+				Namespace: "ais",
+				UID:       types.UID("test-uid"),
+			},
+			Spec: authv1alpha1.AIStoreAuthSpec{
+				Persistence: authv1alpha1.PersistenceSpec{StorageClass: &sc},
+				Deployment: authv1alpha1.DeploymentSpec{
+					Container: authv1alpha1.ContainerSpec{
+						Image: "docker.io/aistorage/authn:v4.8",
+					},
+				},
+				Backup: &authv1alpha1.BackupSpec{
+					Interval:  metav1.Duration{Duration: 24 * time.Hour},
+					Retention: 3,
+					PVC:       &authv1alpha1.PVCBackupSpec{ClaimName: "authn-backups"},
+				},
+			},
+		}
+		at = time.Date(2026, 10, 18, 9, 30, 15, 0, time.UTC)
+	})
+
+	It("names backups after the CR and the backup time", func() {
+		Expect(authnres.BackupName(authn, at)).To(Equal("ais-authn-backup-20261018-093015"))
+		Expect(authnres.BackupFilePath(authn, at)).To(Equal("ais-authn/authn-20261018-093015.db"))
+	})
+
+	It("round-trips the backup time through annotations", func() {
+		job, err := authnres.NewBackupJob(authn, at)
+		Expect(err).NotTo(HaveOccurred())
+		recorded, err := authnres.BackupTime(job.Annotations)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorded).To(BeTemporally("==", at))
+
+		_, err = authnres.BackupTime(nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("Job", func() {
+		It("copies the database into the backup PVC next to the AuthN pod", func() {
+			job, err := authnres.NewBackupJob(authn, at)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(*job.Name).To(Equal("ais-authn-backup-20261018-093015"))
+			Expect(job.Labels).To(HaveKeyWithValue("app.kubernetes.io/component", "backup"))
+			Expect(job.Labels).To(HaveKeyWithValue("app.kubernetes.io/instance", "ais-authn"))
+			Expect(job.OwnerReferences).To(HaveLen(1))
+			Expect(*job.OwnerReferences[0].Controller).To(BeTrue())
+
+			pod := job.Spec.Template.Spec
+			Expect(pod.RestartPolicy).To(HaveValue(Equal(corev1.RestartPolicyNever)))
+			Expect(pod.Volumes).To(HaveLen(2))
+			Expect(pod.Volumes[0].PersistentVolumeClaim.ClaimName).To(HaveValue(Equal("ais-authn-storage")))
+			Expect(pod.Volumes[0].PersistentVolumeClaim.ReadOnly).To(HaveValue(BeTrue()))
+			Expect(pod.Volumes[1].PersistentVolumeClaim.ClaimName).To(HaveValue(Equal("authn-backups")))
+
+			terms := pod.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].TopologyKey).To(HaveValue(Equal(corev1.LabelHostname)))
+			Expect(terms[0].LabelSelector.MatchLabels).To(HaveKeyWithValue("app.kubernetes.io/name", "authn"))
+
+			container := pod.Containers[0]
+			Expect(container.Image).To(HaveValue(Equal("docker.io/library/busybox:1.37")))
+			env := map[string]string{}
+			for _, e := range container.Env {
+				env[*e.Name] = *e.Value
+			}
+			Expect(env).To(Equal(map[string]string{
+				"DB_PATH":       "/data/authn.db",
+				"BACKUP_DIR":    "/backup/ais-authn",
+				"BACKUP_FILE":   "authn-20261018-093015.db",
+				"RETENTION":     "3",
+				"COPY_ATTEMPTS": "5",
+			}))
+		})
+
+		It("requires a backup PVC", func() {
+			authn.Spec.Backup.PVC = nil
+			_, err := authnres.NewBackupJob(authn, at)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("VolumeSnapshot", func() {
+		It("snapshots the data PVC without an owner reference", func() {
+			class := "csi-snapclass"
+			authn.Spec.Backup.VolumeSnapshot = &authv1alpha1.VolumeSnapshotBackupSpec{ClassName: &class}
+
+			snapshot := authnres.NewVolumeSnapshot(authn, at)
+			Expect(snapshot.GroupVersionKind()).To(Equal(authnres.VolumeSnapshotGVK))
+			Expect(snapshot.GetName()).To(Equal("ais-authn-backup-20261018-093015"))
+			Expect(snapshot.GetNamespace()).To(Equal("ais"))
+			Expect(snapshot.GetOwnerReferences()).To(BeEmpty())
+			Expect(snapshot.GetLabels()).To(Equal(authnres.BackupLabels(authn)))
+
+			source, _, _ := unstructured.NestedString(snapshot.Object, "spec", "source", "persistentVolumeClaimName")
+			Expect(source).To(Equal("ais-authn-storage"))
+			className, _, _ := unstructured.NestedString(snapshot.Object, "spec", "volumeSnapshotClassName")
+			Expect(className).To(Equal(class))
+		})
+	})
+
+	Describe("Restore", func() {
+		It("provisions the data PVC from a VolumeSnapshot", func() {
+			snapshot := "ais-authn-backup-20261018-093015"
+			authn.Spec.Persistence.RestoreFrom = &authv1alpha1.RestoreSpec{VolumeSnapshotName: &snapshot}
+
+			pvc, err := authnres.NewPVC(authn)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Spec.DataSource.APIGroup).To(HaveValue(Equal("snapshot.storage.k8s.io")))
+			Expect(pvc.Spec.DataSource.Kind).To(HaveValue(Equal("VolumeSnapshot")))
+			Expect(pvc.Spec.DataSource.Name).To(HaveValue(Equal(snapshot)))
+		})
+
+		It("rejects a snapshot restore into a pre-provisioned volume", func() {
+			snapshot := "ais-authn-backup-20261018-093015"
+			vol := "authn-pv"
+			authn.Spec.Persistence = authv1alpha1.PersistenceSpec{
+				VolumeName:  &vol,
+				RestoreFrom: &authv1alpha1.RestoreSpec{VolumeSnapshotName: &snapshot},
+			}
+
+			_, err := authnres.NewPVC(authn)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("seeds the database from a backup PVC in an init container", func() {
+			authn.Spec.Persistence.RestoreFrom = &authv1alpha1.RestoreSpec{
+				PVC: &authv1alpha1.PVCRestoreSpec{
+					ClaimName: "authn-backups",
+					Path:      "ais-authn/authn-20261018-093015.db",
+				},
+			}
+
+			spec := newPodSpec(authn)
+			Expect(spec.InitContainers).To(HaveLen(1))
+			init := spec.InitContainers[0]
+			Expect(init.Name).To(HaveValue(Equal("restore")))
+			env := map[string]string{}
+			for _, e := range init.Env {
+				env[*e.Name] = *e.Value
+			}
+			Expect(env).To(HaveKeyWithValue("DB_PATH", "/etc/ais/authn/authn.db"))
+			Expect(env).To(HaveKeyWithValue("RESTORE_PATH", "/restore/ais-authn/authn-20261018-093015.db"))
+
+			Expect(spec.Volumes).To(HaveLen(3))
+			Expect(spec.Volumes[2].PersistentVolumeClaim.ClaimName).To(HaveValue(Equal("authn-backups")))
+			Expect(spec.Volumes[2].PersistentVolumeClaim.ReadOnly).To(HaveValue(BeTrue()))
+		})
+	})
+})
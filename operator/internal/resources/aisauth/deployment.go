@@ -131,6 +131,9 @@ func newPodSpec(ctx context.Context, authn *authv1alpha1.AIStoreAuth) (*corev1ac
 	pod := corev1ac.PodSpec().
 		WithContainers(container).
 		WithVolumes(volumes(ctx, authn)...)
+	if authn.UseRestoreFromPVC() {
+		pod.WithInitContainers(restoreInitContainer(authn))
+	}
 	podSpec := spec.Pod
 	if podSpec == nil {
 		return pod, nil
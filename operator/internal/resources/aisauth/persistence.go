@@ -32,6 +32,8 @@ func PVCNSName(authn *authv1alpha1.AIStoreAuth) types.NamespacedName {
 // The operator supports two persistence modes via spec.persistence:
 //   - storageClass: dynamic provisioning via the named StorageClass (provisioner creates the PV).
 //   - volumeName:   bind to a pre-provisioned PV by name (PV must exist before reconcile).
+//
+// With restoreFrom.volumeSnapshotName, the provisioner populates the new volume from the snapshot.
 func NewPVC(authn *authv1alpha1.AIStoreAuth) (*corev1ac.PersistentVolumeClaimApplyConfiguration, error) {
 	persistence := &authn.Spec.Persistence
 
@@ -53,6 +55,16 @@ func NewPVC(authn *authv1alpha1.AIStoreAuth) (*corev1ac.PersistentVolumeClaimApp
 		return nil, fmt.Errorf("spec.persistence must set exactly one of storageClass or volumeName")
 	}
 
+	if snapshot := authn.GetRestoreSnapshotName(); snapshot != "" {
+		if !persistence.UsesStorageClass() {
+			return nil, fmt.Errorf("spec.persistence.restoreFrom.volumeSnapshotName requires storageClass")
+		}
+		spec.WithDataSource(corev1ac.TypedLocalObjectReference().
+			WithAPIGroup(VolumeSnapshotGVK.Group).
+			WithKind(VolumeSnapshotGVK.Kind).
+			WithName(snapshot))
+	}
+
 	return corev1ac.PersistentVolumeClaim(PVCName(authn), authn.Namespace).
 		WithOwnerReferences(ownerref.NewAIStoreAuthControllerRef(authn)).
 		WithLabels(resourceLabels(authn)).
@@ -19,12 +19,13 @@ const (
 	tlsVolumeName     = "tls-certs"
 
 	stateMountPath  = "/etc/ais/authn"
+	authnDBFile     = "authn.db"
 	authnConfigPath = stateMountPath + "/" + AuthnJSONKey
 	tlsMountPath    = "/var/certs"
 )
 
 var configPaths = authnconfig.Paths{
-	Database:       stateMountPath + "/authn.db",
+	Database:       stateMountPath + "/" + authnDBFile,
 	TLSCertificate: tlsMountPath + "/tls.crt",
 	TLSKey:         tlsMountPath + "/tls.key",
 }
@@ -38,6 +39,9 @@ func volumes(ctx context.Context, authn *authv1alpha1.AIStoreAuth) []*corev1ac.V
 			WithConfigMap(corev1ac.ConfigMapVolumeSource().
 				WithName(ConfigMapName(authn))),
 	}
+	if authn.UseRestoreFromPVC() {
+		result = append(result, restoreVolume(authn))
+	}
 	switch {
 	case authn.UseTLSCSI():
 		result = append(result, corev1ac.Volume().WithName(tlsVolumeName).
@@ -95,8 +95,17 @@ func caEnvVars(caConfigMap *aisv1.CAConfigMapRef) []corev1.EnvVar {
 	}}
 }
 
-// selectorLabels returns the standard labels for the admin client deployment
-func selectorLabels(ais *aisv1.AIStore) map[string]string {
+// caConfigMapRef returns the ConfigMap holding the CA bundle the client trusts, falling back to the
+// cluster's trust bundle when spec.adminClient.caConfigMap is unset
+func caConfigMapRef(ais *aisv1.AIStore) *aisv1.CAConfigMapRef {
+	if ais.Spec.AdminClient.CAConfigMap != nil || !ais.UseTrustBundle() {
+		return ais.Spec.AdminClient.CAConfigMap
+	}
+	return &aisv1.CAConfigMapRef{Name: ais.TrustBundleConfigMapName(), Key: aisapc.Ptr(cmn.TrustBundleKey)}
+}
+
+// SelectorLabels returns the standard labels for the admin client deployment
+func SelectorLabels(ais *aisv1.AIStore) map[string]string {
 	return map[string]string{
 		cmn.LabelAppPrefixed:       ais.AdminClientName(),
 		cmn.LabelComponentPrefixed: ComponentLabelValue,
@@ -114,12 +123,15 @@ func NewClientDeployment(ais *aisv1.AIStore, authConf services.AuthConfig) *apps
 		image = *clientSpec.Image
 	}
 
-	matchLabels := selectorLabels(ais)
-	podLabels := selectorLabels(ais)
+	matchLabels := SelectorLabels(ais)
+	podLabels := SelectorLabels(ais)
 	maps.Copy(podLabels, clientSpec.Labels)
+	// The client loads trusted CAs at startup, so it is restarted when the trust bundle changes
+	podAnnotations := cmn.WithTrustBundleAnnotation(ais, maps.Clone(clientSpec.Annotations))
 
-	volumes := caVolumes(clientSpec.CAConfigMap)
-	volumeMounts := caVolumeMounts(clientSpec.CAConfigMap)
+	caConfigMap := caConfigMapRef(ais)
+	volumes := caVolumes(caConfigMap)
+	volumeMounts := caVolumeMounts(caConfigMap)
 
 	container := corev1.Container{
 		Name:         "ais-client",
@@ -148,7 +160,7 @@ func NewClientDeployment(ais *aisv1.AIStore, authConf services.AuthConfig) *apps
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      podLabels,
-					Annotations: clientSpec.Annotations,
+					Annotations: podAnnotations,
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName:           "default",
@@ -181,7 +193,7 @@ func buildClientEnv(ais *aisv1.AIStore, authConf services.AuthConfig) []corev1.E
 	base := []corev1.EnvVar{
 		{Name: aisenv.AisEndpoint, Value: cmn.IntraClusterURL(ais)},
 	}
-	ca := caEnvVars(clientSpec.CAConfigMap)
+	ca := caEnvVars(caConfigMapRef(ais))
 	authn := authnEnvVars(authConf)
 
 	env := make([]corev1.EnvVar, 0, len(base)+len(clientSpec.Env)+len(ca)+len(authn))
@@ -9,6 +9,7 @@ import (
 	"crypto/tls"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	"github.com/ais-operator/internal/services"
 	. "github.com/onsi/ginkgo/v2"
@@ -65,6 +66,36 @@ var _ = Describe("Admin Client Deployment", Label("short"), func() {
 		})
 	})
 
+	Describe("NewClientDeployment trust bundle", func() {
+		It("should mount the cluster trust bundle when no CA ConfigMap is set", func() {
+			ais := baseAIS()
+			ais.Spec.Trust = &aisv1.TrustSpec{Sources: []aisv1.TrustSource{{Certificate: apc.Ptr("ais-ca")}}}
+			ais.Status.TrustBundle = &aisv1.TrustBundleStatus{Hash: "bundle-hash"}
+
+			deploy := NewClientDeployment(ais, nil)
+			template := deploy.Spec.Template
+
+			Expect(template.Spec.Volumes).To(HaveLen(1))
+			Expect(template.Spec.Volumes[0].ConfigMap.Name).To(Equal("test-ais-trust-bundle"))
+			Expect(template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name:  "AIS_CLIENT_CA",
+				Value: "/etc/ais-ca/ca.crt",
+			}))
+			Expect(template.Annotations).To(HaveKeyWithValue("trust.aistore.nvidia.com/bundle-hash", "bundle-hash"))
+			Expect(deploy.Annotations).NotTo(HaveKey("trust.aistore.nvidia.com/bundle-hash"))
+		})
+
+		It("should prefer spec.adminClient.caConfigMap", func() {
+			ais := baseAIS()
+			ais.Spec.Trust = &aisv1.TrustSpec{Sources: []aisv1.TrustSource{{Certificate: apc.Ptr("ais-ca")}}}
+			ais.Spec.AdminClient.CAConfigMap = &aisv1.CAConfigMapRef{Name: "client-ca"}
+
+			deploy := NewClientDeployment(ais, nil)
+
+			Expect(deploy.Spec.Template.Spec.Volumes[0].ConfigMap.Name).To(Equal("client-ca"))
+		})
+	})
+
 	Describe("NewClientDeployment AuthN env", func() {
 		It("should set AIS_AUTHN_URL from the resolved service URL", func() {
 			ais := baseAIS()
@@ -100,5 +131,8 @@ func (*fakeAuthConfig) GetSecretName() string                       { return ""
 func (*fakeAuthConfig) GetSecretNamespace() string                  { return "" }
 func (*fakeAuthConfig) GetUserKey() string                          { return "" }
 func (*fakeAuthConfig) GetPassKey() string                          { return "" }
+func (*fakeAuthConfig) GetGrantSubject() authv1alpha1.ReferenceGrantFrom {
+	return authv1alpha1.ReferenceGrantFrom{}
+}
 
 func (*fakeAuthConfig) GetTLSConfig(context.Context) (*tls.Config, error) { return nil, nil }
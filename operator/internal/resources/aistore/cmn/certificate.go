@@ -13,10 +13,24 @@ import (
 	"github.com/ais-operator/internal/resources/ownerref"
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmapiv1ac "github.com/cert-manager/cert-manager/pkg/client/applyconfigurations/certmanager/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// TLSRestartAnnotation on the AIStore holds the fingerprint of the certificate AIS pods were last
+// restarted to serve. It is copied to the pod templates, so changing it rolls the pods.
+const TLSRestartAnnotation = "tls.aistore.nvidia.com/restart-fingerprint"
+
+// WithTLSRestartAnnotation adds the TLS restart annotation to the pod annotations once the operator
+// has set it on the AIStore, so clusters that were never restarted for a certificate are unaffected
+func WithTLSRestartAnnotation(ais *aisv1.AIStore, annotations map[string]string) map[string]string {
+	if fingerprint := ais.Annotations[TLSRestartAnnotation]; fingerprint != "" {
+		annotations[TLSRestartAnnotation] = fingerprint
+	}
+	return annotations
+}
+
 func certificateName(ais *aisv1.AIStore) string {
 	return fmt.Sprintf("%s-tls-cert", ais.Name)
 }
@@ -104,9 +118,7 @@ func buildCertificateSANs(ais *aisv1.AIStore, publicHosts []string) (dnsNames, i
 	dnsNames, ipAddresses = certres.AppendHosts(dnsNames, ipAddresses, publicHosts...)
 
 	// Add user-specified additional DNS names
-	if certConfig := ais.GetTLSCertificate(); certConfig != nil {
-		dnsNames = append(dnsNames, certConfig.AdditionalDNSNames...)
-	}
+	dnsNames = append(dnsNames, ais.TLSAdditionalDNSNames()...)
 
 	return certres.NormalizeSANs(dnsNames, ipAddresses)
 }
@@ -117,3 +129,112 @@ func TLSCertificate(ais *aisv1.AIStore) *certmanagerv1.Certificate {
 		ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace},
 	}
 }
+
+// OperatorClientCommonName is the subject of the client certificates the operator presents to AIS
+const OperatorClientCommonName = "ais-operator"
+
+func operatorClientCertificateName(ais *aisv1.AIStore) string {
+	return ais.Name + "-operator-client-cert"
+}
+
+// NewOperatorClientCertificate builds the cert-manager Certificate for the operator's client certificate
+func NewOperatorClientCertificate(ais *aisv1.AIStore) *cmapiv1ac.CertificateApplyConfiguration {
+	config := ais.Spec.TLS.OperatorClientCertificate
+	spec := certres.NewSpec(&certres.SpecConfig{
+		SecretName:  ais.OperatorClientCertSecretName(),
+		IssuerName:  config.IssuerRef.Name,
+		IssuerKind:  config.IssuerRef.Kind,
+		Duration:    config.Duration,
+		RenewBefore: config.RenewBefore,
+		Usages:      []certmanagerv1.KeyUsage{certmanagerv1.UsageDigitalSignature, certmanagerv1.UsageClientAuth},
+	}, nil, nil).WithCommonName(OperatorClientCommonName)
+
+	return cmapiv1ac.Certificate(operatorClientCertificateName(ais), ais.Namespace).
+		WithOwnerReferences(ownerref.NewControllerRef(ais)).
+		WithSpec(spec)
+}
+
+// OperatorClientCertificate returns a reference to the cert-manager Certificate for the operator's client certificate
+func OperatorClientCertificate(ais *aisv1.AIStore) *certmanagerv1.Certificate {
+	return &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: operatorClientCertificateName(ais), Namespace: ais.Namespace},
+	}
+}
+
+// OperatorClientCertSecretNSName returns the namespaced name of the Secret holding the operator's client certificate
+func OperatorClientCertSecretNSName(ais *aisv1.AIStore) types.NamespacedName {
+	return types.NamespacedName{Name: ais.OperatorClientCertSecretName(), Namespace: ais.Namespace}
+}
+
+// NewOperatorClientCertSecret stores an operator client certificate issued by the operator's own CA
+func NewOperatorClientCertSecret(ais *aisv1.AIStore, cert *certres.KeyPair, caBundle []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: ais.OperatorClientCertSecretName(), Namespace: ais.Namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			TLSCertFileName: cert.CertPEM,
+			TLSKeyFileName:  cert.KeyPEM,
+			TLSCAFileName:   caBundle,
+		},
+	}
+}
+
+// SelfSignedCAValidityFactor is how many times longer the operator's CA is valid than the certificates it issues
+const SelfSignedCAValidityFactor = 10
+
+// SelfSignedCASecretNSName returns the namespaced name of the Secret holding the operator-generated CA
+func SelfSignedCASecretNSName(ais *aisv1.AIStore) types.NamespacedName {
+	return types.NamespacedName{Name: ais.Name + "-tls-ca", Namespace: ais.Namespace}
+}
+
+// CABundleConfigMapNSName returns the namespaced name of the ConfigMap publishing the CA bundle for clients
+func CABundleConfigMapNSName(ais *aisv1.AIStore) types.NamespacedName {
+	return types.NamespacedName{Name: ais.Name + "-ca-bundle", Namespace: ais.Namespace}
+}
+
+// CertificateSANs returns the DNS names and IP addresses of an operator-managed certificate
+func CertificateSANs(ais *aisv1.AIStore, publicHosts []string) (dnsNames, ipAddresses []string) {
+	return buildCertificateSANs(ais, publicHosts)
+}
+
+// SelfSignedCommonName returns the subject common name of the operator-issued certificate
+func SelfSignedCommonName(ais *aisv1.AIStore) string {
+	return fmt.Sprintf("%s-proxy.%s", ais.Name, ais.Namespace)
+}
+
+// NewSelfSignedCASecret stores the operator-generated CA. The previous CA, if any, is kept in the bundle
+// so clients trusting it keep working while certificates are reissued.
+func NewSelfSignedCASecret(ais *aisv1.AIStore, ca *certres.KeyPair, caBundle []byte) *corev1.Secret {
+	nn := SelfSignedCASecretNSName(ais)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       ca.CertPEM,
+			corev1.TLSPrivateKeyKey: ca.KeyPEM,
+			TLSCAFileName:           caBundle,
+		},
+	}
+}
+
+// NewSelfSignedTLSSecret stores an operator-issued certificate in the Secret mounted by AIS pods
+func NewSelfSignedTLSSecret(ais *aisv1.AIStore, cert *certres.KeyPair, caBundle []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: ais.GetTLSSecretName(), Namespace: ais.Namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			TLSCertFileName: cert.CertPEM,
+			TLSKeyFileName:  cert.KeyPEM,
+			TLSCAFileName:   caBundle,
+		},
+	}
+}
+
+// NewCABundleConfigMap publishes the CA bundle for AIS clients
+func NewCABundleConfigMap(ais *aisv1.AIStore, caBundle []byte) *corev1.ConfigMap {
+	nn := CABundleConfigMapNSName(ais)
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace},
+		Data:       map[string]string{TLSCAFileName: string(caBundle)},
+	}
+}
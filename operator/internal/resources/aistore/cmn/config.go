@@ -70,6 +70,16 @@ func GenerateConfigToSet(ais *aisv1.AIStore) (*aiscmn.ConfigToSet, error) {
 		specConfig.Net.HTTP.ClientCA = aisapc.Ptr(filepath.Join(certsDir, TLSCAFileName))
 	}
 
+	// IPv6-only clusters must select IPv6 addresses unless configured otherwise
+	if ais.UseIPv6() {
+		if specConfig.Net == nil {
+			specConfig.Net = &aisv1.NetConfToUpdate{}
+		}
+		if specConfig.Net.UseIPv6 == nil {
+			specConfig.Net.UseIPv6 = aisapc.Ptr(true)
+		}
+	}
+
 	// Override rebalance if the cluster is not ready for it (starting up, scaling, upgrading)
 	if ais.IsConditionTrue(aisv1.ConditionReadyRebalance) {
 		// If not provided, reset to default
@@ -86,7 +96,7 @@ func GenerateConfigToSet(ais *aisv1.AIStore) (*aiscmn.ConfigToSet, error) {
 
 	// Build OIDC issuer CA path from constants if ConfigMap is specified
 	var issuerCAPath string
-	if ais.Spec.IssuerCAConfigMap != nil {
+	if ais.OIDCIssuerCAConfigMap() != nil {
 		issuerCAPath = filepath.Join(OIDCCAMountPath, OIDCCAFileName)
 	}
 	specConfig.ConfigureAuth(ais.Spec.Auth, issuerCAPath)
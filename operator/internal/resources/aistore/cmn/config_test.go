@@ -5,6 +5,8 @@
 package cmn
 
 import (
+	"crypto/tls"
+
 	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aiscmn "github.com/NVIDIA/aistore/cmn"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
@@ -107,6 +109,85 @@ var _ = Describe("Config", Label("short"), func() {
 			Expect(conf.Net).To(BeNil())
 		})
 
+		It("should select IPv6 addresses with ipFamilyPolicy IPv6", func() {
+			ais := &aisv1.AIStore{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-ns"},
+				Spec:       aisv1.AIStoreSpec{IPFamilyPolicy: aisapc.Ptr(aisv1.IPFamilyPolicyIPv6)},
+			}
+			conf, err := GenerateConfigToSet(ais)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Net.UseIPv6).To(HaveValue(BeTrue()))
+
+			ais.Spec.IPFamilyPolicy = aisapc.Ptr(aisv1.IPFamilyPolicyDualStack)
+			conf, err = GenerateConfigToSet(ais)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Net).To(BeNil())
+		})
+
+		It("should keep client_auth_tls as configured with spec.tls.operatorClientCertificate", func() {
+			ais := &aisv1.AIStore{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-ns"},
+				Spec: aisv1.AIStoreSpec{
+					TLS: &aisv1.TLSSpec{
+						SelfSigned:                &aisv1.TLSSelfSignedConfig{},
+						OperatorClientCertificate: &aisv1.TLSOperatorClientCertificate{},
+					},
+				},
+			}
+			conf, err := GenerateConfigToSet(ais)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Net.HTTP.ClientAuthTLS).To(BeNil())
+
+			// An explicit setting is kept
+			ais.Spec.ConfigToUpdate = &aisv1.ConfigToUpdate{Net: &aisv1.NetConfToUpdate{
+				HTTP: &aisv1.HTTPConfToUpdate{ClientAuthTLS: aisapc.Ptr(int(tls.VerifyClientCertIfGiven))},
+			}}
+			conf, err = GenerateConfigToSet(ais)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Net.HTTP.ClientAuthTLS).To(HaveValue(Equal(int(tls.VerifyClientCertIfGiven))))
+		})
+
+		It("should translate spec.auth.oidc into the auth config", func() {
+			ais := &aisv1.AIStore{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-ns"},
+				Spec: aisv1.AIStoreSpec{
+					Auth: &aisv1.AuthSpec{
+						ProfileRef: &aisv1.AuthProfileRef{Name: "prod-authn"},
+						OIDC: &aisv1.OIDCAuthSpec{
+							Issuers:     []string{"https://keycloak.example.com/realms/aistore"},
+							Audiences:   []string{"AIStore"},
+							CAConfigMap: aisapc.Ptr("keycloak-ca"),
+						},
+					},
+				},
+			}
+			conf, err := GenerateConfigToSet(ais)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Auth.Enabled).To(HaveValue(BeTrue()))
+			Expect(conf.Auth.OIDC.AllowedIssuers).To(HaveValue(ConsistOf("https://keycloak.example.com/realms/aistore")))
+			Expect(conf.Auth.OIDC.IssuerCA).To(HaveValue(Equal("/etc/ais/oidc-ca/ca.crt")))
+			Expect(conf.Auth.RequiredClaims.Aud).To(HaveValue(ConsistOf("AIStore")))
+		})
+
+		It("should leave required claims alone when spec.auth.oidc has no audiences", func() {
+			ais := &aisv1.AIStore{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-ns"},
+				Spec: aisv1.AIStoreSpec{
+					Auth: &aisv1.AuthSpec{
+						ProfileRef: &aisv1.AuthProfileRef{Name: "prod-authn"},
+						OIDC:       &aisv1.OIDCAuthSpec{Issuers: []string{"https://keycloak.example.com/realms/aistore"}},
+					},
+					ConfigToUpdate: &aisv1.ConfigToUpdate{Auth: &aisv1.AuthConfToUpdate{
+						RequiredClaims: &aisv1.RequiredClaimsConfToUpdate{Aud: &[]string{"ais"}},
+					}},
+				},
+			}
+			conf, err := GenerateConfigToSet(ais)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conf.Auth.OIDC.IssuerCA).To(BeNil())
+			Expect(conf.Auth.RequiredClaims.Aud).To(HaveValue(ConsistOf("ais")))
+		})
+
 		It("should generate initial config without an error", func() {
 			const (
 				clusterName = "ais-cluster"
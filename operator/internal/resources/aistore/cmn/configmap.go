@@ -5,6 +5,7 @@
 package cmn
 
 import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	"github.com/ais-operator/internal/resources/ownerref"
 	jsoniter "github.com/json-iterator/go"
@@ -41,5 +42,8 @@ func NewGlobalCM(ais *aisv1.AIStore) (*corev1ac.ConfigMapApplyConfiguration, err
 }
 
 func AISConfigMapName(ais *aisv1.AIStore, daeType string) string {
+	if pool := ais.Spec.TargetSpec.Pool; daeType == aisapc.Target && pool != "" {
+		return ais.Name + "-" + daeType + "-" + pool
+	}
 	return ais.Name + "-" + daeType
 }
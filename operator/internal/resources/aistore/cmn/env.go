@@ -13,11 +13,12 @@ import (
 
 // Environment variables used by AIS init&daemon containers
 const (
-	EnvHostIPS     = "HOST_IPS"   // Host IPs of the node in which pod is deployed
-	EnvNodeName    = "MY_NODE"    // Hostname of the node in which pod is deployed
-	EnvPodName     = "MY_POD"     // Pod name to which the container belongs to
-	EnvNS          = "K8S_NS"     // K8s Namespace where `pod` is deployed
-	EnvServiceName = "MY_SERVICE" // K8s service associated with Pod
+	EnvHostIPS     = "HOST_IPS"     // Host IPs of the node in which pod is deployed
+	EnvNodeName    = "MY_NODE"      // Hostname of the node in which pod is deployed
+	EnvPodName     = "MY_POD"       // Pod name to which the container belongs to
+	EnvPodIndex    = "MY_POD_INDEX" // StatefulSet ordinal of the pod
+	EnvNS          = "K8S_NS"       // K8s Namespace where `pod` is deployed
+	EnvServiceName = "MY_SERVICE"   // K8s service associated with Pod
 
 	EnvPublicHostname       = "AIS_PUBLIC_HOSTNAME"
 	EnvPublicDNSMode        = "AIS_PUBLIC_DNS_MODE"    // Determines what DNS name to use for the public network
@@ -26,6 +27,9 @@ const (
 
 	EnvHostNetwork = "HOST_NETWORK" // Bool flag to indicate if host network is enabled for target
 
+	EnvTargetHostIP   = "AIS_HOST_IP"   // Public IP advertised by a target instead of its hostname
+	EnvTargetHostPort = "AIS_HOST_PORT" // Public port advertised by a target along with AIS_HOST_IP
+
 	// AuthN related environment variables
 	EnvAuthNSecretKey = "SIGNING-KEY" // Key for secret signing key in the K8s secret
 
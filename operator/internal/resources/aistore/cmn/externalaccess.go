@@ -5,8 +5,27 @@
 package cmn
 
 import (
+	"fmt"
+	"strconv"
+
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/ownerref"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	networkingv1ac "k8s.io/client-go/applyconfigurations/networking/v1"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1ac "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
+)
+
+// PodIndexLabel is set by the StatefulSet controller to the ordinal of each pod.
+const PodIndexLabel = "apps.kubernetes.io/pod-index"
+
+// Annotations read by external-dns from LoadBalancer Services.
+const (
+	ExternalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+	ExternalDNSTTLAnnotation      = "external-dns.alpha.kubernetes.io/ttl"
 )
 
 // ExternalAccessLBAnnotations returns annotations for an external LoadBalancer Service.
@@ -21,6 +40,19 @@ func ExternalAccessLBAnnotations(ea *aisv1.ExternalAccessSpec) map[string]string
 	return mergeServiceAnnotations(ann, user)
 }
 
+// ExternalDNSAnnotations returns the external-dns annotations publishing a LoadBalancer Service under hostname,
+// or nil if the component does not use external-dns.
+func ExternalDNSAnnotations(ea *aisv1.ExternalAccessSpec, hostname string) map[string]string {
+	if !ea.UsesExternalDNS() {
+		return nil
+	}
+	ann := map[string]string{ExternalDNSHostnameAnnotation: hostname}
+	if ea.ExternalDNS.TTL != nil {
+		ann[ExternalDNSTTLAnnotation] = strconv.Itoa(int(*ea.ExternalDNS.TTL))
+	}
+	return ann
+}
+
 func mergeServiceAnnotations(base, extra map[string]string) map[string]string {
 	out := make(map[string]string, len(base)+len(extra))
 	for k, v := range base {
@@ -41,3 +73,128 @@ func LoadBalancerIngressReady(ingress []corev1.LoadBalancerIngress) bool {
 	}
 	return false
 }
+
+// PublicHostnameEnv returns the init container environment advertising the routed hostname of each pod.
+// The pod ordinal is read from the pod index label and expanded by the kubelet.
+func PublicHostnameEnv(ais *aisv1.AIStore, ea *aisv1.ExternalAccessSpec) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		EnvFromFieldPath(EnvPodIndex, fmt.Sprintf("metadata.labels['%s']", PodIndexLabel)),
+		EnvFromValue(EnvPublicHostname, ais.ExternalHostnameWithIndex(ea, fmt.Sprintf("$(%s)", EnvPodIndex))),
+	}
+}
+
+// ExternalRoute describes a Service exposed outside the K8s cluster under a hostname.
+type ExternalRoute struct {
+	Name     string
+	Hostname string
+	Labels   map[string]string
+	Selector map[string]string
+	Spec     *aisv1.ServiceSpec
+}
+
+// NewRouteSVC returns the ClusterIP Service backing an external route.
+func NewRouteSVC(ais *aisv1.AIStore, route *ExternalRoute) *corev1ac.ServiceApplyConfiguration {
+	return corev1ac.Service(route.Name, ais.Namespace).
+		WithOwnerReferences(ownerref.NewControllerRef(ais)).
+		WithLabels(route.Labels).
+		WithSpec(NewServiceSpec(ais).
+			WithType(corev1.ServiceTypeClusterIP).
+			WithPorts(
+				corev1ac.ServicePort().
+					WithName("pub").
+					WithProtocol(corev1.ProtocolTCP).
+					WithPort(int32(route.Spec.ServicePort.IntValue())).
+					WithTargetPort(route.Spec.PublicPort),
+			).
+			WithSelector(route.Selector),
+		)
+}
+
+// IngressKind is the kind of the Ingresses created for external access.
+const IngressKind = "Ingress"
+
+// ExternalRouteKind returns the kind of the object created for each route: HTTPRoute, TLSRoute or Ingress.
+func ExternalRouteKind(ais *aisv1.AIStore, ea *aisv1.ExternalAccessSpec) string {
+	if ea.GetType() == aisv1.ExternalAccessIngress {
+		return IngressKind
+	}
+	return string(ea.Gateway.GetRouteKind(ais.UseHTTPS()))
+}
+
+// NewExternalRoute returns the HTTPRoute, TLSRoute or Ingress exposing the route Service under its hostname.
+func NewExternalRoute(ais *aisv1.AIStore, ea *aisv1.ExternalAccessSpec, route *ExternalRoute) runtime.ApplyConfiguration {
+	port := int32(route.Spec.ServicePort.IntValue())
+	kind := ExternalRouteKind(ais, ea)
+	if kind == IngressKind {
+		return newIngress(ais, ea, route, port)
+	}
+	parentRefs := make([]*gwapiv1ac.ParentReferenceApplyConfiguration, 0, len(ea.Gateway.ParentRefs))
+	for _, ref := range ea.Gateway.ParentRefs {
+		parentRef := gwapiv1ac.ParentReference().WithName(gwapiv1.ObjectName(ref.Name))
+		if ref.Namespace != nil {
+			parentRef.WithNamespace(gwapiv1.Namespace(*ref.Namespace))
+		}
+		if ref.SectionName != nil {
+			parentRef.WithSectionName(gwapiv1.SectionName(*ref.SectionName))
+		}
+		parentRefs = append(parentRefs, parentRef)
+	}
+	hostname := gwapiv1.Hostname(route.Hostname)
+	serviceName := gwapiv1.ObjectName(route.Name)
+	if kind == string(aisv1.GatewayRouteKindTLS) {
+		return gwapiv1ac.TLSRoute(route.Name, ais.Namespace).
+			WithOwnerReferences(ownerref.NewControllerRef(ais)).
+			WithLabels(route.Labels).
+			WithAnnotations(ea.Annotations).
+			WithSpec(gwapiv1ac.TLSRouteSpec().
+				WithParentRefs(parentRefs...).
+				WithHostnames(hostname).
+				WithRules(gwapiv1ac.TLSRouteRule().
+					WithBackendRefs(gwapiv1ac.BackendRef().WithName(serviceName).WithPort(port)),
+				),
+			)
+	}
+	return gwapiv1ac.HTTPRoute(route.Name, ais.Namespace).
+		WithOwnerReferences(ownerref.NewControllerRef(ais)).
+		WithLabels(route.Labels).
+		WithAnnotations(ea.Annotations).
+		WithSpec(gwapiv1ac.HTTPRouteSpec().
+			WithParentRefs(parentRefs...).
+			WithHostnames(hostname).
+			WithRules(gwapiv1ac.HTTPRouteRule().
+				WithBackendRefs(gwapiv1ac.HTTPBackendRef().WithName(serviceName).WithPort(port)),
+			),
+		)
+}
+
+func newIngress(ais *aisv1.AIStore, ea *aisv1.ExternalAccessSpec, route *ExternalRoute, port int32) *networkingv1ac.IngressApplyConfiguration {
+	spec := networkingv1ac.IngressSpec().
+		WithRules(networkingv1ac.IngressRule().
+			WithHost(route.Hostname).
+			WithHTTP(networkingv1ac.HTTPIngressRuleValue().
+				WithPaths(networkingv1ac.HTTPIngressPath().
+					WithPath("/").
+					WithPathType(networkingv1.PathTypePrefix).
+					WithBackend(networkingv1ac.IngressBackend().
+						WithService(networkingv1ac.IngressServiceBackend().
+							WithName(route.Name).
+							WithPort(networkingv1ac.ServiceBackendPort().WithNumber(port)),
+						),
+					),
+				),
+			),
+		)
+	if ingress := ea.Ingress; ingress != nil {
+		if ingress.ClassName != nil {
+			spec.WithIngressClassName(*ingress.ClassName)
+		}
+		if ingress.TLSSecretName != nil {
+			spec.WithTLS(networkingv1ac.IngressTLS().WithHosts(route.Hostname).WithSecretName(*ingress.TLSSecretName))
+		}
+	}
+	return networkingv1ac.Ingress(route.Name, ais.Namespace).
+		WithOwnerReferences(ownerref.NewControllerRef(ais)).
+		WithLabels(route.Labels).
+		WithAnnotations(ea.Annotations).
+		WithSpec(spec)
+}
@@ -7,8 +7,13 @@ package cmn
 import (
 	"testing"
 
+	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	networkingv1ac "k8s.io/client-go/applyconfigurations/networking/v1"
+	gwapiv1ac "sigs.k8s.io/gateway-api/applyconfiguration/apis/v1"
 )
 
 func TestLoadBalancerIngressReady(t *testing.T) {
@@ -40,3 +45,76 @@ func TestExternalAccessLBAnnotations_merge(t *testing.T) {
 		t.Fatal("expected user annotation to be merged")
 	}
 }
+
+func TestExternalDNSAnnotations(t *testing.T) {
+	if ann := ExternalDNSAnnotations(&aisv1.ExternalAccessSpec{}, "ais.example.com"); ann != nil {
+		t.Fatalf("expected no annotations without externalDNS, got %v", ann)
+	}
+	ea := &aisv1.ExternalAccessSpec{ExternalDNS: &aisv1.ExternalDNSSpec{Zone: "example.com", TTL: aisapc.Ptr(int32(60))}}
+	ann := ExternalDNSAnnotations(ea, "ais.example.com")
+	if ann[ExternalDNSHostnameAnnotation] != "ais.example.com" || ann[ExternalDNSTTLAnnotation] != "60" {
+		t.Fatalf("unexpected external-dns annotations %v", ann)
+	}
+	ea.Type = aisapc.Ptr(aisv1.ExternalAccessIngress)
+	if ann := ExternalDNSAnnotations(ea, "ais.example.com"); ann != nil {
+		t.Fatalf("expected no annotations with routes, got %v", ann)
+	}
+}
+
+func TestPublicHostnameEnv(t *testing.T) {
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	ea := &aisv1.ExternalAccessSpec{HostnameTemplate: aisapc.Ptr("{cluster}-t{index}.{namespace}.example.com")}
+	env := PublicHostnameEnv(ais, ea)
+	if len(env) != 2 || env[0].Name != EnvPodIndex || env[0].ValueFrom.FieldRef.FieldPath != "metadata.labels['apps.kubernetes.io/pod-index']" {
+		t.Fatalf("expected %s from the pod index label first, got %+v", EnvPodIndex, env)
+	}
+	if env[1].Name != EnvPublicHostname || env[1].Value != "ais-t$(MY_POD_INDEX).ais-ns.example.com" {
+		t.Fatalf("unexpected public hostname env %+v", env[1])
+	}
+}
+
+func TestNewExternalRoute(t *testing.T) {
+	ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+	route := &ExternalRoute{
+		Name:     "ais-proxy-route",
+		Hostname: "ais.example.com",
+		Spec:     &aisv1.ServiceSpec{ServicePort: intstr.FromInt32(51080), PublicPort: intstr.FromInt32(51081)},
+	}
+	gateway := &aisv1.GatewayAccessSpec{ParentRefs: []aisv1.GatewayParentRef{{Name: "gw", SectionName: aisapc.Ptr("ais")}}}
+
+	ea := &aisv1.ExternalAccessSpec{Type: aisapc.Ptr(aisv1.ExternalAccessGateway), Gateway: gateway}
+	httpRoute, ok := NewExternalRoute(ais, ea, route).(*gwapiv1ac.HTTPRouteApplyConfiguration)
+	if !ok {
+		t.Fatal("expected an HTTPRoute without TLS")
+	}
+	if *httpRoute.Spec.ParentRefs[0].SectionName != "ais" || httpRoute.Spec.Hostnames[0] != "ais.example.com" {
+		t.Fatalf("unexpected HTTPRoute spec %+v", httpRoute.Spec)
+	}
+	if *httpRoute.Spec.Rules[0].BackendRefs[0].Port != 51080 {
+		t.Fatal("expected the route to target the Service port")
+	}
+
+	ais.Spec.ConfigToUpdate = &aisv1.ConfigToUpdate{Net: &aisv1.NetConfToUpdate{HTTP: &aisv1.HTTPConfToUpdate{UseHTTPS: aisapc.Ptr(true)}}}
+	if _, ok := NewExternalRoute(ais, ea, route).(*gwapiv1ac.TLSRouteApplyConfiguration); !ok {
+		t.Fatal("expected a TLSRoute with TLS")
+	}
+	ea.Gateway = &aisv1.GatewayAccessSpec{ParentRefs: gateway.ParentRefs, RouteKind: aisapc.Ptr(aisv1.GatewayRouteKindHTTP)}
+	if _, ok := NewExternalRoute(ais, ea, route).(*gwapiv1ac.HTTPRouteApplyConfiguration); !ok {
+		t.Fatal("expected routeKind to override the default")
+	}
+
+	ea = &aisv1.ExternalAccessSpec{
+		Type:    aisapc.Ptr(aisv1.ExternalAccessIngress),
+		Ingress: &aisv1.IngressAccessSpec{ClassName: aisapc.Ptr("nginx"), TLSSecretName: aisapc.Ptr("ais-ingress-tls")},
+	}
+	ingress, ok := NewExternalRoute(ais, ea, route).(*networkingv1ac.IngressApplyConfiguration)
+	if !ok {
+		t.Fatal("expected an Ingress")
+	}
+	if *ingress.Spec.IngressClassName != "nginx" || ingress.Spec.TLS[0].Hosts[0] != "ais.example.com" {
+		t.Fatalf("unexpected Ingress spec %+v", ingress.Spec)
+	}
+	if *ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name != "ais-proxy-route" {
+		t.Fatal("expected the Ingress to target the route Service")
+	}
+}
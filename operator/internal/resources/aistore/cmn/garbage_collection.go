@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	"strconv"
+	"strings"
+)
+
+// OrphanedSinceAnnotation records when garbage collection first found a PVC orphaned, in RFC 3339 format
+const OrphanedSinceAnnotation = "gc.aistore.nvidia.com/orphaned-since"
+
+// ResourceOrdinal returns the pod ordinal of a per-pod resource of the StatefulSet: the pods and per-ordinal
+// Services named `<statefulset>-<ordinal>`, and the PVCs named `<claim>-<statefulset>-<ordinal>`.
+func ResourceOrdinal(name, statefulSetName string) (int32, bool) {
+	idx := strings.LastIndexByte(name, '-')
+	if idx < 0 {
+		return 0, false
+	}
+	prefix := name[:idx]
+	if prefix != statefulSetName && !strings.HasSuffix(prefix, "-"+statefulSetName) {
+		return 0, false
+	}
+	ordinal, err := strconv.ParseInt(name[idx+1:], 10, 32)
+	if err != nil || ordinal < 0 {
+		return 0, false
+	}
+	return int32(ordinal), true
+}
@@ -0,0 +1,27 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GarbageCollection", Label("short"), func() {
+	DescribeTable("should find the ordinal of per-pod resources",
+		func(name string, ordinal int32, ok bool) {
+			got, found := ResourceOrdinal(name, "ais-target")
+			Expect(found).To(Equal(ok))
+			Expect(got).To(Equal(ordinal))
+		},
+		Entry("pod or load balancer service", "ais-target-3", int32(3), true),
+		Entry("data PVC", "ais-data-ais-target-12", int32(12), true),
+		Entry("state PVC", "ais-ns-ais-state-ais-target-0", int32(0), true),
+		Entry("other statefulset", "ais-proxy-1", int32(0), false),
+		Entry("statefulset name suffix", "myais-target-1", int32(0), false),
+		Entry("no ordinal", "ais-target-nodeport", int32(0), false),
+		Entry("negative ordinal", "ais-target--1", int32(0), false),
+	)
+})
@@ -7,33 +7,66 @@ package cmn
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-const CleanupPrefix = "cleanup"
+const (
+	CleanupPrefix = "cleanup"
+	// CleanupNodeAnnotation records the node a cleanup job runs on, as node names may be too long for a label
+	CleanupNodeAnnotation = "cleanup.aistore.nvidia.com/node"
 
-// NewCleanupJob creates a cleanup job for a specific node
+	// StateCleanupDeadline bounds cleanup jobs that only delete state files
+	StateCleanupDeadline = 2 * time.Minute
+	// HostDataCleanupDeadline bounds cleanup jobs that also delete target data
+	HostDataCleanupDeadline = 2 * time.Hour
+
+	// cleanupJobTTL keeps finished jobs until the operator records their result, in case it is not running
+	cleanupJobTTL = int32(600)
+)
+
+// NewCleanupJob creates a cleanup job for a specific node.
+// The job deletes the state files of the cluster when using hostpath state, and the target data
+// under each `useHostPath` mount when confirmed with spec.cleanupHostData.
 func NewCleanupJob(ais *aisv1.AIStore, nodeName string) *batchv1.Job {
-	ttl := int32(0) // delete the pod as soon as it is completed
 	jobName := fmt.Sprintf("%s-%s-", CleanupPrefix, strings.ReplaceAll(nodeName, ".", "-"))
-	stateDir := StateHostPath(ais, "")
+	var (
+		containers []corev1.Container
+		volumes    []corev1.Volume
+		deadline   = StateCleanupDeadline
+	)
+	if stateDir := StateHostPath(ais, ""); stateDir != "" {
+		containers = append(containers, createContainerSpec(stateDir)...)
+		volumes = append(volumes, createVolumeSpec(stateDir)...)
+	}
+	if ais.ShouldCleanupHostData() {
+		container, dataVolumes := createDataCleanupSpec(ais)
+		containers = append(containers, container)
+		volumes = append(volumes, dataVolumes...)
+		deadline = HostDataCleanupDeadline
+	}
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: jobName,
 			Namespace:    ais.Namespace,
+			Labels:       SelectorLabels(ais.Name, CleanupPrefix),
+			Annotations:  map[string]string{CleanupNodeAnnotation: nodeName},
 		},
 		Spec: batchv1.JobSpec{
-			TTLSecondsAfterFinished: &ttl,
+			TTLSecondsAfterFinished: aisapc.Ptr(cleanupJobTTL),
+			ActiveDeadlineSeconds:   aisapc.Ptr(int64(deadline.Seconds())),
 			Template: corev1.PodTemplateSpec{
 				Spec: corev1.PodSpec{
 					Affinity:      createNodeAffinitySpec(nodeName),
-					Containers:    createContainerSpec(stateDir),
-					Volumes:       createVolumeSpec(stateDir),
+					Containers:    containers,
+					Volumes:       volumes,
 					Tolerations:   ais.GetAllTolerations(),
 					RestartPolicy: corev1.RestartPolicyNever,
 				},
@@ -68,7 +101,7 @@ func createContainerSpec(stateDir string) []corev1.Container {
 	return []corev1.Container{
 		{
 			Name:    "cleanup",
-			Image:   "docker.io/aistorage/ais-operator-helper:latest",
+			Image:   HelperImage,
 			Command: []string{"/cleanup-helper", "-dir=" + stateDir},
 			VolumeMounts: []corev1.VolumeMount{
 				{
@@ -93,3 +126,37 @@ func createVolumeSpec(stateDir string) []corev1.Volume {
 		},
 	}
 }
+
+// createDataCleanupSpec constructs the container wiping the cluster's target data directories
+// under each `useHostPath` mount, and the volumes mounting them at the same paths
+func createDataCleanupSpec(ais *aisv1.AIStore) (corev1.Container, []corev1.Volume) {
+	mounts := ais.HostPathMounts()
+	var (
+		mountpaths = make([]string, 0, len(mounts))
+		dirs       = make([]string, 0, len(mounts))
+		volumes    = make([]corev1.Volume, 0, len(mounts))
+		vms        = make([]corev1.VolumeMount, 0, len(mounts))
+	)
+	for i := range mounts {
+		dir := TargetHostDataPath(ais, &mounts[i])
+		name := "data-" + strconv.Itoa(i)
+		mountpaths = append(mountpaths, mounts[i].Path)
+		dirs = append(dirs, dir)
+		volumes = append(volumes, corev1.Volume{
+			Name:         name,
+			VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: dir}},
+		})
+		vms = append(vms, corev1.VolumeMount{Name: name, MountPath: dir})
+	}
+	container := corev1.Container{
+		Name:  "cleanup-data",
+		Image: HelperImage,
+		Command: []string{
+			"/cleanup-helper", "-mode=wipe",
+			"-mountpaths=" + strings.Join(mountpaths, ","),
+			"-wipe_dirs=" + strings.Join(dirs, ","),
+		},
+		VolumeMounts: vms,
+	}
+	return container, volumes
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cleanup job", Label("short"), func() {
+	var ais *aisv1.AIStore
+
+	BeforeEach(func() {
+		ais = newTestAIS()
+		ais.Name = "ais"
+		ais.Namespace = "ais-ns"
+		ais.Spec.CleanupMetadata = aisapc.Ptr(true)
+		ais.Spec.StateStorage = &aisv1.StateStorage{HostPath: &aisv1.StateHostPathConfig{Prefix: "/etc/ais"}}
+		ais.Spec.TargetSpec.Mounts = []aisv1.Mount{
+			{Path: "/ais/nvme0", UseHostPath: aisapc.Ptr(true)},
+			{Path: "/ais/pvc"},
+			{Path: "/ais/nvme1", UseHostPath: aisapc.Ptr(true)},
+		}
+	})
+
+	It("should only delete state files by default", func() {
+		job := NewCleanupJob(ais, "node.example.com")
+		Expect(job.GenerateName).To(Equal("cleanup-node-example-com-"))
+		Expect(job.Labels).To(Equal(SelectorLabels("ais", CleanupPrefix)))
+		Expect(job.Annotations).To(HaveKeyWithValue(CleanupNodeAnnotation, "node.example.com"))
+		Expect(*job.Spec.ActiveDeadlineSeconds).To(BeEquivalentTo(StateCleanupDeadline.Seconds()))
+		containers := job.Spec.Template.Spec.Containers
+		Expect(containers).To(HaveLen(1))
+		Expect(containers[0].Command).To(Equal([]string{"/cleanup-helper", "-dir=/etc/ais/ais-ns/ais"}))
+	})
+
+	It("should wipe target host data only when confirmed", func() {
+		ais.Spec.CleanupData = aisapc.Ptr(true)
+		ais.Spec.CleanupHostData = aisapc.Ptr("other")
+		Expect(NewCleanupJob(ais, "node").Spec.Template.Spec.Containers).To(HaveLen(1))
+
+		ais.Spec.CleanupHostData = aisapc.Ptr("ais")
+		job := NewCleanupJob(ais, "node")
+		Expect(*job.Spec.ActiveDeadlineSeconds).To(BeEquivalentTo(HostDataCleanupDeadline.Seconds()))
+		containers := job.Spec.Template.Spec.Containers
+		Expect(containers).To(HaveLen(2))
+		Expect(containers[1].Command).To(Equal([]string{
+			"/cleanup-helper", "-mode=wipe",
+			"-mountpaths=/ais/nvme0,/ais/nvme1",
+			"-wipe_dirs=/ais/nvme0/ais-ns/ais/target,/ais/nvme1/ais-ns/ais/target",
+		}))
+		Expect(containers[1].VolumeMounts).To(HaveLen(2))
+		Expect(job.Spec.Template.Spec.Volumes).To(HaveLen(3))
+		Expect(job.Spec.Template.Spec.Volumes[2].HostPath.Path).To(Equal("/ais/nvme1/ais-ns/ais/target"))
+
+		// Mounts of target pools are wiped too, once per path
+		ais.Spec.TargetPools = []aisv1.TargetPoolSpec{
+			{Name: "inherit", Size: 1},
+			{Name: "nvme", Size: 1, Mounts: []aisv1.Mount{
+				{Path: "/ais/nvme1", UseHostPath: aisapc.Ptr(true)},
+				{Path: "/ais/nvme2", UseHostPath: aisapc.Ptr(true)},
+			}},
+		}
+		containers = NewCleanupJob(ais, "node").Spec.Template.Spec.Containers
+		Expect(containers[1].Command).To(Equal([]string{
+			"/cleanup-helper", "-mode=wipe",
+			"-mountpaths=/ais/nvme0,/ais/nvme1,/ais/nvme2",
+			"-wipe_dirs=/ais/nvme0/ais-ns/ais/target,/ais/nvme1/ais-ns/ais/target,/ais/nvme2/ais-ns/ais/target",
+		}))
+		ais.Spec.TargetPools = nil
+
+		// Without hostpath state, only target data is wiped
+		ais.Spec.StateStorage = &aisv1.StateStorage{PVC: &aisv1.StatePVCConfig{StorageClass: "local"}}
+		containers = NewCleanupJob(ais, "node").Spec.Template.Spec.Containers
+		Expect(containers).To(HaveLen(1))
+		Expect(containers[0].Name).To(Equal("cleanup-data"))
+	})
+})
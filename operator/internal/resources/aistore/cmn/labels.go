@@ -6,6 +6,14 @@ package cmn
 
 import "maps"
 
+const (
+	// LabelTargetPool holds the target pool of the targets and resources of a pool
+	LabelTargetPool = "aistore.nvidia.com/target-pool"
+	// LabelDrainReleased marks the targets whose data was rebalanced off their drained node, excluding them from
+	// the target PDBs so they can be evicted
+	LabelDrainReleased = "aistore.nvidia.com/drain-released"
+)
+
 // LegacyLabels returns standard AIS daemon labels including unprefixed app and component
 // for backward compatibility with external selectors.
 func LegacyLabels(name, component string) map[string]string {
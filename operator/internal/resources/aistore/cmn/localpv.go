@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"regexp"
+	"strings"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// LocalPVNamespaceLabel scopes operator-created local PVs, which are cluster-scoped, to the namespace of the cluster
+	LocalPVNamespaceLabel = "storage.aistore.nvidia.com/namespace"
+	// LocalPVMountpathLabel identifies the target mount a local PV was created for
+	LocalPVMountpathLabel = "storage.aistore.nvidia.com/mountpath"
+	// LocalPVNodeAnnotation records the node of a local PV, as node names may be too long for a label
+	LocalPVNodeAnnotation = "storage.aistore.nvidia.com/node"
+
+	nameHashLen = 8
+)
+
+var invalidNameChars = regexp.MustCompile("[^a-z0-9.-]+")
+
+// LocalPVClusterLabels selects all local PVs created for the targets of a cluster.
+func LocalPVClusterLabels(ais *aisv1.AIStore) map[string]string {
+	labels := SelectorLabels(ais.Name, aisapc.Target)
+	labels[LocalPVNamespaceLabel] = ais.Namespace
+	return labels
+}
+
+// LocalPVLabels returns the labels of the local PVs created for a target mount,
+// also used as the selector of its PVCs when the mount has none.
+func LocalPVLabels(ais *aisv1.AIStore, mnt *aisv1.Mount) map[string]string {
+	labels := LocalPVClusterLabels(ais)
+	labels[LocalPVMountpathLabel] = truncateWithHash(sanitizeName(mnt.Path), validation.LabelValueMaxLength)
+	return labels
+}
+
+// LocalPVName returns the name of the local PV created for a target mount on a node.
+func LocalPVName(ais *aisv1.AIStore, nodeName string, mnt *aisv1.Mount) string {
+	name := strings.Join([]string{ais.Namespace, ais.Name, nodeName, sanitizeName(mnt.Path)}, "-")
+	return truncateWithHash(name, validation.DNS1123SubdomainMaxLength)
+}
+
+// NewLocalPV returns the local PV backed by the mount path on the node, with the capacity and storage class of
+// the mount. Data is never deleted with the PV, so the reclaim policy is Retain.
+func NewLocalPV(ais *aisv1.AIStore, node *corev1.Node, mnt *aisv1.Mount) *corev1.PersistentVolume {
+	labels := map[string]string{}
+	if mnt.Selector != nil {
+		maps.Copy(labels, mnt.Selector.MatchLabels)
+	}
+	maps.Copy(labels, LocalPVLabels(ais, mnt))
+	hostname := node.Labels[corev1.LabelHostname]
+	if hostname == "" {
+		hostname = node.Name
+	}
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        LocalPVName(ais, node.Name, mnt),
+			Labels:      labels,
+			Annotations: map[string]string{LocalPVNodeAnnotation: node.Name},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			VolumeMode:                    aisapc.Ptr(corev1.PersistentVolumeFilesystem),
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				Local: &corev1.LocalVolumeSource{Path: mnt.Path},
+			},
+			NodeAffinity: &corev1.VolumeNodeAffinity{
+				Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      corev1.LabelHostname,
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{hostname},
+						}},
+					}},
+				},
+			},
+		},
+	}
+	if mnt.StorageClass != nil {
+		pv.Spec.StorageClassName = *mnt.StorageClass
+	}
+	if mnt.Size != nil {
+		pv.Spec.Capacity = corev1.ResourceList{corev1.ResourceStorage: *mnt.Size}
+	}
+	return pv
+}
+
+// sanitizeName converts a path to a valid DNS-1123 name fragment, e.g. /ais/nvme0n1 to ais-nvme0n1.
+func sanitizeName(p string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(p), "-"), "-.")
+}
+
+// truncateWithHash shortens names over the limit, keeping them unique with a hash of the full name.
+func truncateWithHash(name string, limit int) string {
+	if len(name) <= limit {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	prefix := strings.TrimRight(name[:limit-nameHashLen-1], "-.")
+	return prefix + "-" + hex.EncodeToString(sum[:])[:nameHashLen]
+}
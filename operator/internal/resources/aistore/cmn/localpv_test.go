@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	"strings"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var _ = Describe("LocalPV", Label("short"), func() {
+	var (
+		ais  *aisv1.AIStore
+		mnt  *aisv1.Mount
+		node *corev1.Node
+	)
+
+	BeforeEach(func() {
+		ais = newTestAIS()
+		ais.Name = "ais"
+		ais.Namespace = "ais-ns"
+		size := resource.MustParse("1Ti")
+		mnt = &aisv1.Mount{
+			Path:         "/ais/nvme0n1",
+			Size:         &size,
+			StorageClass: aisapc.Ptr("local-storage"),
+			LocalPV:      aisapc.Ptr(true),
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"disk": "nvme"}},
+		}
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-1",
+			Labels: map[string]string{corev1.LabelHostname: "node-1.example.com"},
+		}}
+	})
+
+	It("should create a local PV pinned to the node", func() {
+		pv := NewLocalPV(ais, node, mnt)
+		Expect(pv.Name).To(Equal("ais-ns-ais-node-1-ais-nvme0n1"))
+		Expect(pv.Labels).To(HaveKeyWithValue("disk", "nvme"))
+		Expect(pv.Labels).To(HaveKeyWithValue(LocalPVMountpathLabel, "ais-nvme0n1"))
+		Expect(pv.Labels).To(HaveKeyWithValue(LocalPVNamespaceLabel, "ais-ns"))
+		Expect(pv.Annotations).To(HaveKeyWithValue(LocalPVNodeAnnotation, "node-1"))
+		Expect(pv.Spec.Local.Path).To(Equal("/ais/nvme0n1"))
+		Expect(pv.Spec.StorageClassName).To(Equal("local-storage"))
+		Expect(pv.Spec.PersistentVolumeReclaimPolicy).To(Equal(corev1.PersistentVolumeReclaimRetain))
+		Expect(pv.Spec.Capacity.Storage().String()).To(Equal("1Ti"))
+		Expect(pv.Spec.NodeAffinity.Required.NodeSelectorTerms[0].MatchExpressions[0].Values).To(Equal([]string{"node-1.example.com"}))
+	})
+
+	It("should select the PVs of the mount from the cluster PVs", func() {
+		Expect(LocalPVLabels(ais, mnt)).To(HaveKeyWithValue(LocalPVMountpathLabel, "ais-nvme0n1"))
+		for k, v := range LocalPVClusterLabels(ais) {
+			Expect(LocalPVLabels(ais, mnt)).To(HaveKeyWithValue(k, v))
+		}
+	})
+
+	It("should keep long names valid and unique", func() {
+		long := strings.Repeat("a", 300)
+		first := LocalPVName(ais, long+"1", mnt)
+		second := LocalPVName(ais, long+"2", mnt)
+		Expect(first).To(HaveLen(validation.DNS1123SubdomainMaxLength))
+		Expect(validation.IsDNS1123Subdomain(first)).To(BeEmpty())
+		Expect(first).NotTo(Equal(second))
+
+		mnt.Path = "/" + long
+		Expect(validation.IsValidLabelValue(LocalPVLabels(ais, mnt)[LocalPVMountpathLabel])).To(BeEmpty())
+	})
+})
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// MountpathsAnnotation is set on nodes by the mount-discovery agent to the filesystems mounted on the node
+	MountpathsAnnotation = "storage.aistore.nvidia.com/mountpaths"
+	// NodeMountpathsFileName is the target ConfigMap entry mapping node names to their discovered mountpaths
+	NodeMountpathsFileName = "node_mountpaths.json"
+
+	// MountpathsContainerName is the init container adding the discovered mountpaths to the AIS local config
+	MountpathsContainerName = "mountpaths"
+
+	mountDiscoveryVolume = "discovered-mounts"
+)
+
+// DiscoveredFilesystem is an entry of the mountpaths node annotation.
+type DiscoveredFilesystem struct {
+	Path   string `json:"path"`
+	Device string `json:"device,omitempty"`
+	FSType string `json:"fsType,omitempty"`
+}
+
+// DiscoveredMountpaths returns the sorted mountpaths of the node selected by the mount discovery spec:
+// filesystems mounted directly under root whose name matches the pattern and whose type is allowed.
+// Returns nil when the node has not been reported by the agent.
+func DiscoveredMountpaths(md *aisv1.MountDiscoverySpec, node *corev1.Node) ([]string, error) {
+	raw, ok := node.Annotations[MountpathsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	var filesystems []DiscoveredFilesystem
+	if err := json.Unmarshal([]byte(raw), &filesystems); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation of node %q: %w", MountpathsAnnotation, node.Name, err)
+	}
+	pattern := "*"
+	if md.Pattern != nil {
+		pattern = *md.Pattern
+	}
+	var mpaths []string
+	for _, fs := range filesystems {
+		mpath := path.Clean(fs.Path)
+		if path.Dir(mpath) != md.Root {
+			continue
+		}
+		if len(md.FSTypes) > 0 && !slices.Contains(md.FSTypes, fs.FSType) {
+			continue
+		}
+		if matched, err := path.Match(pattern, path.Base(mpath)); err != nil || !matched {
+			continue
+		}
+		mpaths = append(mpaths, mpath)
+	}
+	slices.Sort(mpaths)
+	return slices.Compact(mpaths), nil
+}
+
+// NewMountpathsInitContainer returns the init container that waits for the discovered mountpaths of its node
+// in the target ConfigMap and adds them to the AIS local config generated by the config init container.
+// Returns nil when the cluster does not use mount discovery.
+func NewMountpathsInitContainer(ais *aisv1.AIStore) *corev1.Container {
+	md := ais.Spec.TargetSpec.MountDiscovery
+	if md == nil {
+		return nil
+	}
+	args := []string{
+		"-mode=configure",
+		"-local_config=" + path.Join(AisConfigDir, AISLocalConfigName),
+		"-node_mountpaths=" + path.Join(InitConfTemplateDir, NodeMountpathsFileName),
+		"-node=$(" + EnvNodeName + ")",
+	}
+	if md.Label != nil {
+		args = append(args, "-label="+*md.Label)
+	}
+	return &corev1.Container{
+		Name:            MountpathsContainerName,
+		Image:           HelperImage,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"/mount-discovery"},
+		Args:            args,
+		Env:             []corev1.EnvVar{EnvFromFieldPath(EnvNodeName, "spec.nodeName")},
+		Resources:       *NewInitResourceReq(),
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: configTemplateVolume, MountPath: InitConfTemplateDir, ReadOnly: true},
+			{Name: configVolume, MountPath: AisConfigDir},
+		},
+		SecurityContext: RestrictedSecurityContext(),
+	}
+}
+
+// NewMountDiscoveryVolume returns the host root of the discovered filesystems, and its mount in the AIS container
+// propagating the filesystems mounted under it. Returns nil when the cluster does not use mount discovery.
+func NewMountDiscoveryVolume(ais *aisv1.AIStore) (*corev1.Volume, *corev1.VolumeMount) {
+	md := ais.Spec.TargetSpec.MountDiscovery
+	if md == nil {
+		return nil, nil
+	}
+	volume := &corev1.Volume{
+		Name: mountDiscoveryVolume,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: md.Root,
+				Type: aisapc.Ptr(corev1.HostPathDirectory),
+			},
+		},
+	}
+	mount := &corev1.VolumeMount{
+		Name:             mountDiscoveryVolume,
+		MountPath:        md.Root,
+		MountPropagation: aisapc.Ptr(corev1.MountPropagationHostToContainer),
+	}
+	return volume, mount
+}
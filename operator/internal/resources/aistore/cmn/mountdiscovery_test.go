@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("MountDiscovery", Label("short"), func() {
+	var ais *aisv1.AIStore
+
+	BeforeEach(func() {
+		ais = newTestAIS()
+		ais.Spec.TargetSpec.MountDiscovery = &aisv1.MountDiscoverySpec{Root: "/ais"}
+	})
+
+	Describe("DiscoveredMountpaths", func() {
+		node := func(annotation string) *corev1.Node {
+			n := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+			if annotation != "" {
+				n.Annotations = map[string]string{MountpathsAnnotation: annotation}
+			}
+			return n
+		}
+		const reported = `[
+			{"path": "/ais/nvme1n1", "device": "/dev/nvme1n1", "fsType": "xfs"},
+			{"path": "/ais/nvme0n1/", "device": "/dev/nvme0n1", "fsType": "xfs"},
+			{"path": "/ais/sda", "device": "/dev/sda", "fsType": "ext4"},
+			{"path": "/ais/nested/nvme2n1", "device": "/dev/nvme2n1", "fsType": "xfs"},
+			{"path": "/data/nvme3n1", "device": "/dev/nvme3n1", "fsType": "xfs"}
+		]`
+
+		It("should return the sorted filesystems directly under root", func() {
+			mpaths, err := DiscoveredMountpaths(ais.Spec.TargetSpec.MountDiscovery, node(reported))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mpaths).To(Equal([]string{"/ais/nvme0n1", "/ais/nvme1n1", "/ais/sda"}))
+		})
+
+		It("should filter by pattern and filesystem type", func() {
+			md := ais.Spec.TargetSpec.MountDiscovery
+			md.Pattern = aisapc.Ptr("nvme*")
+			mpaths, err := DiscoveredMountpaths(md, node(reported))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mpaths).To(Equal([]string{"/ais/nvme0n1", "/ais/nvme1n1"}))
+
+			md.Pattern = nil
+			md.FSTypes = []string{"ext4"}
+			mpaths, err = DiscoveredMountpaths(md, node(reported))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mpaths).To(Equal([]string{"/ais/sda"}))
+		})
+
+		It("should return nil for unreported nodes and fail on a malformed annotation", func() {
+			mpaths, err := DiscoveredMountpaths(ais.Spec.TargetSpec.MountDiscovery, node(""))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mpaths).To(BeNil())
+
+			_, err = DiscoveredMountpaths(ais.Spec.TargetSpec.MountDiscovery, node("{"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("should configure the mountpaths of the node in the init container", func() {
+		ais.Spec.TargetSpec.MountDiscovery.Label = aisapc.Ptr("nvme")
+		c := NewMountpathsInitContainer(ais)
+		Expect(c.Name).To(Equal(MountpathsContainerName))
+		Expect(c.Args).To(Equal([]string{
+			"-mode=configure",
+			"-local_config=/var/ais_config/ais_local.json",
+			"-node_mountpaths=/var/ais_config_template/node_mountpaths.json",
+			"-node=$(MY_NODE)",
+			"-label=nvme",
+		}))
+
+		ais.Spec.TargetSpec.MountDiscovery = nil
+		Expect(NewMountpathsInitContainer(ais)).To(BeNil())
+	})
+
+	It("should mount the root with host-to-container propagation", func() {
+		volume, mount := NewMountDiscoveryVolume(ais)
+		Expect(volume.HostPath.Path).To(Equal("/ais"))
+		Expect(mount.MountPath).To(Equal("/ais"))
+		Expect(*mount.MountPropagation).To(Equal(corev1.MountPropagationHostToContainer))
+
+		ais.Spec.TargetSpec.MountDiscovery = nil
+		volume, mount = NewMountDiscoveryVolume(ais)
+		Expect(volume).To(BeNil())
+		Expect(mount).To(BeNil())
+	})
+})
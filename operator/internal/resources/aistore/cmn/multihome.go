@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	"encoding/json"
+	"net"
+	"path"
+	"strings"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// HelperImage is the image of the operator helper executables run in jobs and AIS pods
+	HelperImage = "docker.io/aistorage/ais-operator-helper:latest"
+
+	// MultihomeContainerName is the init container adding the multihome addresses to the AIS local config
+	MultihomeContainerName = "multihome"
+
+	networkStatusVolume   = "network-status"
+	networkStatusDir      = "/var/network_status"
+	networkStatusFileName = "network-status"
+)
+
+// NetAttachmentAnnotation returns the multus networks annotation of AIS pods, or nil without additional networks.
+// Multihome attachments use the `<namespace>/<name>@<interface>` form, so they can select a namespace and interface.
+func NetAttachmentAnnotation(ais *aisv1.AIStore) *string {
+	attachments := ais.MultihomeAttachments()
+	if len(attachments) == 0 {
+		return ais.Spec.NetAttachment
+	}
+	refs := make([]string, 0, len(attachments))
+	for i := range attachments {
+		refs = append(refs, attachmentRef(ais, &attachments[i]))
+	}
+	return aisapc.Ptr(strings.Join(refs, ","))
+}
+
+// attachmentRef identifies an attachment in the multus networks annotation and the arguments of the
+// multihome helper, as `<namespace>/<name>`, with an `@<interface>` suffix when the interface is requested.
+func attachmentRef(ais *aisv1.AIStore, na *aisv1.NetworkAttachment) string {
+	ref := na.GetNamespace(ais.Namespace) + "/" + na.Name
+	if na.Interface != nil {
+		ref += "@" + *na.Interface
+	}
+	return ref
+}
+
+func attachmentRefs(ais *aisv1.AIStore, usage aisv1.NetworkUsage) (refs []string) {
+	attachments := ais.MultihomeAttachments()
+	for i := range attachments {
+		if attachments[i].HasUsage(usage) {
+			refs = append(refs, attachmentRef(ais, &attachments[i]))
+		}
+	}
+	return refs
+}
+
+// NewMultihomeInitContainer returns the init container that waits for the addresses assigned on the multihome
+// attachments and writes them to the AIS local config generated by the config init container.
+// Returns nil when the cluster has no multihome attachments.
+func NewMultihomeInitContainer(ais *aisv1.AIStore) *corev1.Container {
+	if len(ais.MultihomeAttachments()) == 0 {
+		return nil
+	}
+	args := []string{
+		"-local_config=" + path.Join(AisConfigDir, AISLocalConfigName),
+		"-network_status=" + path.Join(networkStatusDir, networkStatusFileName),
+	}
+	if ais.UseIPv6() {
+		args = append(args, "-ipv6")
+	}
+	for _, f := range []struct {
+		flag  string
+		usage aisv1.NetworkUsage
+	}{
+		{"public", aisv1.NetworkUsagePublic},
+		{"intra_control", aisv1.NetworkUsageIntraControl},
+		{"intra_data", aisv1.NetworkUsageIntraData},
+	} {
+		if refs := attachmentRefs(ais, f.usage); len(refs) > 0 {
+			args = append(args, "-"+f.flag+"="+strings.Join(refs, ","))
+		}
+	}
+	return &corev1.Container{
+		Name:            MultihomeContainerName,
+		Image:           HelperImage,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"/multihome-helper"},
+		Args:            args,
+		Resources:       *NewInitResourceReq(),
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: configVolume, MountPath: AisConfigDir},
+			{Name: networkStatusVolume, MountPath: networkStatusDir, ReadOnly: true},
+		},
+		SecurityContext: RestrictedSecurityContext(),
+	}
+}
+
+// newNetworkStatusVolume exposes the network-status annotation multus sets on the pod before its containers start.
+func newNetworkStatusVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: networkStatusVolume,
+		VolumeSource: corev1.VolumeSource{
+			DownwardAPI: &corev1.DownwardAPIVolumeSource{
+				Items: []corev1.DownwardAPIVolumeFile{{
+					Path:     networkStatusFileName,
+					FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations['" + nadv1.NetworkStatusAnnot + "']"},
+				}},
+				DefaultMode: &CMDefaultMode,
+			},
+		},
+	}
+}
+
+// MultihomeAddressesFromPod returns the addresses assigned to the pod on the multihome attachments of the cluster,
+// read from the network-status annotation. Only addresses of the IP family used by AIS are returned.
+// Returns nil until multus reports an address of that family for every attachment.
+func MultihomeAddressesFromPod(ais *aisv1.AIStore, pod *corev1.Pod) (*aisv1.MultihomeAddresses, error) {
+	raw, ok := pod.Annotations[nadv1.NetworkStatusAnnot]
+	if !ok {
+		return nil, nil
+	}
+	var statuses []nadv1.NetworkStatus
+	if err := json.Unmarshal([]byte(raw), &statuses); err != nil {
+		return nil, err
+	}
+	addrs := &aisv1.MultihomeAddresses{Pod: pod.Name}
+	attachments := ais.MultihomeAttachments()
+	for i := range attachments {
+		na := &attachments[i]
+		ips := attachmentIPs(statuses, ais, na, ais.UseIPv6())
+		if len(ips) == 0 {
+			return nil, nil
+		}
+		if na.HasUsage(aisv1.NetworkUsagePublic) {
+			addrs.Public = append(addrs.Public, ips...)
+		}
+		if na.HasUsage(aisv1.NetworkUsageIntraControl) {
+			addrs.IntraControl = ips[0]
+		}
+		if na.HasUsage(aisv1.NetworkUsageIntraData) {
+			addrs.IntraData = ips[0]
+		}
+	}
+	return addrs, nil
+}
+
+func attachmentIPs(statuses []nadv1.NetworkStatus, ais *aisv1.AIStore, na *aisv1.NetworkAttachment, ipv6 bool) (ips []string) {
+	name := na.GetNamespace(ais.Namespace) + "/" + na.Name
+	for i := range statuses {
+		if statuses[i].Name != name || (na.Interface != nil && statuses[i].Interface != *na.Interface) {
+			continue
+		}
+		for _, ip := range statuses[i].IPs {
+			if parsed := net.ParseIP(ip); parsed != nil && (parsed.To4() == nil) == ipv6 {
+				ips = append(ips, parsed.String())
+			}
+		}
+		return ips
+	}
+	return nil
+}
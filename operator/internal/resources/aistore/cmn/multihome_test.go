@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Multihome", Label("short"), func() {
+	var ais *aisv1.AIStore
+
+	BeforeEach(func() {
+		ais = newTestAIS()
+		ais.Namespace = "ais"
+		ais.Spec.Multihome = &aisv1.MultihomeSpec{Attachments: []aisv1.NetworkAttachment{
+			{Name: "public-net", Interface: aisapc.Ptr("net1"), Usage: []aisv1.NetworkUsage{aisv1.NetworkUsagePublic}},
+			{Name: "data-net", Namespace: aisapc.Ptr("nets"), Usage: []aisv1.NetworkUsage{aisv1.NetworkUsageIntraControl, aisv1.NetworkUsageIntraData}},
+		}}
+	})
+
+	It("should request all attachments from multus", func() {
+		Expect(*NetAttachmentAnnotation(ais)).To(Equal("ais/public-net@net1,nets/data-net"))
+
+		ais.Spec.Multihome = nil
+		Expect(NetAttachmentAnnotation(ais)).To(BeNil())
+		ais.Spec.NetAttachment = aisapc.Ptr("legacy")
+		Expect(*NetAttachmentAnnotation(ais)).To(Equal("legacy"))
+	})
+
+	It("should pass the attachments of each network to the helper", func() {
+		c := NewMultihomeInitContainer(ais)
+		Expect(c.Name).To(Equal(MultihomeContainerName))
+		Expect(c.Args).To(Equal([]string{
+			"-local_config=/var/ais_config/ais_local.json",
+			"-network_status=/var/network_status/network-status",
+			"-public=ais/public-net@net1",
+			"-intra_control=nets/data-net",
+			"-intra_data=nets/data-net",
+		}))
+
+		ais.Spec.IPFamilyPolicy = aisapc.Ptr(aisv1.IPFamilyPolicyIPv6)
+		Expect(NewMultihomeInitContainer(ais).Args).To(ContainElement("-ipv6"))
+
+		ais.Spec.Multihome = nil
+		Expect(NewMultihomeInitContainer(ais)).To(BeNil())
+	})
+
+	Describe("MultihomeAddressesFromPod", func() {
+		pod := func(status string) *corev1.Pod {
+			p := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "ais-target-0"}}
+			if status != "" {
+				p.Annotations = map[string]string{nadv1.NetworkStatusAnnot: status}
+			}
+			return p
+		}
+		const reported = `[
+			{"name": "cbr0", "ips": ["10.244.0.5"], "default": true},
+			{"name": "ais/public-net", "interface": "net1", "ips": ["192.168.1.5", "fd00::5"]},
+			{"name": "nets/data-net", "interface": "net2", "ips": ["172.16.0.5"]}
+		]`
+
+		It("should return the addresses of the AIS IP family", func() {
+			addrs, err := MultihomeAddressesFromPod(ais, pod(reported))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*addrs).To(Equal(aisv1.MultihomeAddresses{
+				Pod:          "ais-target-0",
+				Public:       []string{"192.168.1.5"},
+				IntraControl: "172.16.0.5",
+				IntraData:    "172.16.0.5",
+			}))
+		})
+
+		It("should return nil until every attachment has an address", func() {
+			addrs, err := MultihomeAddressesFromPod(ais, pod(""))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addrs).To(BeNil())
+
+			// The data network has no IPv6 address
+			ais.Spec.IPFamilyPolicy = aisapc.Ptr(aisv1.IPFamilyPolicyIPv6)
+			addrs, err = MultihomeAddressesFromPod(ais, pod(reported))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addrs).To(BeNil())
+		})
+
+		It("should match the requested interface", func() {
+			ais.Spec.Multihome.Attachments[0].Interface = aisapc.Ptr("net3")
+			addrs, err := MultihomeAddressesFromPod(ais, pod(reported))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addrs).To(BeNil())
+		})
+
+		It("should fail on a malformed annotation", func() {
+			_, err := MultihomeAddressesFromPod(ais, pod("{"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
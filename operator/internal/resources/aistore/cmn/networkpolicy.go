@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func networkPolicyName(ais *aisv1.AIStore, daemonType string) string {
+	return ais.Name + "-" + daemonType
+}
+
+func NetworkPolicyNSName(ais *aisv1.AIStore, daemonType string) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      networkPolicyName(ais, daemonType),
+		Namespace: ais.Namespace,
+	}
+}
+
+// NewNetworkPolicy creates the NetworkPolicy of the proxy or target pods of the cluster.
+// Their intra-cluster and public ports accept the AIS pods of the cluster. The public port also accepts
+// publicPeers, or any peer when openPublic is set.
+func NewNetworkPolicy(ais *aisv1.AIStore, daemonType string, spec *aisv1.ServiceSpec, publicPeers []networkingv1.NetworkPolicyPeer,
+	openPublic bool) *networkingv1.NetworkPolicy {
+	ingress := []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{
+			{PodSelector: &metav1.LabelSelector{MatchLabels: SelectorLabels(ais.Name, aisapc.Proxy)}},
+			{PodSelector: &metav1.LabelSelector{MatchLabels: SelectorLabels(ais.Name, aisapc.Target)}},
+		},
+		Ports: networkPolicyPorts(spec.PublicPort, spec.IntraControlPort, spec.IntraDataPort),
+	}}
+	switch {
+	case openPublic:
+		// A rule without peers matches all sources
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{Ports: networkPolicyPorts(spec.PublicPort)})
+	case len(publicPeers) > 0:
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{From: publicPeers, Ports: networkPolicyPorts(spec.PublicPort)})
+	}
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      networkPolicyName(ais, daemonType),
+			Namespace: ais.Namespace,
+			Labels:    LegacyLabels(ais.Name, daemonType),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: SelectorLabels(ais.Name, daemonType)},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     ingress,
+		},
+	}
+}
+
+func networkPolicyPorts(ports ...intstr.IntOrString) []networkingv1.NetworkPolicyPort {
+	policyPorts := make([]networkingv1.NetworkPolicyPort, 0, len(ports))
+	for i := range ports {
+		policyPorts = append(policyPorts, networkingv1.NetworkPolicyPort{Protocol: aisapc.Ptr(corev1.ProtocolTCP), Port: &ports[i]})
+	}
+	return policyPorts
+}
@@ -33,6 +33,8 @@ const (
 	probeReadinessEndpoint = probeLivenessEndpoint + "?readiness=true"
 )
 
+// newHTTPProbeHandle returns an HTTP GET on the public port, or a TCP check when AIS requires client
+// certificates, since the kubelet cannot present one.
 func newHTTPProbeHandle(ais *aisv1.AIStore, daemonRole, probeEndpoint string) corev1.ProbeHandler {
 	var (
 		httpPort  intstr.IntOrString
@@ -49,6 +51,9 @@ func newHTTPProbeHandle(ais *aisv1.AIStore, daemonRole, probeEndpoint string) co
 	case aisapc.Target:
 		httpPort = ais.Spec.TargetSpec.PublicPort
 	}
+	if ais.UseHTTPS() && ais.RequiresClientCert() {
+		return corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{Port: httpPort}}
+	}
 	return corev1.ProbeHandler{
 		HTTPGet: &corev1.HTTPGetAction{
 			Scheme: uriScheme,
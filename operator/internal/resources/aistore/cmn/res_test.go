@@ -5,6 +5,8 @@
 package cmn
 
 import (
+	"crypto/tls"
+
 	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
@@ -174,4 +176,58 @@ var _ = Describe("Health Probes", Label("short"), func() {
 			Expect(startup.FailureThreshold).To(BeEquivalentTo(defaultStartupFailureThreshold))
 		})
 	})
+
+	Describe("with mTLS", func() {
+		newMTLSAIS := func(clientAuth tls.ClientAuthType) *aisv1.AIStore {
+			ais := newTestAIS()
+			ais.Spec.TLS = &aisv1.TLSSpec{SelfSigned: &aisv1.TLSSelfSignedConfig{}, OperatorClientCertificate: &aisv1.TLSOperatorClientCertificate{}}
+			ais.Spec.ConfigToUpdate = &aisv1.ConfigToUpdate{Net: &aisv1.NetConfToUpdate{HTTP: &aisv1.HTTPConfToUpdate{
+				UseHTTPS:      aisapc.Ptr(true),
+				ClientAuthTLS: aisapc.Ptr(int(clientAuth)),
+			}}}
+			return ais
+		}
+
+		DescribeTable("should check the port over TCP when client certificates are required",
+			func(role string, expectedPort int32) {
+				ais := newMTLSAIS(tls.RequireAndVerifyClientCert)
+				for _, probe := range []*corev1.Probe{
+					NewLivenessProbe(ais, role), NewReadinessProbe(ais, role), NewStartupProbe(ais, role),
+				} {
+					Expect(probe.HTTPGet).To(BeNil())
+					Expect(probe.TCPSocket).NotTo(BeNil())
+					Expect(probe.TCPSocket.Port.IntValue()).To(Equal(int(expectedPort)))
+				}
+			},
+			Entry("proxy", aisapc.Proxy, int32(51080)),
+			Entry("target", aisapc.Target, int32(51081)),
+		)
+
+		It("should keep HTTPS probes when client certificates are optional", func() {
+			probe := NewReadinessProbe(newMTLSAIS(tls.VerifyClientCertIfGiven), aisapc.Proxy)
+			Expect(probe.TCPSocket).To(BeNil())
+			Expect(probe.HTTPGet.Scheme).To(Equal(corev1.URISchemeHTTPS))
+		})
+	})
+})
+
+var _ = Describe("NewServiceSpec", Label("short"), func() {
+	It("should leave IP families to the K8s cluster default when not declared", func() {
+		spec := NewServiceSpec(newTestAIS())
+		Expect(spec.IPFamilies).To(BeEmpty())
+		Expect(spec.IPFamilyPolicy).To(BeNil())
+	})
+
+	DescribeTable("should match the declared IP family policy",
+		func(policy aisv1.IPFamilyPolicy, families []corev1.IPFamily, familyPolicy corev1.IPFamilyPolicy) {
+			ais := newTestAIS()
+			ais.Spec.IPFamilyPolicy = aisapc.Ptr(policy)
+			spec := NewServiceSpec(ais)
+			Expect(spec.IPFamilies).To(Equal(families))
+			Expect(spec.IPFamilyPolicy).To(HaveValue(Equal(familyPolicy)))
+		},
+		Entry("IPv4", aisv1.IPFamilyPolicyIPv4, []corev1.IPFamily{corev1.IPv4Protocol}, corev1.IPFamilyPolicySingleStack),
+		Entry("IPv6", aisv1.IPFamilyPolicyIPv6, []corev1.IPFamily{corev1.IPv6Protocol}, corev1.IPFamilyPolicySingleStack),
+		Entry("DualStack", aisv1.IPFamilyPolicyDualStack, []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}, corev1.IPFamilyPolicyRequireDualStack),
+	)
 })
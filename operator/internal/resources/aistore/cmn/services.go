@@ -1,9 +1,15 @@
 /*
- * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2025-2026, NVIDIA CORPORATION. All rights reserved.
  */
 
 package cmn
 
+import (
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
 func NewServiceLabels(aisName, component string) map[string]string {
 	return map[string]string{
 		LabelApp:               aisName,
@@ -11,3 +17,17 @@ func NewServiceLabels(aisName, component string) map[string]string {
 		LabelComponentPrefixed: component,
 	}
 }
+
+// NewServiceSpec returns the spec of an AIS Service, with the IP families of the cluster network if declared.
+func NewServiceSpec(ais *aisv1.AIStore) *corev1ac.ServiceSpecApplyConfiguration {
+	spec := corev1ac.ServiceSpec()
+	families := ais.IPFamilies()
+	if len(families) == 0 {
+		return spec
+	}
+	policy := corev1.IPFamilyPolicySingleStack
+	if len(families) > 1 {
+		policy = corev1.IPFamilyPolicyRequireDualStack
+	}
+	return spec.WithIPFamilies(families...).WithIPFamilyPolicy(policy)
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	"path"
+	"time"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	StateMigrationPrefix = "state-migration"
+	// StateMigrationPodAnnotation records the pod whose state a copy job migrates
+	StateMigrationPodAnnotation = "state-migration.aistore.nvidia.com/pod"
+
+	// StateCopyDeadline bounds jobs copying the state of a pod
+	StateCopyDeadline = 10 * time.Minute
+
+	stateCopySrcDir = "/state/src"
+	stateCopyDstDir = "/state/dst"
+)
+
+// StateBacking is the volume holding the state of a pod. Both fields are empty for ephemeral state.
+type StateBacking struct {
+	// HostPath is the host directory with the state of the pod
+	HostPath string
+	// Claim is the PVC with the state of the pod
+	Claim string
+}
+
+// IsEphemeral reports whether the state is lost with the pod, so there is nothing to copy from or into.
+func (b StateBacking) IsEphemeral() bool {
+	return b.HostPath == "" && b.Claim == ""
+}
+
+// PodStateBacking returns the state volume of a running pod.
+func PodStateBacking(ais *aisv1.AIStore, pod *corev1.Pod, daeType string) StateBacking {
+	for i := range pod.Spec.Volumes {
+		vol := &pod.Spec.Volumes[i]
+		switch {
+		case vol.Name == stateVolume && vol.HostPath != nil:
+			return StateBacking{HostPath: podStateHostDir(vol.HostPath.Path, pod.Name, daeType)}
+		case vol.Name == getStatePVCName(ais) && vol.PersistentVolumeClaim != nil:
+			return StateBacking{Claim: vol.PersistentVolumeClaim.ClaimName}
+		}
+	}
+	return StateBacking{}
+}
+
+// DesiredStateBacking returns the state volume of a pod with the state storage in the spec.
+func DesiredStateBacking(ais *aisv1.AIStore, podName, daeType string) StateBacking {
+	spec := &ais.Spec
+	switch {
+	case spec.UsesStateEmptyDir():
+		return StateBacking{}
+	case spec.UsesStatePVC():
+		return StateBacking{Claim: StateClaimName(ais, podName)}
+	case spec.UsesStateHostPath():
+		return StateBacking{HostPath: podStateHostDir(StateHostPath(ais, daeType), podName, daeType)}
+	}
+	return StateBacking{}
+}
+
+// NeedsStateMigration reports whether the pod keeps its state in another volume than the one in the spec.
+func NeedsStateMigration(ais *aisv1.AIStore, pod *corev1.Pod, daeType string) bool {
+	return PodStateBacking(ais, pod, daeType) != DesiredStateBacking(ais, pod.Name, daeType)
+}
+
+// podStateHostDir returns the host directory of the pod, matching the sub-path of its state mount
+func podStateHostDir(dir, podName, daeType string) string {
+	if getHostMountSubPath(daeType) == "" {
+		return dir
+	}
+	return path.Join(dir, podName)
+}
+
+// StateClaimName returns the name of the state PVC the StatefulSet creates for the pod.
+func StateClaimName(ais *aisv1.AIStore, podName string) string {
+	return getStatePVCName(ais) + "-" + podName
+}
+
+// NewStateClaim returns the state PVC of the pod, created ahead of the StatefulSet to copy the state into it.
+// The StatefulSet adopts it as it has the name and labels of the claims it creates.
+func NewStateClaim(ais *aisv1.AIStore, podName string, labels map[string]string) *corev1.PersistentVolumeClaim {
+	pvc := DefineStatePVC(ais, ais.Spec.StateStoragePVCStorageClass())
+	pvc.Name = StateClaimName(ais, podName)
+	pvc.Namespace = ais.Namespace
+	pvc.Labels = labels
+	return pvc
+}
+
+// StateClaimTemplatesMatch reports whether both StatefulSets have the same state PVC template, if any.
+// Volume claim templates cannot be updated, so StatefulSets whose template differs must be recreated.
+func StateClaimTemplatesMatch(ais *aisv1.AIStore, current, desired *appsv1.StatefulSet) bool {
+	a, b := findStateClaimTemplate(ais, current), findStateClaimTemplate(ais, desired)
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return equality.Semantic.DeepEqual(a.Spec.StorageClassName, b.Spec.StorageClassName)
+}
+
+func findStateClaimTemplate(ais *aisv1.AIStore, ss *appsv1.StatefulSet) *corev1.PersistentVolumeClaim {
+	for i := range ss.Spec.VolumeClaimTemplates {
+		if ss.Spec.VolumeClaimTemplates[i].Name == getStatePVCName(ais) {
+			return &ss.Spec.VolumeClaimTemplates[i]
+		}
+	}
+	return nil
+}
+
+// StateCopyJobName returns the name of the job copying the state of the pod.
+func StateCopyJobName(podName string) string {
+	return StateMigrationPrefix + "-" + podName
+}
+
+// NewStateCopyJob returns the job copying the state of the pod from its current volume into the new one.
+// It runs on the node of the pod, so both host directories and node-local PVCs are reachable.
+func NewStateCopyJob(ais *aisv1.AIStore, pod *corev1.Pod, src, dst StateBacking) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        StateCopyJobName(pod.Name),
+			Namespace:   ais.Namespace,
+			Labels:      SelectorLabels(ais.Name, StateMigrationPrefix),
+			Annotations: map[string]string{StateMigrationPodAnnotation: pod.Name},
+		},
+		Spec: batchv1.JobSpec{
+			ActiveDeadlineSeconds: aisapc.Ptr(int64(StateCopyDeadline.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Affinity: createNodeAffinitySpec(pod.Spec.NodeName),
+					Containers: []corev1.Container{
+						{
+							Name:    "copy",
+							Image:   HelperImage,
+							Command: []string{"/state-copy", "-src=" + stateCopySrcDir, "-dst=" + stateCopyDstDir},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "src", MountPath: stateCopySrcDir, ReadOnly: true},
+								{Name: "dst", MountPath: stateCopyDstDir},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{Name: "src", VolumeSource: stateBackingVolumeSource(src, corev1.HostPathDirectory)},
+						{Name: "dst", VolumeSource: stateBackingVolumeSource(dst, corev1.HostPathDirectoryOrCreate)},
+					},
+					Tolerations:   pod.Spec.Tolerations,
+					RestartPolicy: corev1.RestartPolicyNever,
+				},
+			},
+		},
+	}
+}
+
+func stateBackingVolumeSource(b StateBacking, hostPathType corev1.HostPathType) corev1.VolumeSource {
+	if b.Claim != "" {
+		return corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: b.Claim},
+		}
+	}
+	return corev1.VolumeSource{
+		HostPath: &corev1.HostPathVolumeSource{Path: b.HostPath, Type: aisapc.Ptr(hostPathType)},
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("StateMigration", Label("short"), func() {
+	var (
+		ais *aisv1.AIStore
+		pod *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		ais = newTestAIS()
+		ais.Name = "ais"
+		ais.Namespace = "ais-ns"
+		ais.Spec.StateStorage = &aisv1.StateStorage{HostPath: &aisv1.StateHostPathConfig{Prefix: "/etc/ais"}}
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "ais-target-0", Namespace: ais.Namespace},
+			Spec: corev1.PodSpec{
+				NodeName:    "node-1",
+				Volumes:     NewAISVolumes(ais, aisapc.Target),
+				Tolerations: []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+			},
+		}
+	})
+
+	It("should find the state of a pod in its host directory", func() {
+		backing := PodStateBacking(ais, pod, aisapc.Target)
+		Expect(backing).To(Equal(StateBacking{HostPath: "/etc/ais/ais-ns/ais/target/ais-target-0"}))
+		Expect(backing).To(Equal(DesiredStateBacking(ais, pod.Name, aisapc.Target)))
+		Expect(NeedsStateMigration(ais, pod, aisapc.Target)).To(BeFalse())
+	})
+
+	It("should migrate a pod to the state PVC created by the statefulset", func() {
+		ais.Spec.StateStorage = &aisv1.StateStorage{PVC: &aisv1.StatePVCConfig{StorageClass: "local-path"}}
+		Expect(DesiredStateBacking(ais, pod.Name, aisapc.Target)).To(Equal(StateBacking{Claim: "ais-ns-ais-state-ais-target-0"}))
+		Expect(NeedsStateMigration(ais, pod, aisapc.Target)).To(BeTrue())
+
+		pvc := NewStateClaim(ais, pod.Name, map[string]string{"app": "ais"})
+		Expect(pvc.Name).To(Equal("ais-ns-ais-state-ais-target-0"))
+		Expect(pvc.Namespace).To(Equal(ais.Namespace))
+		Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("local-path")))
+
+		pod.Spec.Volumes = []corev1.Volume{{
+			Name: getStatePVCName(ais),
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.Name},
+			},
+		}}
+		Expect(NeedsStateMigration(ais, pod, aisapc.Target)).To(BeFalse())
+	})
+
+	It("should keep the shared host directory of proxies", func() {
+		pod.Spec.Volumes = NewAISVolumes(ais, aisapc.Proxy)
+		Expect(PodStateBacking(ais, pod, aisapc.Proxy)).To(Equal(StateBacking{HostPath: "/etc/ais/ais-ns/ais/proxy"}))
+	})
+
+	It("should treat emptyDir state as ephemeral", func() {
+		ais.Spec.StateStorage = &aisv1.StateStorage{EmptyDir: &aisv1.StateEmptyDirConfig{}}
+		pod.Spec.Volumes = NewAISVolumes(ais, aisapc.Target)
+		Expect(PodStateBacking(ais, pod, aisapc.Target).IsEphemeral()).To(BeTrue())
+		Expect(DesiredStateBacking(ais, pod.Name, aisapc.Target).IsEphemeral()).To(BeTrue())
+	})
+
+	It("should copy the state on the node of the pod", func() {
+		src := PodStateBacking(ais, pod, aisapc.Target)
+		dst := StateBacking{Claim: StateClaimName(ais, pod.Name)}
+		job := NewStateCopyJob(ais, pod, src, dst)
+
+		Expect(job.Name).To(Equal("state-migration-ais-target-0"))
+		Expect(job.Annotations).To(HaveKeyWithValue(StateMigrationPodAnnotation, pod.Name))
+		Expect(job.Labels).To(Equal(SelectorLabels(ais.Name, StateMigrationPrefix)))
+		Expect(job.Spec.ActiveDeadlineSeconds).To(HaveValue(BeEquivalentTo(StateCopyDeadline.Seconds())))
+		spec := job.Spec.Template.Spec
+		Expect(spec.Affinity).To(Equal(createNodeAffinitySpec("node-1")))
+		Expect(spec.Tolerations).To(Equal(pod.Spec.Tolerations))
+		Expect(spec.Volumes).To(HaveLen(2))
+		Expect(spec.Volumes[0].HostPath.Path).To(Equal(src.HostPath))
+		Expect(spec.Volumes[1].PersistentVolumeClaim.ClaimName).To(Equal(dst.Claim))
+		Expect(spec.Containers[0].Command).To(Equal([]string{"/state-copy", "-src=/state/src", "-dst=/state/dst"}))
+		Expect(spec.Containers[0].VolumeMounts[0].ReadOnly).To(BeTrue())
+	})
+
+	It("should compare the state PVC templates of statefulsets", func() {
+		newSS := func(storageClass *string) *appsv1.StatefulSet {
+			ss := &appsv1.StatefulSet{}
+			if storageClass != nil {
+				ss.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{*DefineStatePVC(ais, storageClass)}
+			}
+			return ss
+		}
+		Expect(StateClaimTemplatesMatch(ais, newSS(nil), newSS(nil))).To(BeTrue())
+		Expect(StateClaimTemplatesMatch(ais, newSS(aisapc.Ptr("a")), newSS(aisapc.Ptr("a")))).To(BeTrue())
+		Expect(StateClaimTemplatesMatch(ais, newSS(nil), newSS(aisapc.Ptr("a")))).To(BeFalse())
+		Expect(StateClaimTemplatesMatch(ais, newSS(aisapc.Ptr("a")), newSS(nil))).To(BeFalse())
+		Expect(StateClaimTemplatesMatch(ais, newSS(aisapc.Ptr("a")), newSS(aisapc.Ptr("b")))).To(BeFalse())
+	})
+})
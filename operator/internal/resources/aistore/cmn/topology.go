@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	"path"
+	"strings"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// NodeTopologyFileName is the target ConfigMap entry mapping node names to the mountpath label of their domain
+	NodeTopologyFileName = "node_topology.json"
+
+	// TopologyContainerName is the init container labeling mountpaths with the domain of their node
+	TopologyContainerName = "topology"
+)
+
+// NewTopologySpreadConstraints returns a constraint spreading the pods matching labels across the values of each
+// topology key of the cluster. Returns nil when targets are not spread.
+func NewTopologySpreadConstraints(ais *aisv1.AIStore, labels map[string]string) []corev1.TopologySpreadConstraint {
+	spread := ais.Spec.TargetSpec.TopologySpread
+	if spread == nil {
+		return nil
+	}
+	maxSkew := int32(1)
+	if spread.MaxSkew != nil {
+		maxSkew = *spread.MaxSkew
+	}
+	whenUnsatisfiable := corev1.DoNotSchedule
+	if spread.WhenUnsatisfiable != nil {
+		whenUnsatisfiable = *spread.WhenUnsatisfiable
+	}
+	keys := ais.TopologyKeys()
+	constraints := make([]corev1.TopologySpreadConstraint, 0, len(keys))
+	for _, key := range keys {
+		constraints = append(constraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           maxSkew,
+			TopologyKey:       key,
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: labels},
+		})
+	}
+	return constraints
+}
+
+// NodeTopologyLabel returns the mountpath label of the domain of the node, e.g. `zone=us-east-1a,rack=r12`,
+// with the values of the topology keys set on the node. Returns an empty label when the node has none of them.
+func NodeTopologyLabel(spread *aisv1.TopologySpreadSpec, node *corev1.Node) string {
+	var parts []string
+	if spread.ZoneKey != nil {
+		if zone := node.Labels[*spread.ZoneKey]; zone != "" {
+			parts = append(parts, "zone="+zone)
+		}
+	}
+	if spread.RackKey != nil {
+		if rack := node.Labels[*spread.RackKey]; rack != "" {
+			parts = append(parts, "rack="+rack)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// NewTopologyInitContainer returns the init container that waits for the domain of its node in the target
+// ConfigMap and sets it as the label of the mountpaths without one in the AIS local config.
+// Returns nil when mountpaths are not labeled with their domain.
+func NewTopologyInitContainer(ais *aisv1.AIStore) *corev1.Container {
+	if spread := ais.Spec.TargetSpec.TopologySpread; spread == nil || !spread.LabelMountpaths {
+		return nil
+	}
+	return &corev1.Container{
+		Name:            TopologyContainerName,
+		Image:           HelperImage,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"/mount-discovery"},
+		Args: []string{
+			"-mode=label",
+			"-local_config=" + path.Join(AisConfigDir, AISLocalConfigName),
+			"-node_topology=" + path.Join(InitConfTemplateDir, NodeTopologyFileName),
+			"-node=$(" + EnvNodeName + ")",
+		},
+		Env:       []corev1.EnvVar{EnvFromFieldPath(EnvNodeName, "spec.nodeName")},
+		Resources: *NewInitResourceReq(),
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: configTemplateVolume, MountPath: InitConfTemplateDir, ReadOnly: true},
+			{Name: configVolume, MountPath: AisConfigDir},
+		},
+		SecurityContext: RestrictedSecurityContext(),
+	}
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Topology", Label("short"), func() {
+	spread := &aisv1.TopologySpreadSpec{
+		ZoneKey: aisapc.Ptr("topology.kubernetes.io/zone"),
+		RackKey: aisapc.Ptr("example.com/rack"),
+	}
+	node := func(labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: labels}}
+	}
+
+	It("should label mountpaths with the zone and rack of the node", func() {
+		label := NodeTopologyLabel(spread, node(map[string]string{
+			"topology.kubernetes.io/zone": "us-east-1a",
+			"example.com/rack":            "r12",
+		}))
+		Expect(label).To(Equal("zone=us-east-1a,rack=r12"))
+	})
+
+	It("should leave out the keys missing on the node", func() {
+		Expect(NodeTopologyLabel(spread, node(map[string]string{"example.com/rack": "r12"}))).To(Equal("rack=r12"))
+		Expect(NodeTopologyLabel(spread, node(nil))).To(BeEmpty())
+	})
+
+	It("should only add the init container when labeling mountpaths", func() {
+		ais := newTestAIS()
+		Expect(NewTopologyInitContainer(ais)).To(BeNil())
+		ais.Spec.TargetSpec.TopologySpread = spread.DeepCopy()
+		Expect(NewTopologyInitContainer(ais)).To(BeNil())
+		ais.Spec.TargetSpec.TopologySpread.LabelMountpaths = true
+		container := NewTopologyInitContainer(ais)
+		Expect(container).NotTo(BeNil())
+		Expect(container.Args).To(ContainElement("-mode=label"))
+	})
+})
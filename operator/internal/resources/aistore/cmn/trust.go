@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// TrustBundleKey is the key holding the merged CA bundle in the trust bundle ConfigMap
+	TrustBundleKey = "ca.crt"
+	// TrustBundleMountPath is where AIS pods mount the trust bundle
+	TrustBundleMountPath = "/etc/ais/trust-bundle"
+	// TrustBundleHashAnnotation on pod templates holds the hash of the mounted trust bundle. Go loads
+	// trusted CAs once per process, so changing it rolls the pods to pick up a new bundle.
+	TrustBundleHashAnnotation = "trust.aistore.nvidia.com/bundle-hash"
+
+	// EnvSSLCertDir adds the trust bundle to the CAs trusted by aisnode. Go still loads the image's
+	// system CA file, so only the default certificate directories are replaced.
+	EnvSSLCertDir = "SSL_CERT_DIR"
+
+	trustBundleVolume = "trust-bundle"
+)
+
+// TrustBundleConfigMapNSName returns the namespaced name of the ConfigMap holding the merged CA bundle
+func TrustBundleConfigMapNSName(ais *aisv1.AIStore) types.NamespacedName {
+	return types.NamespacedName{Name: ais.TrustBundleConfigMapName(), Namespace: ais.Namespace}
+}
+
+// NewTrustBundleConfigMap stores the CA bundle merged from spec.trust.sources
+func NewTrustBundleConfigMap(ais *aisv1.AIStore, bundle []byte) *corev1.ConfigMap {
+	nn := TrustBundleConfigMapNSName(ais)
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace},
+		Data:       map[string]string{TrustBundleKey: string(bundle)},
+	}
+}
+
+// NewTrustBundleVolume mounts the trust bundle ConfigMap
+func NewTrustBundleVolume(ais *aisv1.AIStore) corev1.Volume {
+	return corev1.Volume{
+		Name: trustBundleVolume,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ais.TrustBundleConfigMapName()},
+				Items:                []corev1.KeyToPath{{Key: TrustBundleKey, Path: TrustBundleKey}},
+				DefaultMode:          &CMDefaultMode,
+			},
+		},
+	}
+}
+
+// TrustBundleEnv returns the environment variables making aisnode trust the bundle
+func TrustBundleEnv(ais *aisv1.AIStore) []corev1.EnvVar {
+	if !ais.UseTrustBundle() {
+		return nil
+	}
+	return []corev1.EnvVar{EnvFromValue(EnvSSLCertDir, TrustBundleMountPath)}
+}
+
+// WithTrustBundleAnnotation adds the hash of the trust bundle to the pod annotations
+func WithTrustBundleAnnotation(ais *aisv1.AIStore, annotations map[string]string) map[string]string {
+	if hash := ais.TrustBundleHash(); ais.UseTrustBundle() && hash != "" {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[TrustBundleHashAnnotation] = hash
+	}
+	return annotations
+}
@@ -6,6 +6,7 @@ package cmn
 
 import (
 	"fmt"
+	"net"
 
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	"github.com/ais-operator/internal/opinfo"
@@ -23,22 +24,29 @@ func ClusterDomain(ais *aisv1.AIStore) string {
 // DefaultProxyURL returns the URL of the proxy that starts out as primary.
 func DefaultProxyURL(ais *aisv1.AIStore) string {
 	// Example: https://ais-proxy-0.ais-proxy.ais.svc.cluster.local:51082
-	return fmt.Sprintf("%s://%s.%s.%s.%s", urlScheme(ais), ais.DefaultPrimaryName(),
-		ais.ProxyStatefulSetName(), ais.Namespace, controlSvcSuffix(ais))
+	host := fmt.Sprintf("%s.%s.%s", ais.DefaultPrimaryName(), ais.ProxyStatefulSetName(), svcDomain(ais))
+	return DaemonURL(ais, host, ais.Spec.ProxySpec.IntraControlPort.String())
 }
 
 // IntraClusterURL returns the URL of the cluster-internal proxy service on the public network.
 func IntraClusterURL(ais *aisv1.AIStore) string {
 	// Example: https://ais-proxy.ais.svc.cluster.local:51080
-	return fmt.Sprintf("%s://%s.%s.%s", urlScheme(ais),
-		ais.ProxyStatefulSetName(), ais.Namespace, publicSvcSuffix(ais))
+	host := fmt.Sprintf("%s.%s", ais.ProxyStatefulSetName(), svcDomain(ais))
+	return DaemonURL(ais, host, ais.Spec.ProxySpec.PublicPort.String())
 }
 
 // DiscoveryProxyURL returns the URL of the proxy service on the intra-control network.
 func DiscoveryProxyURL(ais *aisv1.AIStore) string {
 	// Example: https://ais-proxy.ais.svc.cluster.local:51082
-	return fmt.Sprintf("%s://%s.%s.%s", urlScheme(ais),
-		ais.ProxyStatefulSetName(), ais.Namespace, controlSvcSuffix(ais))
+	host := fmt.Sprintf("%s.%s", ais.ProxyStatefulSetName(), svcDomain(ais))
+	return DaemonURL(ais, host, ais.Spec.ProxySpec.IntraControlPort.String())
+}
+
+// DaemonURL returns the URL of an AIS daemon at the given host and port.
+// IPv6 addresses are enclosed in brackets.
+func DaemonURL(ais *aisv1.AIStore, host, port string) string {
+	// Example: https://[fd00::10]:51080
+	return fmt.Sprintf("%s://%s", urlScheme(ais), net.JoinHostPort(host, port))
 }
 
 func urlScheme(ais *aisv1.AIStore) string {
@@ -48,10 +56,6 @@ func urlScheme(ais *aisv1.AIStore) string {
 	return "http"
 }
 
-func controlSvcSuffix(ais *aisv1.AIStore) string {
-	return fmt.Sprintf("svc.%s:%s", ClusterDomain(ais), ais.Spec.ProxySpec.IntraControlPort.String())
-}
-
-func publicSvcSuffix(ais *aisv1.AIStore) string {
-	return fmt.Sprintf("svc.%s:%s", ClusterDomain(ais), ais.Spec.ProxySpec.PublicPort.String())
+func svcDomain(ais *aisv1.AIStore) string {
+	return fmt.Sprintf("%s.svc.%s", ais.Namespace, ClusterDomain(ais))
 }
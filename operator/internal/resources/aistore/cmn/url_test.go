@@ -76,6 +76,11 @@ var _ = Describe("Proxy URLs", Label("short"), func() {
 			urlTestScheme, ais.ProxyStatefulSetName(), ais.Namespace, urlTestDomain, urlTestPublicPort)))
 	})
 
+	It("should enclose IPv6 addresses in brackets", func() {
+		Expect(DaemonURL(ais, "fd00::10", "51080")).To(Equal(urlTestScheme + "://[fd00::10]:51080"))
+		Expect(DaemonURL(ais, "192.0.2.10", "51080")).To(Equal(urlTestScheme + "://192.0.2.10:51080"))
+	})
+
 	It("should use https when the cluster does", func() {
 		ais.Spec.ConfigToUpdate = &aisv1.ConfigToUpdate{
 			Net: &aisv1.NetConfToUpdate{
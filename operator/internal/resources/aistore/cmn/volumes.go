@@ -65,6 +65,11 @@ func StateHostPath(ais *v1beta1.AIStore, daeType string) string {
 	return path.Join(*prefix, ais.Namespace, ais.Name, daeType)
 }
 
+// TargetHostDataPath returns the host directory holding a cluster's target data under a `useHostPath` mount.
+func TargetHostDataPath(ais *v1beta1.AIStore, mnt *v1beta1.Mount) string {
+	return path.Join(mnt.Path, ais.Namespace, ais.Name, aisapc.Target)
+}
+
 func NewAISVolumes(ais *v1beta1.AIStore, daeType string) []corev1.Volume {
 	volumes := []corev1.Volume{
 		{
@@ -97,6 +102,9 @@ func NewAISVolumes(ais *v1beta1.AIStore, daeType string) []corev1.Volume {
 		},
 		newLogsVolume(ais, daeType),
 	}
+	if len(ais.MultihomeAttachments()) != 0 {
+		volumes = append(volumes, newNetworkStatusVolume())
+	}
 
 	if ais.Spec.UsesStateEmptyDir() {
 		// emptyDir: metadata is ephemeral and local to the pod
@@ -123,6 +131,10 @@ func NewAISVolumes(ais *v1beta1.AIStore, daeType string) []corev1.Volume {
 		volumes = append(volumes, *tlsVol)
 	}
 
+	if ais.UseTrustBundle() {
+		volumes = append(volumes, NewTrustBundleVolume(ais))
+	}
+
 	if ais.Spec.TracingTokenSecretName != nil {
 		volumes = append(volumes, corev1.Volume{
 			Name: tracingSecretVolume,
@@ -164,7 +176,7 @@ func getTLSVolume(ais *v1beta1.AIStore, daeType string) *corev1.Volume {
 	switch {
 	case ais.UseTLSCSI():
 		source = getTLSCSIVolumeSource(ais, daeType)
-	case ais.UseTLSCertificate(), ais.UseTLSSecret():
+	case ais.UseTLSCertificate(), ais.UseTLSSelfSigned(), ais.UseTLSSecret():
 		source = getTLSSecretVolumeSource(ais)
 	default:
 		return nil
@@ -255,6 +267,9 @@ func NewAISVolumeMounts(ais *v1beta1.AIStore, daeType string) []corev1.VolumeMou
 	if spec.TracingTokenSecretName != nil {
 		volumeMounts = AppendSimpleReadOnlyMount(volumeMounts, tracingSecretVolume, tracesDir)
 	}
+	if ais.UseTrustBundle() {
+		volumeMounts = AppendSimpleReadOnlyMount(volumeMounts, trustBundleVolume, TrustBundleMountPath)
+	}
 	return volumeMounts
 }
 
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package proxy
+
+import (
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func PDBNSName(ais *aisv1.AIStore) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      ais.ProxyStatefulSetName(),
+		Namespace: ais.Namespace,
+	}
+}
+
+// QuorumSize returns the number of proxies that must stay available for a majority to elect a primary
+func QuorumSize(ais *aisv1.AIStore) int32 {
+	return ais.GetProxySize()/2 + 1
+}
+
+// NeedsPDB reports whether a proxy PDB can keep a quorum available while still allowing evictions.
+// The majority of one or two proxies is all of them, so their PDB would block every node drain.
+func NeedsPDB(ais *aisv1.AIStore) bool {
+	return QuorumSize(ais) < ais.GetProxySize()
+}
+
+// NewProxyPDB returns the PDB keeping a quorum of proxies available during voluntary disruptions
+func NewProxyPDB(ais *aisv1.AIStore) *policyv1.PodDisruptionBudget {
+	minAvailable := intstr.FromInt32(QuorumSize(ais))
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ais.ProxyStatefulSetName(),
+			Namespace: ais.Namespace,
+			Labels:    BasicLabels(ais),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: SelectorLabels(ais),
+			},
+		},
+	}
+}
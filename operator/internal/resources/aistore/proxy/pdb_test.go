@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package proxy
+
+import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("PDB", Label("short"), func() {
+	DescribeTable("should keep a majority of proxies available",
+		func(size, minAvailable int32) {
+			ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+			ais.Spec.Size = aisapc.Ptr(size)
+			pdb := NewProxyPDB(ais)
+			Expect(pdb.Name).To(Equal("ais-proxy"))
+			Expect(pdb.Spec.MinAvailable).To(HaveValue(Equal(intstr.FromInt32(minAvailable))))
+			Expect(pdb.Spec.Selector.MatchLabels).To(Equal(SelectorLabels(ais)))
+			Expect(NeedsPDB(ais)).To(BeTrue())
+		},
+		Entry("three proxies", int32(3), int32(2)),
+		Entry("four proxies", int32(4), int32(3)),
+		Entry("five proxies", int32(5), int32(3)),
+	)
+
+	DescribeTable("should not be needed when every proxy is part of the majority",
+		func(size int32) {
+			ais := &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+			ais.Spec.Size = aisapc.Ptr(size)
+			Expect(NeedsPDB(ais)).To(BeFalse())
+		},
+		Entry("single proxy", int32(1)),
+		Entry("two proxies", int32(2)),
+	)
+})
@@ -17,6 +17,8 @@ import (
 const (
 	ServiceLabelHeadless = "proxy-svc"
 	ServiceLabelLB       = "proxy-lb"
+	ServiceLabelRoute    = "proxy-route"
+	ServiceLabelNodePort = "proxy-nodeport"
 )
 
 func headlessSVCName(aisName string) string {
@@ -41,6 +43,32 @@ func LoadBalancerSVCNSName(ais *aisv1.AIStore) types.NamespacedName {
 	}
 }
 
+func nodePortSVCName(ais *aisv1.AIStore) string {
+	return ais.Name + "-" + aisapc.Proxy + "-nodeport"
+}
+
+func NodePortSVCNSName(ais *aisv1.AIStore) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      nodePortSVCName(ais),
+		Namespace: ais.Namespace,
+	}
+}
+
+func routeSVCName(ais *aisv1.AIStore) string {
+	return ais.Name + "-" + aisapc.Proxy + "-route"
+}
+
+// NewExternalRoute describes the Gateway API route or Ingress shared by all proxies.
+func NewExternalRoute(ais *aisv1.AIStore) *cmn.ExternalRoute {
+	return &cmn.ExternalRoute{
+		Name:     routeSVCName(ais),
+		Hostname: ais.ExternalHostname(ais.Spec.ProxySpec.ExternalAccess, 0),
+		Labels:   cmn.NewServiceLabels(ais.Name, ServiceLabelRoute),
+		Selector: SelectorLabels(ais),
+		Spec:     &ais.Spec.ProxySpec.ServiceSpec,
+	}
+}
+
 // NewProxyHeadlessSvc creates the apply config for the headless Service fronting proxy pods.
 func NewProxyHeadlessSvc(ais *aisv1.AIStore) *corev1ac.ServiceApplyConfiguration {
 	servicePort := ais.Spec.ProxySpec.ServicePort
@@ -53,7 +81,7 @@ func NewProxyHeadlessSvc(ais *aisv1.AIStore) *corev1ac.ServiceApplyConfiguration
 			"prometheus.io/scrape": "true",
 		}).
 		WithLabels(cmn.NewServiceLabels(ais.Name, ServiceLabelHeadless)).
-		WithSpec(corev1ac.ServiceSpec().
+		WithSpec(cmn.NewServiceSpec(ais).
 			WithClusterIP("None").
 			WithPublishNotReadyAddresses(true).
 			WithPorts(
@@ -80,11 +108,13 @@ func NewProxyHeadlessSvc(ais *aisv1.AIStore) *corev1ac.ServiceApplyConfiguration
 func NewProxyLoadBalancerSVC(ais *aisv1.AIStore) *corev1ac.ServiceApplyConfiguration {
 	servicePort := ais.Spec.ProxySpec.ServicePort
 	publicNetPort := ais.Spec.ProxySpec.PublicPort
+	ea := ais.Spec.ProxySpec.ExternalAccess
 	return corev1ac.Service(loadBalancerSVCName(ais), ais.Namespace).
 		WithOwnerReferences(ownerref.NewControllerRef(ais)).
-		WithAnnotations(cmn.ExternalAccessLBAnnotations(ais.Spec.ProxySpec.ExternalAccess)).
+		WithAnnotations(cmn.ExternalDNSAnnotations(ea, ais.ExternalHostname(ea, 0))).
+		WithAnnotations(cmn.ExternalAccessLBAnnotations(ea)).
 		WithLabels(cmn.NewServiceLabels(ais.Name, ServiceLabelLB)).
-		WithSpec(corev1ac.ServiceSpec().
+		WithSpec(cmn.NewServiceSpec(ais).
 			WithType(corev1.ServiceTypeLoadBalancer).
 			WithPorts(
 				corev1ac.ServicePort().
@@ -96,3 +126,26 @@ func NewProxyLoadBalancerSVC(ais *aisv1.AIStore) *corev1ac.ServiceApplyConfigura
 			WithSelector(SelectorLabels(ais)),
 		)
 }
+
+// NewProxyNodePortSVC exposes all proxies on the node port of their external access spec.
+func NewProxyNodePortSVC(ais *aisv1.AIStore) *corev1ac.ServiceApplyConfiguration {
+	servicePort := ais.Spec.ProxySpec.ServicePort
+	publicNetPort := ais.Spec.ProxySpec.PublicPort
+	ea := ais.Spec.ProxySpec.ExternalAccess
+	return corev1ac.Service(nodePortSVCName(ais), ais.Namespace).
+		WithOwnerReferences(ownerref.NewControllerRef(ais)).
+		WithAnnotations(cmn.ExternalAccessLBAnnotations(ea)).
+		WithLabels(cmn.NewServiceLabels(ais.Name, ServiceLabelNodePort)).
+		WithSpec(cmn.NewServiceSpec(ais).
+			WithType(corev1.ServiceTypeNodePort).
+			WithPorts(
+				corev1ac.ServicePort().
+					WithName("pub").
+					WithProtocol(corev1.ProtocolTCP).
+					WithPort(int32(servicePort.IntValue())).
+					WithTargetPort(publicNetPort).
+					WithNodePort(ea.NodePort.Port),
+			).
+			WithSelector(SelectorLabels(ais)),
+		)
+}
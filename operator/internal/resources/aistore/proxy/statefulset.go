@@ -50,6 +50,8 @@ func NewProxyStatefulSet(ais *aisv1.AIStore, size int32) *apiv1.StatefulSet {
 	basicLabels := BasicLabels(ais)
 	basicLabels[cmn.LabelManagedBy] = cmn.LabelManagedByValue
 	podLabels := cmn.MergePodLabels(ais.Spec.ProxySpec.Labels, basicLabels)
+	podAnnotations := cmn.PrepareAnnotations(ais.Spec.ProxySpec.Annotations, cmn.NetAttachmentAnnotation(ais), aisapc.Ptr(ais.Annotations[cmn.RestartConfigHashAnnotation]))
+	podAnnotations = cmn.WithTrustBundleAnnotation(ais, cmn.WithTLSRestartAnnotation(ais, podAnnotations))
 
 	ss := &apiv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -68,7 +70,7 @@ func NewProxyStatefulSet(ais *aisv1.AIStore, size int32) *apiv1.StatefulSet {
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      podLabels,
-					Annotations: cmn.PrepareAnnotations(ais.Spec.ProxySpec.Annotations, ais.Spec.NetAttachment, aisapc.Ptr(ais.Annotations[cmn.RestartConfigHashAnnotation])),
+					Annotations: podAnnotations,
 				},
 				Spec: *proxyPodSpec(ais),
 			},
@@ -104,7 +106,7 @@ func proxyPodSpec(ais *aisv1.AIStore) *corev1.PodSpec {
 				Image:           ais.Spec.NodeImage,
 				ImagePullPolicy: corev1.PullAlways,
 				Command:         []string{"aisnode"},
-				Args:            cmn.NewAISContainerArgs(ais.GetTargetSize(), aisapc.Proxy),
+				Args:            cmn.NewAISContainerArgs(ais.GetClusterTargetSize(), aisapc.Proxy),
 				Env:             NewAISContainerEnv(ais),
 				Ports:           cmn.NewDaemonPorts(&ais.Spec.ProxySpec),
 				Resources:       *cmn.NewResourceReq(ais, &ais.Spec.ProxySpec.Resources),
@@ -126,6 +128,9 @@ func proxyPodSpec(ais *aisv1.AIStore) *corev1.PodSpec {
 	if ais.Spec.PriorityClassName != nil {
 		spec.PriorityClassName = *ais.Spec.PriorityClassName
 	}
+	if multihome := cmn.NewMultihomeInitContainer(ais); multihome != nil {
+		spec.InitContainers = append(spec.InitContainers, *multihome)
+	}
 	if ais.GetLogSidecarImage() != "" {
 		spec.Containers = append(spec.Containers, cmn.NewLogSidecar(ais, aisapc.Proxy))
 	}
@@ -133,7 +138,7 @@ func proxyPodSpec(ais *aisv1.AIStore) *corev1.PodSpec {
 }
 
 func NewInitContainerEnv(ais *aisv1.AIStore) (initEnv []corev1.EnvVar) {
-	initEnv = cmn.CommonInitEnv(ais, ais.ProxyExternalAccessEnabled())
+	initEnv = cmn.CommonInitEnv(ais, ais.ProxyLoadBalancerEnabled())
 	initEnv = append(initEnv, cmn.EnvFromValue(cmn.EnvServiceName, headlessSVCName(ais.Name)))
 	// Set AIS_PUBLIC_HOSTNAME if listening on hostPort
 	// Without this set, the proxy will resolve its public hostname to the pod IP
@@ -149,6 +154,7 @@ func NewInitContainerEnv(ais *aisv1.AIStore) (initEnv []corev1.EnvVar) {
 
 func NewAISContainerEnv(ais *aisv1.AIStore) []corev1.EnvVar {
 	baseEnv := cmn.CommonEnv()
+	baseEnv = append(baseEnv, cmn.TrustBundleEnv(ais)...)
 	if ais.Spec.AuthNSecretName != nil {
 		baseEnv = append(baseEnv, cmn.EnvFromSecret(aisenv.AisAuthSecretKey, *ais.Spec.AuthNSecretName, cmn.EnvAuthNSecretKey))
 	}
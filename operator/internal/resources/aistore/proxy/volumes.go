@@ -15,8 +15,8 @@ const oidcCAVolumeName = "oidc-ca"
 
 func newVolumes(ais *aisv1.AIStore) []corev1.Volume {
 	volumes := cmn.NewAISVolumes(ais, aisapc.Proxy)
-	if ais.Spec.IssuerCAConfigMap != nil {
-		volumes = append(volumes, newOIDCCAVolume(*ais.Spec.IssuerCAConfigMap))
+	if configMap := ais.OIDCIssuerCAConfigMap(); configMap != nil {
+		volumes = append(volumes, newOIDCCAVolume(*configMap))
 	}
 	return volumes
 }
@@ -38,7 +38,7 @@ func newOIDCCAVolume(configMapName string) corev1.Volume {
 
 func newVolumeMounts(ais *aisv1.AIStore) []corev1.VolumeMount {
 	vm := cmn.NewAISVolumeMounts(ais, aisapc.Proxy)
-	if ais.Spec.IssuerCAConfigMap != nil {
+	if ais.OIDCIssuerCAConfigMap() != nil {
 		vm = append(vm, newOIDCCAVolumeMount())
 	}
 	return vm
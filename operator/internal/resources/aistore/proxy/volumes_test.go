@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package proxy
+
+import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Proxy OIDC issuer CA volume", func() {
+	DescribeTable("mounts the issuer CA ConfigMap",
+		func(spec aisv1.AIStoreSpec, configMap string) {
+			ais := &aisv1.AIStore{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-ais", Namespace: "test-ns"},
+				Spec:       spec,
+			}
+			Expect(newVolumes(ais)).To(ContainElement(newOIDCCAVolume(configMap)))
+			Expect(newVolumeMounts(ais)).To(ContainElement(HaveField("MountPath", cmn.OIDCCAMountPath)))
+		},
+		Entry("from spec.issuerCAConfigMap", aisv1.AIStoreSpec{IssuerCAConfigMap: aisapc.Ptr("issuer-ca")}, "issuer-ca"),
+		Entry("from spec.auth.oidc.caConfigMap", aisv1.AIStoreSpec{Auth: &aisv1.AuthSpec{
+			OIDC: &aisv1.OIDCAuthSpec{Issuers: []string{"https://idp.example.com"}, CAConfigMap: aisapc.Ptr("oidc-ca")},
+		}}, "oidc-ca"),
+	)
+
+	It("does not mount a CA without a ConfigMap", func() {
+		ais := &aisv1.AIStore{Spec: aisv1.AIStoreSpec{Auth: &aisv1.AuthSpec{
+			OIDC: &aisv1.OIDCAuthSpec{Issuers: []string{"https://idp.example.com"}},
+		}}}
+		Expect(newVolumeMounts(ais)).NotTo(ContainElement(HaveField("MountPath", cmn.OIDCCAMountPath)))
+	})
+})
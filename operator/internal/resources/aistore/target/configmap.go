@@ -23,16 +23,36 @@ func ConfigMapNSName(ais *aisv1.AIStore) types.NamespacedName {
 	}
 }
 
-func NewTargetCM(ais *aisv1.AIStore) (*corev1ac.ConfigMapApplyConfiguration, error) {
+// NewTargetCM returns the target ConfigMap. With mount discovery, it also maps each node to its
+// discovered mountpaths, read by the mountpaths init container of the target on that node. With mountpaths
+// labeled by topology, it maps each node to the label of its domain, read by the topology init container.
+func NewTargetCM(ais *aisv1.AIStore, nodeMountpaths map[string][]string, nodeTopology map[string]string) (*corev1ac.ConfigMapApplyConfiguration, error) {
 	localConfStr, err := buildLocalConf(ais)
 	if err != nil {
 		return nil, err
 	}
+	data := map[string]string{
+		cmn.AISLocalConfigName: localConfStr,
+	}
+	if ais.Spec.TargetSpec.MountDiscovery != nil {
+		if nodeMountpaths == nil {
+			nodeMountpaths = map[string][]string{}
+		}
+		if data[cmn.NodeMountpathsFileName], err = jsoniter.MarshalToString(nodeMountpaths); err != nil {
+			return nil, err
+		}
+	}
+	if spread := ais.Spec.TargetSpec.TopologySpread; spread != nil && spread.LabelMountpaths {
+		if nodeTopology == nil {
+			nodeTopology = map[string]string{}
+		}
+		if data[cmn.NodeTopologyFileName], err = jsoniter.MarshalToString(nodeTopology); err != nil {
+			return nil, err
+		}
+	}
 	return corev1ac.ConfigMap(cmn.AISConfigMapName(ais, aisapc.Target), ais.Namespace).
 		WithOwnerReferences(ownerref.NewControllerRef(ais)).
-		WithData(map[string]string{
-			cmn.AISLocalConfigName: localConfStr,
-		}), nil
+		WithData(data), nil
 }
 
 func buildLocalConf(ais *aisv1.AIStore) (string, error) {
@@ -5,10 +5,15 @@
 package target
 
 import (
+	"fmt"
+
+	aiscmn "github.com/NVIDIA/aistore/cmn"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func pdbName(ais *aisv1.AIStore) string {
@@ -22,8 +27,20 @@ func PDBNSName(ais *aisv1.AIStore) types.NamespacedName {
 	}
 }
 
-func NewTargetPDB(ais *aisv1.AIStore) *policyv1.PodDisruptionBudget {
-	maxUnavailable := ais.GetTargetPDBMaxUnavailable()
+func NewTargetPDB(ais *aisv1.AIStore, maxUnavailable intstr.IntOrString) *policyv1.PodDisruptionBudget {
+	selector := &metav1.LabelSelector{MatchLabels: SelectorLabels(ais)}
+	if len(ais.Spec.TargetPools) > 0 {
+		// Targets of pools are covered by the PDBs of their pools, and a pod must not match several PDBs
+		selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key: cmn.LabelTargetPool, Operator: metav1.LabelSelectorOpDoesNotExist,
+		})
+	}
+	if ais.NodeDrainEnabled() {
+		// Targets whose data was rebalanced off their drained node are left out, so only they can be evicted
+		selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key: cmn.LabelDrainReleased, Operator: metav1.LabelSelectorOpDoesNotExist,
+		})
+	}
 	return &policyv1.PodDisruptionBudget{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      pdbName(ais),
@@ -32,9 +49,78 @@ func NewTargetPDB(ais *aisv1.AIStore) *policyv1.PodDisruptionBudget {
 		},
 		Spec: policyv1.PodDisruptionBudgetSpec{
 			MaxUnavailable: &maxUnavailable,
-			Selector: &metav1.LabelSelector{
-				MatchLabels: SelectorLabels(ais),
-			},
+			Selector:       selector,
 		},
 	}
 }
+
+// Redundancy is the erasure coding config protecting objects against the loss of targets.
+// Mirroring is not included, as it only copies objects across the mountpaths of a target.
+type Redundancy struct {
+	ECEnabled    bool
+	ParitySlices int
+}
+
+// RedundancyNeedsLiveConfig reports whether spec.configToUpdate leaves the redundancy to the live cluster config.
+func RedundancyNeedsLiveConfig(ais *aisv1.AIStore) bool {
+	ec := specECConfig(ais)
+	return ec.Enabled == nil || (*ec.Enabled && ec.ParitySlices == nil)
+}
+
+// ResolveRedundancy returns the redundancy set by spec.configToUpdate, with the values it leaves unset taken
+// from the live cluster config, if any.
+func ResolveRedundancy(ais *aisv1.AIStore, live *aiscmn.ClusterConfig) Redundancy {
+	var r Redundancy
+	if live != nil {
+		r = Redundancy{ECEnabled: live.EC.Enabled, ParitySlices: live.EC.ParitySlices}
+	}
+	ec := specECConfig(ais)
+	if ec.Enabled != nil {
+		r.ECEnabled = *ec.Enabled
+	}
+	if ec.ParitySlices != nil {
+		r.ParitySlices = *ec.ParitySlices
+	}
+	return r
+}
+
+func specECConfig(ais *aisv1.AIStore) *aisv1.ECConfToUpdate {
+	if ais.Spec.ConfigToUpdate == nil || ais.Spec.ConfigToUpdate.EC == nil {
+		return &aisv1.ECConfToUpdate{}
+	}
+	return ais.Spec.ConfigToUpdate.EC
+}
+
+// TolerableUnavailable returns the number of targets that can be unavailable with every object still readable
+func (r Redundancy) TolerableUnavailable() int32 {
+	if !r.ECEnabled || r.ParitySlices < 0 {
+		return 0
+	}
+	return int32(r.ParitySlices)
+}
+
+func (r Redundancy) String() string {
+	if !r.ECEnabled {
+		return "erasure coding disabled"
+	}
+	return fmt.Sprintf("erasure coding with %d parity slices", r.ParitySlices)
+}
+
+// AutoPDBMaxUnavailable returns the maximum number of unavailable targets allowed by the redundancy, capped by
+// the maxUnavailable of the PDB spec if set.
+// With target pools, each PDB only gets a share of the redundancy proportional to its targets, rounded down, so
+// the PDBs of all pools together never allow more unavailable targets than the redundancy tolerates.
+func AutoPDBMaxUnavailable(ais *aisv1.AIStore, r Redundancy) intstr.IntOrString {
+	maxUnavailable := int(r.TolerableUnavailable())
+	if total := ais.GetClusterTargetSize(); total > ais.GetTargetSize() {
+		maxUnavailable = maxUnavailable * int(ais.GetTargetSize()) / int(total)
+	}
+	if limit := ais.Spec.TargetSpec.PodDisruptionBudget.MaxUnavailable; limit != nil {
+		// Percentages are rounded down, as for the disruptions allowed by the PDB itself
+		capped, err := intstr.GetScaledValueFromIntOrPercent(limit, int(ais.GetTargetSize()), false)
+		if err == nil && capped < maxUnavailable {
+			maxUnavailable = capped
+		}
+	}
+	return intstr.FromInt32(int32(maxUnavailable))
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package target
+
+import (
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	aiscmn "github.com/NVIDIA/aistore/cmn"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("PDB", Label("short"), func() {
+	var ais *aisv1.AIStore
+
+	BeforeEach(func() {
+		ais = &aisv1.AIStore{ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"}}
+		ais.Spec.Size = aisapc.Ptr(int32(10))
+		ais.Spec.TargetSpec.PodDisruptionBudget = &aisv1.PDBSpec{Enabled: true, Mode: aisapc.Ptr(aisv1.PDBModeAuto)}
+	})
+
+	It("should create the PDB with the given maxUnavailable", func() {
+		pdb := NewTargetPDB(ais, intstr.FromInt32(2))
+		Expect(pdb.Name).To(Equal("ais-target"))
+		Expect(pdb.Spec.MaxUnavailable).To(HaveValue(Equal(intstr.FromInt32(2))))
+		Expect(pdb.Spec.Selector.MatchLabels).To(Equal(SelectorLabels(ais)))
+	})
+
+	It("should leave the targets of pools to the PDBs of their pools", func() {
+		ais.Spec.TargetPools = []aisv1.TargetPoolSpec{{Name: "nvme", Size: 2}}
+		pdb := NewTargetPDB(ais, intstr.FromInt32(1))
+		Expect(pdb.Spec.Selector.MatchExpressions).To(ConsistOf(metav1.LabelSelectorRequirement{
+			Key: cmn.LabelTargetPool, Operator: metav1.LabelSelectorOpDoesNotExist,
+		}))
+
+		poolPDB := NewTargetPDB(ais.ForTargetPool(&ais.Spec.TargetPools[0]), intstr.FromInt32(1))
+		Expect(poolPDB.Name).To(Equal("ais-target-nvme"))
+		Expect(poolPDB.Spec.Selector.MatchLabels).To(HaveKeyWithValue(cmn.LabelTargetPool, "nvme"))
+		Expect(poolPDB.Spec.Selector.MatchExpressions).To(BeEmpty())
+	})
+
+	It("should leave out targets released after a node drain", func() {
+		ais.Spec.TargetSpec.NodeDrain = &aisv1.NodeDrainSpec{}
+		ais.Spec.TargetPools = []aisv1.TargetPoolSpec{{Name: "nvme", Size: 2}}
+		released := metav1.LabelSelectorRequirement{Key: cmn.LabelDrainReleased, Operator: metav1.LabelSelectorOpDoesNotExist}
+		pdb := NewTargetPDB(ais, intstr.FromInt32(0))
+		Expect(pdb.Spec.Selector.MatchExpressions).To(ConsistOf(metav1.LabelSelectorRequirement{
+			Key: cmn.LabelTargetPool, Operator: metav1.LabelSelectorOpDoesNotExist,
+		}, released))
+		poolPDB := NewTargetPDB(ais.ForTargetPool(&ais.Spec.TargetPools[0]), intstr.FromInt32(0))
+		Expect(poolPDB.Spec.Selector.MatchExpressions).To(ConsistOf(released))
+	})
+
+	It("should read the redundancy missing from the spec in the live config", func() {
+		Expect(RedundancyNeedsLiveConfig(ais)).To(BeTrue())
+		live := &aiscmn.ClusterConfig{}
+		live.EC.Enabled, live.EC.ParitySlices = true, 2
+		Expect(ResolveRedundancy(ais, live)).To(Equal(Redundancy{ECEnabled: true, ParitySlices: 2}))
+		Expect(ResolveRedundancy(ais, nil)).To(Equal(Redundancy{}))
+
+		ais.Spec.ConfigToUpdate = &aisv1.ConfigToUpdate{EC: &aisv1.ECConfToUpdate{Enabled: aisapc.Ptr(true)}}
+		Expect(RedundancyNeedsLiveConfig(ais)).To(BeTrue())
+		ais.Spec.ConfigToUpdate.EC.ParitySlices = aisapc.Ptr(3)
+		Expect(RedundancyNeedsLiveConfig(ais)).To(BeFalse())
+		Expect(ResolveRedundancy(ais, live)).To(Equal(Redundancy{ECEnabled: true, ParitySlices: 3}))
+
+		ais.Spec.ConfigToUpdate.EC = &aisv1.ECConfToUpdate{Enabled: aisapc.Ptr(false)}
+		Expect(RedundancyNeedsLiveConfig(ais)).To(BeFalse())
+		Expect(ResolveRedundancy(ais, live).TolerableUnavailable()).To(BeZero())
+	})
+
+	DescribeTable("should allow as many unavailable targets as parity slices",
+		func(r Redundancy, limit *intstr.IntOrString, expected int32) {
+			ais.Spec.TargetSpec.PodDisruptionBudget.MaxUnavailable = limit
+			Expect(AutoPDBMaxUnavailable(ais, r)).To(Equal(intstr.FromInt32(expected)))
+		},
+		Entry("erasure coding disabled", Redundancy{ParitySlices: 2}, nil, int32(0)),
+		Entry("parity slices", Redundancy{ECEnabled: true, ParitySlices: 2}, nil, int32(2)),
+		Entry("capped by maxUnavailable", Redundancy{ECEnabled: true, ParitySlices: 4}, aisapc.Ptr(intstr.FromInt32(1)), int32(1)),
+		Entry("capped by a percentage", Redundancy{ECEnabled: true, ParitySlices: 4}, aisapc.Ptr(intstr.FromString("25%")), int32(2)),
+		Entry("below the cap", Redundancy{ECEnabled: true, ParitySlices: 1}, aisapc.Ptr(intstr.FromInt32(3)), int32(1)),
+	)
+
+	It("should split the redundancy across the PDBs of the target pools", func() {
+		ais.Spec.Size = aisapc.Ptr(int32(6))
+		ais.Spec.TargetPools = []aisv1.TargetPoolSpec{{Name: "nvme", Size: 3}, {Name: "hdd", Size: 3}}
+		r := Redundancy{ECEnabled: true, ParitySlices: 4}
+		Expect(AutoPDBMaxUnavailable(ais, r)).To(Equal(intstr.FromInt32(2)))
+		Expect(AutoPDBMaxUnavailable(ais.ForTargetPool(&ais.Spec.TargetPools[0]), r)).To(Equal(intstr.FromInt32(1)))
+		Expect(AutoPDBMaxUnavailable(ais.ForTargetPool(&ais.Spec.TargetPools[1]), r)).To(Equal(intstr.FromInt32(1)))
+	})
+})
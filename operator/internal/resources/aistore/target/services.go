@@ -7,7 +7,6 @@ package target
 import (
 	"fmt"
 
-	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	"github.com/ais-operator/internal/resources/aistore/cmn"
 	"github.com/ais-operator/internal/resources/ownerref"
@@ -19,15 +18,17 @@ import (
 const (
 	ServiceLabelHeadless = "target-svc"
 	ServiceLabelLB       = "target-lb"
+	ServiceLabelRoute    = "target-route"
+	ServiceLabelNodePort = "target-nodeport"
 )
 
-func headlessSVCName(aisName string) string {
-	return aisName + "-" + aisapc.Target
+func headlessSVCName(ais *aisv1.AIStore) string {
+	return statefulSetName(ais)
 }
 
 func HeadlessSVCNSName(ais *aisv1.AIStore) types.NamespacedName {
 	return types.NamespacedName{
-		Name:      headlessSVCName(ais.Name),
+		Name:      headlessSVCName(ais),
 		Namespace: ais.Namespace,
 	}
 }
@@ -43,6 +44,17 @@ func LoadBalancerSVCNSName(ais *aisv1.AIStore, index int32) types.NamespacedName
 	}
 }
 
+func nodePortSVCName(ais *aisv1.AIStore) string {
+	return statefulSetName(ais) + "-nodeport"
+}
+
+func NodePortSVCNSName(ais *aisv1.AIStore) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      nodePortSVCName(ais),
+		Namespace: ais.Namespace,
+	}
+}
+
 func PodName(ais *aisv1.AIStore, index int32) string {
 	return fmt.Sprintf("%s-%d", statefulSetName(ais), index)
 }
@@ -51,13 +63,13 @@ func NewTargetHeadlessSvc(ais *aisv1.AIStore) *corev1ac.ServiceApplyConfiguratio
 	servicePort := ais.Spec.TargetSpec.ServicePort
 	controlPort := ais.Spec.TargetSpec.IntraControlPort
 	dataPort := ais.Spec.TargetSpec.IntraDataPort
-	return corev1ac.Service(headlessSVCName(ais.Name), ais.Namespace).
+	return corev1ac.Service(headlessSVCName(ais), ais.Namespace).
 		WithOwnerReferences(ownerref.NewControllerRef(ais)).
 		WithAnnotations(map[string]string{
 			"prometheus.io/scrape": "true",
 		}).
 		WithLabels(cmn.NewServiceLabels(ais.Name, ServiceLabelHeadless)).
-		WithSpec(corev1ac.ServiceSpec().
+		WithSpec(cmn.NewServiceSpec(ais).
 			WithClusterIP("None").
 			WithPublishNotReadyAddresses(true).
 			WithPorts(
@@ -86,11 +98,13 @@ func NewTargetLoadBalancerSVC(ais *aisv1.AIStore, targetIndex int32) *corev1ac.S
 	publicNetPort := ais.Spec.TargetSpec.PublicPort
 	selectors := SelectorLabels(ais)
 	selectors["statefulset.kubernetes.io/pod-name"] = PodName(ais, targetIndex)
+	ea := ais.Spec.TargetSpec.ExternalAccess
 	return corev1ac.Service(loadBalancerSVCName(ais, targetIndex), ais.Namespace).
 		WithOwnerReferences(ownerref.NewControllerRef(ais)).
-		WithAnnotations(cmn.ExternalAccessLBAnnotations(ais.Spec.TargetSpec.ExternalAccess)).
+		WithAnnotations(cmn.ExternalDNSAnnotations(ea, ais.ExternalHostname(ea, targetIndex))).
+		WithAnnotations(cmn.ExternalAccessLBAnnotations(ea)).
 		WithLabels(cmn.NewServiceLabels(ais.Name, ServiceLabelLB)).
-		WithSpec(corev1ac.ServiceSpec().
+		WithSpec(cmn.NewServiceSpec(ais).
 			WithType(corev1.ServiceTypeLoadBalancer).
 			WithPorts(
 				corev1ac.ServicePort().
@@ -114,3 +128,47 @@ func LoadBalancerSVCList(ais *aisv1.AIStore, first, size int32) []*corev1ac.Serv
 	}
 	return svcs
 }
+
+// NewTargetNodePortSVC exposes all targets on the node port of their external access spec.
+// Traffic is kept on the receiving node, so the node port of each node reaches the target it runs
+// and every target can advertise the IP of its node with the same port.
+func NewTargetNodePortSVC(ais *aisv1.AIStore) *corev1ac.ServiceApplyConfiguration {
+	servicePort := ais.Spec.TargetSpec.ServicePort
+	publicNetPort := ais.Spec.TargetSpec.PublicPort
+	ea := ais.Spec.TargetSpec.ExternalAccess
+	return corev1ac.Service(nodePortSVCName(ais), ais.Namespace).
+		WithOwnerReferences(ownerref.NewControllerRef(ais)).
+		WithAnnotations(cmn.ExternalAccessLBAnnotations(ea)).
+		WithLabels(cmn.NewServiceLabels(ais.Name, ServiceLabelNodePort)).
+		WithSpec(cmn.NewServiceSpec(ais).
+			WithType(corev1.ServiceTypeNodePort).
+			WithExternalTrafficPolicy(corev1.ServiceExternalTrafficPolicyLocal).
+			WithPorts(
+				corev1ac.ServicePort().
+					WithName("pub").
+					WithProtocol(corev1.ProtocolTCP).
+					WithPort(int32(servicePort.IntValue())).
+					WithTargetPort(publicNetPort).
+					WithNodePort(ea.NodePort.Port),
+			).
+			WithSelector(SelectorLabels(ais)),
+		)
+}
+
+// NewExternalRouteList describes the Gateway API route or Ingress of each target pod.
+func NewExternalRouteList(ais *aisv1.AIStore) []*cmn.ExternalRoute {
+	size := ais.GetTargetSize()
+	routes := make([]*cmn.ExternalRoute, 0, size)
+	for i := range size {
+		selectors := SelectorLabels(ais)
+		selectors["statefulset.kubernetes.io/pod-name"] = PodName(ais, i)
+		routes = append(routes, &cmn.ExternalRoute{
+			Name:     PodName(ais, i) + "-route",
+			Hostname: ais.ExternalHostname(ais.Spec.TargetSpec.ExternalAccess, i),
+			Labels:   cmn.NewServiceLabels(ais.Name, ServiceLabelRoute),
+			Selector: selectors,
+			Spec:     &ais.Spec.TargetSpec.ServiceSpec,
+		})
+	}
+	return routes
+}
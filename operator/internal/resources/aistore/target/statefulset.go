@@ -6,6 +6,7 @@ package target
 
 import (
 	"path/filepath"
+	"strconv"
 
 	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
@@ -16,7 +17,11 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// statefulSetName returns the name of the StatefulSet of the targets, suffixed with their pool if any
 func statefulSetName(ais *aisv1.AIStore) string {
+	if pool := ais.Spec.TargetSpec.Pool; pool != "" {
+		return ais.Name + "-" + aisapc.Target + "-" + pool
+	}
 	return ais.Name + "-" + aisapc.Target
 }
 
@@ -30,18 +35,28 @@ func StatefulSetNSName(ais *aisv1.AIStore) types.NamespacedName {
 // BasicLabels defines labels for target pods and statefulset
 // Includes legacy labels for compatibility with older StatefulSets that may still select on
 // non-prefixed 'app' and 'component' labels
+// Targets of a pool are also labeled with their pool, so they are selected apart from the other targets.
 func BasicLabels(ais *aisv1.AIStore) map[string]string {
-	return cmn.LegacyLabels(ais.Name, aisapc.Target)
+	return withPoolLabel(ais, cmn.LegacyLabels(ais.Name, aisapc.Target))
 }
 
 func SelectorLabels(ais *aisv1.AIStore) map[string]string {
-	return cmn.SelectorLabels(ais.Name, aisapc.Target)
+	return withPoolLabel(ais, cmn.SelectorLabels(ais.Name, aisapc.Target))
+}
+
+func withPoolLabel(ais *aisv1.AIStore, labels map[string]string) map[string]string {
+	if pool := ais.Spec.TargetSpec.Pool; pool != "" {
+		labels[cmn.LabelTargetPool] = pool
+	}
+	return labels
 }
 
 func NewTargetSS(ais *aisv1.AIStore, expectedSize int32) *apiv1.StatefulSet {
 	basicLabels := BasicLabels(ais)
 	basicLabels[cmn.LabelManagedBy] = cmn.LabelManagedByValue
 	podLabels := cmn.MergePodLabels(ais.Spec.TargetSpec.Labels, basicLabels)
+	podAnnotations := cmn.PrepareAnnotations(ais.Spec.TargetSpec.Annotations, cmn.NetAttachmentAnnotation(ais), aisapc.Ptr(ais.Annotations[cmn.RestartConfigHashAnnotation]))
+	podAnnotations = cmn.WithTrustBundleAnnotation(ais, cmn.WithTLSRestartAnnotation(ais, podAnnotations))
 
 	ss := &apiv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -54,7 +69,7 @@ func NewTargetSS(ais *aisv1.AIStore, expectedSize int32) *apiv1.StatefulSet {
 			Selector: &metav1.LabelSelector{
 				MatchLabels: SelectorLabels(ais),
 			},
-			ServiceName:         headlessSVCName(ais.Name),
+			ServiceName:         headlessSVCName(ais),
 			PodManagementPolicy: apiv1.ParallelPodManagement,
 			Replicas:            &expectedSize,
 			UpdateStrategy: apiv1.StatefulSetUpdateStrategy{
@@ -64,7 +79,7 @@ func NewTargetSS(ais *aisv1.AIStore, expectedSize int32) *apiv1.StatefulSet {
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      podLabels,
-					Annotations: cmn.PrepareAnnotations(ais.Spec.TargetSpec.Annotations, ais.Spec.NetAttachment, aisapc.Ptr(ais.Annotations[cmn.RestartConfigHashAnnotation])),
+					Annotations: podAnnotations,
 				},
 				Spec: *targetPodSpec(ais),
 			},
@@ -76,6 +91,8 @@ func NewTargetSS(ais *aisv1.AIStore, expectedSize int32) *apiv1.StatefulSet {
 	return ss
 }
 
+// targetPodSpec builds the pod spec of the targets. The anti-affinity selects the targets of all pools, as
+// they may use the same host paths and ports.
 func targetPodSpec(ais *aisv1.AIStore) *corev1.PodSpec {
 	spec := &corev1.PodSpec{
 		InitContainers: []corev1.Container{
@@ -107,19 +124,31 @@ func targetPodSpec(ais *aisv1.AIStore) *corev1.PodSpec {
 				ReadinessProbe:  cmn.NewReadinessProbe(ais, aisapc.Target),
 			},
 		},
-		HostNetwork:        ais.UseHostNetwork(),
-		DNSPolicy:          ais.GetTargetDNSPolicy(),
-		ServiceAccountName: cmn.ServiceAccountName(ais),
-		SecurityContext:    cmn.GetPodSecurityContext(&ais.Spec.TargetSpec.DaemonSpec),
-		Affinity:           createTargetAffinity(ais, SelectorLabels(ais)),
-		NodeSelector:       ais.Spec.TargetSpec.NodeSelector,
-		Volumes:            newVolumes(ais),
-		Tolerations:        ais.Spec.TargetSpec.Tolerations,
+		HostNetwork:               ais.UseHostNetwork(),
+		DNSPolicy:                 ais.GetTargetDNSPolicy(),
+		ServiceAccountName:        cmn.ServiceAccountName(ais),
+		SecurityContext:           cmn.GetPodSecurityContext(&ais.Spec.TargetSpec.DaemonSpec),
+		Affinity:                  createTargetAffinity(ais, cmn.SelectorLabels(ais.Name, aisapc.Target)),
+		TopologySpreadConstraints: cmn.NewTopologySpreadConstraints(ais, SelectorLabels(ais)),
+		NodeSelector:              ais.Spec.TargetSpec.NodeSelector,
+		Volumes:                   newVolumes(ais),
+		Tolerations:               ais.Spec.TargetSpec.Tolerations,
 	}
 	// Apply priority class if specified to prevent eviction during node pressure
 	if ais.Spec.PriorityClassName != nil {
 		spec.PriorityClassName = *ais.Spec.PriorityClassName
 	}
+	// Mountpaths are added before multihome so both helpers see the config written by the init container,
+	// and labeled with their domain once discovered
+	if mountpaths := cmn.NewMountpathsInitContainer(ais); mountpaths != nil {
+		spec.InitContainers = append(spec.InitContainers, *mountpaths)
+	}
+	if topology := cmn.NewTopologyInitContainer(ais); topology != nil {
+		spec.InitContainers = append(spec.InitContainers, *topology)
+	}
+	if multihome := cmn.NewMultihomeInitContainer(ais); multihome != nil {
+		spec.InitContainers = append(spec.InitContainers, *multihome)
+	}
 	if ais.GetLogSidecarImage() != "" {
 		spec.Containers = append(spec.Containers, cmn.NewLogSidecar(ais, aisapc.Target))
 	}
@@ -127,8 +156,10 @@ func targetPodSpec(ais *aisv1.AIStore) *corev1.PodSpec {
 }
 
 func NewInitContainerEnv(ais *aisv1.AIStore) (initEnv []corev1.EnvVar) {
-	initEnv = cmn.CommonInitEnv(ais, ais.TargetExternalAccessEnabled())
-	initEnv = append(initEnv, cmn.EnvFromValue(cmn.EnvServiceName, headlessSVCName(ais.Name)))
+	ea := ais.Spec.TargetSpec.ExternalAccess
+	// Targets advertising a hostname must not fall back to the address of their LoadBalancer
+	initEnv = cmn.CommonInitEnv(ais, ais.TargetLoadBalancerEnabled() && !ea.AdvertisesHostname())
+	initEnv = append(initEnv, cmn.EnvFromValue(cmn.EnvServiceName, headlessSVCName(ais)))
 	if ais.Spec.TargetSpec.HostPort != nil && !ais.TargetExternalAccessEnabled() {
 		if ais.UseNodeNameForPublicNet() {
 			initEnv = append(initEnv, cmn.EnvFromFieldPath(cmn.EnvPublicHostname, "spec.nodeName"))
@@ -136,6 +167,10 @@ func NewInitContainerEnv(ais *aisv1.AIStore) (initEnv []corev1.EnvVar) {
 			initEnv = append(initEnv, cmn.EnvFromFieldPath(cmn.EnvPublicHostname, "status.hostIP"))
 		}
 	}
+	// Redirects to targets must resolve to their routes or external-dns hostnames
+	if ea.AdvertisesHostname() {
+		initEnv = append(initEnv, cmn.PublicHostnameEnv(ais, ea)...)
+	}
 	if ais.UseHostNetwork() {
 		initEnv = append(initEnv, cmn.EnvFromValue(cmn.EnvHostNetwork, "true"))
 	}
@@ -144,6 +179,15 @@ func NewInitContainerEnv(ais *aisv1.AIStore) (initEnv []corev1.EnvVar) {
 
 func NewAISContainerEnv(ais *aisv1.AIStore) []corev1.EnvVar {
 	baseEnv := cmn.CommonEnv()
+	baseEnv = append(baseEnv, cmn.TrustBundleEnv(ais)...)
+	// Read by aisnode rather than the init container: targets exposed on a node port
+	// advertise the IP of their node with that port instead of their listening port
+	if ea := ais.Spec.TargetSpec.ExternalAccess; ea.UsesNodePort() {
+		baseEnv = append(baseEnv,
+			cmn.EnvFromFieldPath(cmn.EnvTargetHostIP, "status.hostIP"),
+			cmn.EnvFromValue(cmn.EnvTargetHostPort, strconv.Itoa(int(ea.NodePort.Port))),
+		)
+	}
 	if ais.Spec.HasGCPBackend() {
 		baseEnv = append(baseEnv, cmn.EnvFromValue(cmn.EnvGoogleCreds, filepath.Join(DefaultGCPDir, DefaultGCPConfig)))
 	}
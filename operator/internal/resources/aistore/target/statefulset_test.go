@@ -106,6 +106,118 @@ var _ = Describe("Statefulset Target Volumes and Mounts", Label("short"), func()
 			// config-mount,logs,state,data,data
 			Expect(result.Spec.Template.Spec.Containers[0].VolumeMounts).To(HaveLen(5))
 		})
+		It("should select the operator-created local PVs of the mount", func() {
+			specCopy := aisSpec.DeepCopy()
+			specCopy.Spec.TargetSpec.Mounts = []aisv1.Mount{{
+				Path:         "/data/test",
+				Size:         &size,
+				StorageClass: apc.Ptr("local-storage"),
+				LocalPV:      apc.Ptr(true),
+			}}
+			result := NewTargetSS(specCopy, *specCopy.Spec.Size)
+			Expect(result.Spec.VolumeClaimTemplates[0].Spec.Selector).To(Equal(&metav1.LabelSelector{
+				MatchLabels: cmn.LocalPVLabels(specCopy, &specCopy.Spec.TargetSpec.Mounts[0]),
+			}))
+		})
+	})
+	Describe("New Target with mountDiscovery", func() {
+		It("should add the discovered mountpaths before the multihome addresses", func() {
+			specCopy := aisSpec.DeepCopy()
+			specCopy.Spec.TargetSpec.MountDiscovery = &aisv1.MountDiscoverySpec{Root: "/ais"}
+			specCopy.Spec.Multihome = &aisv1.MultihomeSpec{Attachments: []aisv1.NetworkAttachment{
+				{Name: "public-net", Usage: []aisv1.NetworkUsage{aisv1.NetworkUsagePublic}},
+			}}
+			result := NewTargetSS(specCopy, *specCopy.Spec.Size)
+			initContainers := result.Spec.Template.Spec.InitContainers
+			Expect(initContainers).To(HaveLen(3))
+			Expect(initContainers[1].Name).To(Equal(cmn.MountpathsContainerName))
+			Expect(initContainers[2].Name).To(Equal(cmn.MultihomeContainerName))
+			Expect(result.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(HaveField("MountPath", "/ais")))
+		})
+		It("should map nodes to their mountpaths in the ConfigMap", func() {
+			specCopy := aisSpec.DeepCopy()
+			cm, err := NewTargetCM(specCopy, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cm.Data).NotTo(HaveKey(cmn.NodeMountpathsFileName))
+
+			specCopy.Spec.TargetSpec.MountDiscovery = &aisv1.MountDiscoverySpec{Root: "/ais"}
+			cm, err = NewTargetCM(specCopy, map[string][]string{"node-1": {"/ais/nvme0n1"}}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cm.Data).To(HaveKeyWithValue(cmn.NodeMountpathsFileName, `{"node-1":["/ais/nvme0n1"]}`))
+		})
+	})
+	Describe("New Target with topologySpread", func() {
+		It("should spread targets across zones and racks", func() {
+			specCopy := aisSpec.DeepCopy()
+			specCopy.Spec.TargetSpec.TopologySpread = &aisv1.TopologySpreadSpec{
+				ZoneKey:           apc.Ptr("topology.kubernetes.io/zone"),
+				RackKey:           apc.Ptr("example.com/rack"),
+				WhenUnsatisfiable: apc.Ptr(v1.ScheduleAnyway),
+			}
+			result := NewTargetSS(specCopy, *specCopy.Spec.Size)
+			constraints := result.Spec.Template.Spec.TopologySpreadConstraints
+			Expect(constraints).To(HaveLen(2))
+			Expect(constraints[0].TopologyKey).To(Equal("topology.kubernetes.io/zone"))
+			Expect(constraints[1].TopologyKey).To(Equal("example.com/rack"))
+			for _, constraint := range constraints {
+				Expect(constraint.MaxSkew).To(Equal(int32(1)))
+				Expect(constraint.WhenUnsatisfiable).To(Equal(v1.ScheduleAnyway))
+				Expect(constraint.LabelSelector.MatchLabels).To(Equal(SelectorLabels(specCopy)))
+			}
+			Expect(result.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+		})
+		It("should label mountpaths after discovering them", func() {
+			specCopy := aisSpec.DeepCopy()
+			specCopy.Spec.TargetSpec.MountDiscovery = &aisv1.MountDiscoverySpec{Root: "/ais"}
+			specCopy.Spec.TargetSpec.TopologySpread = &aisv1.TopologySpreadSpec{
+				ZoneKey:         apc.Ptr("topology.kubernetes.io/zone"),
+				LabelMountpaths: true,
+			}
+			result := NewTargetSS(specCopy, *specCopy.Spec.Size)
+			initContainers := result.Spec.Template.Spec.InitContainers
+			Expect(initContainers).To(HaveLen(3))
+			Expect(initContainers[1].Name).To(Equal(cmn.MountpathsContainerName))
+			Expect(initContainers[2].Name).To(Equal(cmn.TopologyContainerName))
+
+			cm, err := NewTargetCM(specCopy, nil, map[string]string{"node-1": "zone=us-east-1a"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cm.Data).To(HaveKeyWithValue(cmn.NodeTopologyFileName, `{"node-1":"zone=us-east-1a"}`))
+		})
+	})
+	Describe("New Target of a pool", func() {
+		It("should name its resources after the pool and select only its targets", func() {
+			specCopy := aisSpec.DeepCopy()
+			specCopy.Spec.TargetSpec.Mounts = []aisv1.Mount{{Path: "/ais/hdd", Size: &size}}
+			specCopy.Spec.TargetPools = []aisv1.TargetPoolSpec{{
+				Name:         "nvme",
+				Size:         2,
+				Mounts:       []aisv1.Mount{{Path: "/ais/nvme", Size: &size}},
+				NodeSelector: map[string]string{"disk": "nvme"},
+			}}
+			pool := specCopy.ForTargetPool(&specCopy.Spec.TargetPools[0])
+			result := NewTargetSS(pool, pool.GetTargetSize())
+			Expect(result.Name).To(Equal("test-ais-target-nvme"))
+			Expect(result.Spec.ServiceName).To(Equal("test-ais-target-nvme"))
+			Expect(*result.Spec.Replicas).To(Equal(int32(2)))
+			Expect(result.Spec.Selector.MatchLabels).To(HaveKeyWithValue(cmn.LabelTargetPool, "nvme"))
+			Expect(result.Spec.Template.Labels).To(HaveKeyWithValue(cmn.LabelTargetPool, "nvme"))
+			Expect(result.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"disk": "nvme"}))
+			Expect(result.Spec.VolumeClaimTemplates[0].Name).To(Equal(aisSpec.Name + "-ais-nvme"))
+			Expect(HeadlessSVCNSName(pool).Name).To(Equal("test-ais-target-nvme"))
+			Expect(ConfigMapNSName(pool).Name).To(Equal("test-ais-target-nvme"))
+			Expect(PodName(pool, 1)).To(Equal("test-ais-target-nvme-1"))
+			Expect(result.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("ConfigMap.Name", "test-ais-target-nvme")))
+
+			// Targets of all pools avoid sharing a node
+			affinity := result.Spec.Template.Spec.Affinity.PodAntiAffinity
+			terms := affinity.RequiredDuringSchedulingIgnoredDuringExecution
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].LabelSelector.MatchLabels).To(Equal(cmn.SelectorLabels(aisSpec.Name, apc.Target)))
+
+			// The targets of targetSpec keep their names and labels
+			Expect(NewTargetSS(specCopy, 1).Name).To(Equal("test-ais-target"))
+			Expect(SelectorLabels(specCopy)).NotTo(HaveKey(cmn.LabelTargetPool))
+		})
 	})
 	Describe("New Target with hostMount", func() {
 		It("should return no VolumeClaimTemplates but with volume mounts", func() {
@@ -257,4 +369,49 @@ var _ = Describe("Statefulset Target Volumes and Mounts", Label("short"), func()
 				"1Gi (whole bytes) should be unchanged")
 		})
 	})
+
+	Describe("External access with routes", func() {
+		It("should advertise the routed hostname of each target", func() {
+			specCopy := aisSpec.DeepCopy()
+			specCopy.Spec.TargetSpec.HostPort = apc.Ptr(int32(51081))
+			specCopy.Spec.TargetSpec.ExternalAccess = &aisv1.ExternalAccessSpec{
+				Type:             apc.Ptr(aisv1.ExternalAccessIngress),
+				HostnameTemplate: apc.Ptr("{cluster}-{index}.example.com"),
+			}
+			env := NewInitContainerEnv(specCopy)
+			Expect(env).To(ContainElement(cmn.EnvFromValue(cmn.EnvEnableExternalAccess, "false")))
+			Expect(env).To(ContainElement(cmn.EnvFromValue(cmn.EnvPublicHostname, "test-ais-$(MY_POD_INDEX).example.com")))
+			Expect(env).NotTo(ContainElement(cmn.EnvFromFieldPath(cmn.EnvPublicHostname, "status.hostIP")))
+		})
+	})
+
+	Describe("External access with external-dns", func() {
+		It("should advertise the published hostname of each target instead of its LoadBalancer address", func() {
+			specCopy := aisSpec.DeepCopy()
+			specCopy.Spec.TargetSpec.ExternalAccess = &aisv1.ExternalAccessSpec{
+				HostnameTemplate: apc.Ptr("{cluster}-{index}.example.com"),
+				ExternalDNS:      &aisv1.ExternalDNSSpec{Zone: "example.com"},
+			}
+			env := NewInitContainerEnv(specCopy)
+			Expect(env).To(ContainElement(cmn.EnvFromValue(cmn.EnvEnableExternalAccess, "false")))
+			Expect(env).To(ContainElement(cmn.EnvFromValue(cmn.EnvPublicHostname, "test-ais-$(MY_POD_INDEX).example.com")))
+
+			svc := NewTargetLoadBalancerSVC(specCopy, 1)
+			Expect(svc.Annotations).To(HaveKeyWithValue(cmn.ExternalDNSHostnameAnnotation, "test-ais-1.example.com"))
+		})
+	})
+
+	Describe("External access with a node port", func() {
+		It("should advertise the node IP with the node port", func() {
+			specCopy := aisSpec.DeepCopy()
+			specCopy.Spec.TargetSpec.ExternalAccess = &aisv1.ExternalAccessSpec{
+				Type:     apc.Ptr(aisv1.ExternalAccessNodePort),
+				NodePort: &aisv1.NodePortAccessSpec{Port: 30081},
+			}
+			Expect(NewInitContainerEnv(specCopy)).To(ContainElement(cmn.EnvFromValue(cmn.EnvEnableExternalAccess, "false")))
+			env := NewAISContainerEnv(specCopy)
+			Expect(env).To(ContainElement(cmn.EnvFromFieldPath(cmn.EnvTargetHostIP, "status.hostIP")))
+			Expect(env).To(ContainElement(cmn.EnvFromValue(cmn.EnvTargetHostPort, "30081")))
+		})
+	})
 })
@@ -6,12 +6,12 @@ package target
 
 import (
 	"fmt"
-	"path"
 
 	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	"github.com/ais-operator/internal/resources/aistore/cmn"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -35,6 +35,9 @@ func newVolumes(ais *aisv1.AIStore) []corev1.Volume {
 	volumes := cmn.NewAISVolumes(ais, aisapc.Target)
 	volumes = appendCloudVolumes(ais, volumes)
 	volumes = appendHostPathDataVolumes(ais, volumes)
+	if volume, _ := cmn.NewMountDiscoveryVolume(ais); volume != nil {
+		volumes = append(volumes, *volume)
+	}
 	return volumes
 }
 
@@ -69,16 +72,16 @@ func appendCloudVolumes(ais *aisv1.AIStore, volumes []corev1.Volume) []corev1.Vo
 
 func appendHostPathDataVolumes(ais *aisv1.AIStore, volumes []corev1.Volume) []corev1.Volume {
 	mounts := ais.Spec.TargetSpec.Mounts
-	for i, mnt := range mounts {
+	for i := range mounts {
 		// Only creating new volumes for HostPath mounts
-		if !mnt.IsHostPath() {
+		if !mounts[i].IsHostPath() {
 			continue
 		}
 		volumes = append(volumes, corev1.Volume{
 			Name: getHostPathVolumeName(i),
 			VolumeSource: corev1.VolumeSource{
 				HostPath: &corev1.HostPathVolumeSource{
-					Path: path.Join(mnt.Path, ais.Namespace, ais.Name, aisapc.Target),
+					Path: cmn.TargetHostDataPath(ais, &mounts[i]),
 					Type: aisapc.Ptr(corev1.HostPathDirectoryOrCreate),
 				},
 			},
@@ -90,11 +93,17 @@ func appendHostPathDataVolumes(ais *aisv1.AIStore, volumes []corev1.Volume) []co
 func newTargetPVCs(ais *aisv1.AIStore) []corev1.PersistentVolumeClaim {
 	// Add PVCs for AIS data storage
 	pvcs := make([]corev1.PersistentVolumeClaim, 0, len(ais.Spec.TargetSpec.Mounts))
-	for _, mnt := range ais.Spec.TargetSpec.Mounts {
+	for i := range ais.Spec.TargetSpec.Mounts {
+		mnt := &ais.Spec.TargetSpec.Mounts[i]
 		if mnt.IsHostPath() {
 			continue
 		}
-		pvcs = append(pvcs, *mnt.BuildPVC(ais.Name))
+		pvc := mnt.BuildPVC(ais.Name)
+		if mnt.IsLocalPV() && pvc.Spec.Selector == nil {
+			// Bind to the local PVs created by the operator for this mount
+			pvc.Spec.Selector = &metav1.LabelSelector{MatchLabels: cmn.LocalPVLabels(ais, mnt)}
+		}
+		pvcs = append(pvcs, *pvc)
 	}
 	if stateStorageClass := ais.Spec.StateStoragePVCStorageClass(); stateStorageClass != nil {
 		if statePVC := cmn.DefineStatePVC(ais, stateStorageClass); statePVC != nil {
@@ -108,6 +117,9 @@ func newVolumeMounts(ais *aisv1.AIStore) []corev1.VolumeMount {
 	vm := cmn.NewAISVolumeMounts(ais, aisapc.Target)
 	vm = appendCloudVolumeMounts(&ais.Spec, vm)
 	vm = appendDataVolumeMounts(ais, vm)
+	if _, mount := cmn.NewMountDiscoveryVolume(ais); mount != nil {
+		vm = append(vm, *mount)
+	}
 	return vm
 }
 
@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package certificates
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+)
+
+// EncodeBundlePEM PEM-encodes the certificates, dropping duplicates while keeping the order they first
+// appear in, and returns the bundle with the number of certificates it holds.
+func EncodeBundlePEM(certs []*x509.Certificate) (bundle []byte, count int) {
+	seen := make(map[string]struct{}, len(certs))
+	for _, cert := range certs {
+		fingerprint := Fingerprint(cert)
+		if _, ok := seen[fingerprint]; ok {
+			continue
+		}
+		seen[fingerprint] = struct{}{}
+		bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	return bundle, len(seen)
+}
+
+// BundleHash returns the hex-encoded SHA-256 digest of a PEM bundle.
+func BundleHash(bundle []byte) string {
+	sum := sha256.Sum256(bundle)
+	return hex.EncodeToString(sum[:])
+}
@@ -7,6 +7,7 @@ package certificates
 import (
 	"net"
 	"slices"
+	"strings"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmapiv1ac "github.com/cert-manager/cert-manager/pkg/client/applyconfigurations/certmanager/v1"
@@ -59,13 +60,14 @@ func NewSpec(config *SpecConfig, dnsNames, ipAddresses []string) *cmapiv1ac.Cert
 }
 
 // AppendHosts classifies hosts as DNS names or IP addresses and appends them to the corresponding SAN list.
+// IP addresses are appended in canonical form, and IPv6 addresses may be enclosed in brackets.
 func AppendHosts(dnsNames, ipAddresses []string, hosts ...string) ([]string, []string) {
 	for _, host := range hosts {
 		if host == "" {
 			continue
 		}
-		if net.ParseIP(host) != nil {
-			ipAddresses = append(ipAddresses, host)
+		if ip := net.ParseIP(strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")); ip != nil {
+			ipAddresses = append(ipAddresses, ip.String())
 		} else {
 			dnsNames = append(dnsNames, host)
 		}
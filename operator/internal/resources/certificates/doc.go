@@ -2,5 +2,6 @@
  * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
  */
 
-// Package certificates contains shared cert-manager resource helpers.
+// Package certificates contains shared cert-manager resource helpers and generates the
+// certificates the operator issues itself.
 package certificates
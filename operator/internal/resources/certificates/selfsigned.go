@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package certificates
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"slices"
+	"time"
+)
+
+// backdate is subtracted from NotBefore to tolerate clock skew between the operator and clients.
+const backdate = 5 * time.Minute
+
+// KeyPair is a PEM-encoded certificate and its private key.
+type KeyPair struct {
+	CertPEM []byte
+	KeyPEM  []byte
+}
+
+// NewSelfSignedCA generates a self-signed CA certificate valid for the given duration.
+func NewSelfSignedCA(commonName string, duration time.Duration) (*KeyPair, error) {
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              now.Add(duration),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	return issue(template, nil, nil)
+}
+
+// NewLeafCertificate generates a certificate for the given SANs, signed by the CA, usable for both
+// serving and dialing TLS.
+func NewLeafCertificate(ca *KeyPair, commonName string, dnsNames, ipAddresses []string, duration time.Duration) (*KeyPair, error) {
+	ips := make([]net.IP, 0, len(ipAddresses))
+	for _, addr := range ipAddresses {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address SAN %q", addr)
+		}
+		ips = append(ips, ip)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: commonName},
+		DNSNames:    dnsNames,
+		IPAddresses: ips,
+		NotBefore:   now.Add(-backdate),
+		NotAfter:    now.Add(duration),
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	return signByCA(ca, template)
+}
+
+// NewClientCertificate generates a certificate signed by the CA that is only usable for dialing TLS.
+func NewClientCertificate(ca *KeyPair, commonName string, duration time.Duration) (*KeyPair, error) {
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: commonName},
+		NotBefore:   now.Add(-backdate),
+		NotAfter:    now.Add(duration),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	return signByCA(ca, template)
+}
+
+// signByCA issues the template from the CA, never outliving it
+func signByCA(ca *KeyPair, template *x509.Certificate) (*KeyPair, error) {
+	caCert, caKey, err := ca.parse()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CA: %w", err)
+	}
+	if template.NotAfter.After(caCert.NotAfter) {
+		template.NotAfter = caCert.NotAfter
+	}
+	return issue(template, caCert, caKey)
+}
+
+// NeedsRenewal reports whether the certificate expires within renewBefore or is not yet valid.
+func NeedsRenewal(cert *x509.Certificate, renewBefore time.Duration) bool {
+	now := time.Now()
+	return now.Before(cert.NotBefore) || now.Add(renewBefore).After(cert.NotAfter)
+}
+
+// HasSANs reports whether the certificate covers exactly the given DNS names and IP addresses.
+func HasSANs(cert *x509.Certificate, dnsNames, ipAddresses []string) bool {
+	certIPs := make([]string, 0, len(cert.IPAddresses))
+	for _, ip := range cert.IPAddresses {
+		certIPs = append(certIPs, ip.String())
+	}
+	certDNSNames, certIPs := NormalizeSANs(slices.Clone(cert.DNSNames), certIPs)
+	dnsNames, ipAddresses = NormalizeSANs(slices.Clone(dnsNames), slices.Clone(ipAddresses))
+	return slices.Equal(certDNSNames, dnsNames) && slices.Equal(certIPs, ipAddresses)
+}
+
+// IsSignedBy reports whether the certificate was issued by the CA.
+func IsSignedBy(cert, ca *x509.Certificate) bool {
+	return cert.CheckSignatureFrom(ca) == nil
+}
+
+// Fingerprint returns the hex-encoded SHA-256 digest of the certificate.
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseCertificatePEM parses the first certificate in a PEM bundle.
+func ParseCertificatePEM(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// ParseCertificatesPEM parses every certificate in a PEM bundle.
+func ParseCertificatesPEM(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM certificate found")
+	}
+	return certs, nil
+}
+
+// Certificate parses the key pair's certificate.
+func (kp *KeyPair) Certificate() (*x509.Certificate, error) {
+	return ParseCertificatePEM(kp.CertPEM)
+}
+
+func (kp *KeyPair) parse() (*x509.Certificate, crypto.Signer, error) {
+	cert, err := kp.Certificate()
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(kp.KeyPEM)
+	if block == nil {
+		return nil, nil, errors.New("no PEM private key found")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return cert, signer, nil
+}
+
+// issue signs the template with the parent key, or self-signs it when parent is nil.
+func issue(template, parent *x509.Certificate, parentKey crypto.Signer) (*KeyPair, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template.SerialNumber, err = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{
+		CertPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		KeyPEM:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package certificates
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewLeafCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ca, err := NewSelfSignedCA("test-ca", 24*time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	caCert, err := ca.Certificate()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(caCert.IsCA).To(BeTrue())
+
+	leaf, err := NewLeafCertificate(ca, "test", []string{"b.example.com", "a.example.com"}, []string{"192.0.2.1"}, 48*time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	leafCert, err := leaf.Certificate()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(IsSignedBy(leafCert, caCert)).To(BeTrue())
+	g.Expect(leafCert.ExtKeyUsage).To(ConsistOf(x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth))
+	g.Expect(HasSANs(leafCert, []string{"a.example.com", "b.example.com"}, []string{"192.0.2.1"})).To(BeTrue())
+	g.Expect(HasSANs(leafCert, []string{"a.example.com"}, []string{"192.0.2.1"})).To(BeFalse())
+	// Capped at the CA's expiry
+	g.Expect(leafCert.NotAfter).To(BeTemporally("<=", caCert.NotAfter))
+
+	other, err := NewSelfSignedCA("other-ca", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	otherCert, err := other.Certificate()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(IsSignedBy(leafCert, otherCert)).To(BeFalse())
+
+	_, err = NewLeafCertificate(ca, "test", nil, []string{"not-an-ip"}, time.Hour)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewClientCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ca, err := NewSelfSignedCA("test-ca", 24*time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	caCert, err := ca.Certificate()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	client, err := NewClientCertificate(ca, "operator", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	clientCert, err := client.Certificate()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(IsSignedBy(clientCert, caCert)).To(BeTrue())
+	g.Expect(clientCert.Subject.CommonName).To(Equal("operator"))
+	g.Expect(clientCert.ExtKeyUsage).To(ConsistOf(x509.ExtKeyUsageClientAuth))
+	g.Expect(clientCert.DNSNames).To(BeEmpty())
+}
+
+func TestNeedsRenewal(t *testing.T) {
+	g := NewWithT(t)
+	ca, err := NewSelfSignedCA("test-ca", 10*time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	caCert, err := ca.Certificate()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(NeedsRenewal(caCert, time.Hour)).To(BeFalse())
+	g.Expect(NeedsRenewal(caCert, 11*time.Hour)).To(BeTrue())
+}
+
+func TestParseCertificatesPEM(t *testing.T) {
+	g := NewWithT(t)
+	first, err := NewSelfSignedCA("first", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	second, err := NewSelfSignedCA("second", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	certs, err := ParseCertificatesPEM(append(append([]byte{}, first.CertPEM...), second.CertPEM...))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(certs).To(HaveLen(2))
+	g.Expect(certs[1].Subject.CommonName).To(Equal("second"))
+
+	_, err = ParseCertificatesPEM(first.KeyPEM)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestEncodeBundlePEM(t *testing.T) {
+	g := NewWithT(t)
+	first, err := NewSelfSignedCA("first", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	second, err := NewSelfSignedCA("second", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	firstCert, err := first.Certificate()
+	g.Expect(err).NotTo(HaveOccurred())
+	secondCert, err := second.Certificate()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	bundle, count := EncodeBundlePEM([]*x509.Certificate{secondCert, firstCert, secondCert})
+	g.Expect(count).To(Equal(2))
+	certs, err := ParseCertificatesPEM(bundle)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(certs).To(HaveLen(2))
+	g.Expect(certs[0].Subject.CommonName).To(Equal("second"))
+	g.Expect(BundleHash(bundle)).To(HaveLen(64))
+}
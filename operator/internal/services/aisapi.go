@@ -33,12 +33,15 @@ type (
 		DecommissionCluster(rmUserData bool) error
 		DecommissionNode(actValue *apc.ActValRmNode) (xid string, err error)
 		GetClusterMap() (smap *meta.Smap, err error)
+		GetClusterConfig() (*cmn.ClusterConfig, error)
 		Health(readyToRebalance bool) error
 		SetClusterConfigUsingMsg(configToUpdate *cmn.ConfigToSet, transient bool) error
 		SetPrimaryProxy(newPrimaryID, newPrimaryURL string, force bool) error
 		ShutdownCluster() error
 		StartMaintenance(actValue *apc.ActValRmNode) (string, error)
+		StopMaintenance(actValue *apc.ActValRmNode) (string, error)
 		HasValidBaseParams(context context.Context, ais *aisv1.AIStore, expectedURL string) bool
+		LoadX509Cert(nodeID ...string) error
 	}
 
 	AIStoreClient struct {
@@ -48,6 +51,10 @@ type (
 		tlsCfg        *tls.Config
 		tokenExpireAt time.Time
 		authFailed    bool
+		// trustBundleHash identifies the trust bundle the client's TLS config was built from
+		trustBundleHash string
+		// selfSignedCA is the bundle of the operator-generated CA trusted by the client's TLS config
+		selfSignedCA []byte
 	}
 )
 
@@ -94,6 +101,11 @@ func (c *AIStoreClient) HasValidBaseParams(ctx context.Context, ais *aisv1.AISto
 	if c.mode != ais.GetAPIMode() {
 		return false
 	}
+	// Check for a change of the trusted CAs
+	if c.trustBundleHash != ais.TrustBundleHash() {
+		logf.FromContext(ctx).Info("Trust bundle changed, recreating client")
+		return false
+	}
 	// If using public API, no k8s service to automate changing endpoints, verify params still valid
 	if c.mode == APIModePublic {
 		err := c.Health(false)
@@ -162,6 +174,12 @@ func (c *AIStoreClient) GetClusterMap() (smap *meta.Smap, err error) {
 	return
 }
 
+func (c *AIStoreClient) GetClusterConfig() (*cmn.ClusterConfig, error) {
+	config, err := api.GetClusterConfig(*c.params)
+	c.checkAuthErr(err)
+	return config, err
+}
+
 func (c *AIStoreClient) Health(readyToRebalance bool) error {
 	err := api.Health(*c.params, readyToRebalance)
 	c.checkAuthErr(err)
@@ -192,6 +210,19 @@ func (c *AIStoreClient) StartMaintenance(actValue *apc.ActValRmNode) (string, er
 	return xid, err
 }
 
+func (c *AIStoreClient) StopMaintenance(actValue *apc.ActValRmNode) (string, error) {
+	xid, err := api.StopMaintenance(*c.params, actValue)
+	c.checkAuthErr(err)
+	return xid, err
+}
+
+// LoadX509Cert asks the given node, or all nodes when none is given, to reload the TLS certificate from disk
+func (c *AIStoreClient) LoadX509Cert(nodeID ...string) error {
+	err := api.LoadX509Cert(*c.params, nodeID...)
+	c.checkAuthErr(err)
+	return err
+}
+
 func NewAIStoreClient(ctx context.Context, url string, tokenInfo *TokenInfo, mode string, tlsCfg *tls.Config) *AIStoreClient {
 	var token string
 	var tokenExpireAt time.Time
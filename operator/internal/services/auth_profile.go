@@ -7,7 +7,10 @@ package services
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"net/http"
+	"sync/atomic"
 
 	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
 	aisclient "github.com/ais-operator/internal/client"
@@ -15,6 +18,14 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// Errors returned by CheckAuthProfile, telling why no token was obtained. Any other error means the
+// auth provider answered without issuing a token.
+var (
+	ErrAuthProviderTLS         = errors.New("auth provider TLS configuration is invalid")
+	ErrAuthProviderCredentials = errors.New("auth provider credentials are unavailable")
+	ErrAuthProviderUnreachable = errors.New("auth provider is unreachable")
+)
+
 // AuthProfileConfig wraps an AIStoreAuthProfile, the administrator-approved auth provider
 type AuthProfileConfig struct {
 	profile   *authv1alpha1.AIStoreAuthProfile
@@ -80,6 +91,10 @@ func (c *AuthProfileConfig) GetPassKey() string {
 	return ""
 }
 
+func (c *AuthProfileConfig) GetGrantSubject() authv1alpha1.ReferenceGrantFrom {
+	return c.profile.GrantSubject()
+}
+
 func (c *AuthProfileConfig) GetTLSConfig(ctx context.Context) (*tls.Config, error) {
 	insecureSkipVerify := c.profile.Spec.TLS != nil && c.profile.Spec.TLS.InsecureSkipVerify
 	return c.tls.get(ctx, c.trustStoreConfig, insecureSkipVerify)
@@ -100,6 +115,10 @@ func (c *AuthProfileConfig) trustStoreConfig(ctx context.Context) (truststore.Co
 	}
 	ref := c.profile.Spec.TLS.CAConfigMapRef
 	name := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+	err := checkReferenceGrant(ctx, c.k8sClient, c.GetGrantSubject(), authv1alpha1.ReferenceGrantToConfigMap, name)
+	if err != nil {
+		return truststore.Config{}, err
+	}
 	configMap, err := c.k8sClient.GetConfigMap(ctx, name)
 	if err != nil {
 		return truststore.Config{}, fmt.Errorf("failed to get CA ConfigMap %s: %w", name, err)
@@ -110,3 +129,50 @@ func (c *AuthProfileConfig) trustStoreConfig(ctx context.Context) (truststore.Co
 	}
 	return truststore.Config{CAPEMs: [][]byte{[]byte(caPEM)}}, nil
 }
+
+// CheckAuthProfile obtains a token from the profile's auth provider the same way an AIStore
+// referencing the profile would. Token exchange requests no audiences, since those depend on the
+// referencing cluster.
+func (c *AuthNClient) CheckAuthProfile(ctx context.Context, profile *authv1alpha1.AIStoreAuthProfile) (*TokenInfo, error) {
+	conf := &AuthProfileConfig{profile: profile, k8sClient: c.k8sClient}
+	bp, err := newAuthBaseParams(ctx, conf)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAuthProviderTLS, err)
+	}
+	transport := &answerTracker{RoundTripper: bp.Client.Transport}
+	bp.Client.Transport = transport
+
+	var tokenInfo *TokenInfo
+	if conf.IsTokenExchange() {
+		subjectToken, tokenErr := c.getSubjectToken(ctx, conf)
+		if tokenErr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAuthProviderCredentials, tokenErr)
+		}
+		tokenInfo, err = exchangeTokenWithAuthSvc(ctx, bp, subjectToken, conf.GetTokenExchangeEndpoint(), nil)
+	} else {
+		creds, credsErr := c.getCredentials(ctx, conf)
+		if credsErr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAuthProviderCredentials, credsErr)
+		}
+		tokenInfo, err = getTokenWithCredentials(ctx, bp, creds, conf.GetOAuthLoginConf())
+	}
+	if err != nil && !transport.answered.Load() {
+		return nil, fmt.Errorf("%w: %w", ErrAuthProviderUnreachable, err)
+	}
+	return tokenInfo, err
+}
+
+// answerTracker records whether the auth provider answered any request. The AIS API client
+// reports transport failures as HTTP errors, so the error alone does not tell them apart.
+type answerTracker struct {
+	http.RoundTripper
+	answered atomic.Bool
+}
+
+func (t *answerTracker) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err == nil {
+		t.answered.Store(true)
+	}
+	return resp, err
+}
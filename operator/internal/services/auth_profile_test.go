@@ -8,6 +8,8 @@ import (
 	"context"
 	"crypto/x509"
 	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
 
 	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
@@ -127,7 +129,8 @@ var _ = Describe("AuthProfileConfig", func() {
 				},
 			},
 		})
-		config.k8sClient = newFakeK8sClient(configMap)
+		config.k8sClient = newFakeK8sClient(configMap, newReferenceGrant("auth-config", config.GetGrantSubject(),
+			authv1alpha1.ReferenceGrantTo{Kind: authv1alpha1.ReferenceGrantToConfigMap}))
 
 		tlsConfig, err := config.GetTLSConfig(context.Background())
 		Expect(err).NotTo(HaveOccurred())
@@ -155,11 +158,30 @@ var _ = Describe("AuthProfileConfig", func() {
 				},
 			},
 		})
-		config.k8sClient = newFakeK8sClient(configMap)
+		config.k8sClient = newFakeK8sClient(configMap, newReferenceGrant("auth-config", config.GetGrantSubject(),
+			authv1alpha1.ReferenceGrantTo{Kind: authv1alpha1.ReferenceGrantToConfigMap}))
 
 		_, err := config.GetTLSConfig(context.Background())
 		Expect(err).To(MatchError(ContainSubstring(`has no key "ca.crt"`)))
 	})
+
+	It("should not read a CA ConfigMap without a reference grant", func() {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "auth-provider-ca", Namespace: "auth-config"},
+			Data:       map[string]string{"ca.crt": string(createTestCACertPEM("profile-ca"))},
+		}
+		config := profileConfig(authv1alpha1.AIStoreAuthProfileSpec{
+			TLS: &authv1alpha1.AuthProfileTLSConfig{
+				CAConfigMapRef: &authv1alpha1.AuthProfileCAConfigMapRef{
+					Namespace: "auth-config", Name: "auth-provider-ca", Key: "ca.crt",
+				},
+			},
+		})
+		config.k8sClient = newFakeK8sClient(configMap)
+
+		_, err := config.GetTLSConfig(context.Background())
+		Expect(err).To(MatchError(ErrReferenceNotGranted))
+	})
 })
 
 var _ = Describe("getAuthConfig", func() {
@@ -260,6 +282,104 @@ var _ = Describe("getAuthConfig", func() {
 	})
 })
 
+var _ = Describe("CheckAuthProfile", func() {
+	const loginPath = "/v1/users/admin"
+
+	var (
+		server *httptest.Server
+		status int
+		secret *corev1.Secret
+		grant  *authv1alpha1.AuthReferenceGrant
+	)
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != loginPath {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(status)
+			if status == http.StatusOK {
+				_, _ = w.Write([]byte(`{"token":"admin-token"}`))
+			}
+		}))
+		DeferCleanup(server.Close)
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin", Namespace: "auth-config"},
+			Data: map[string][]byte{
+				authv1alpha1.DefaultAuthProfileUserKey: []byte("admin"),
+				authv1alpha1.DefaultAuthProfilePassKey: []byte("secret"),
+			},
+		}
+		grant = newReferenceGrant("auth-config",
+			authv1alpha1.ReferenceGrantFrom{Kind: authv1alpha1.ReferenceGrantFromAIStoreAuthProfile},
+			authv1alpha1.ReferenceGrantTo{Kind: authv1alpha1.ReferenceGrantToSecret},
+			authv1alpha1.ReferenceGrantTo{Kind: authv1alpha1.ReferenceGrantToConfigMap},
+		)
+	})
+
+	passwordProfile := func(serviceURL string) *authv1alpha1.AIStoreAuthProfile {
+		return &authv1alpha1.AIStoreAuthProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-authn"},
+			Spec: authv1alpha1.AIStoreAuthProfileSpec{
+				ServiceURL: serviceURL,
+				UsernamePassword: &authv1alpha1.AuthProfileUsernamePassword{
+					Secret: authv1alpha1.AuthProfileSecret{Name: "admin", Namespace: "auth-config"},
+				},
+			},
+		}
+	}
+
+	It("should return the token issued for the profile credentials", func(ctx context.Context) {
+		token, err := NewAuthNClient(newFakeK8sClient(secret, grant)).CheckAuthProfile(ctx, passwordProfile(server.URL))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token.Token).To(Equal("admin-token"))
+	})
+
+	It("should report rejected credentials without classifying the error", func(ctx context.Context) {
+		status = http.StatusUnauthorized
+		_, err := NewAuthNClient(newFakeK8sClient(secret, grant)).CheckAuthProfile(ctx, passwordProfile(server.URL))
+		Expect(err).To(HaveOccurred())
+		Expect(err).NotTo(MatchError(ErrAuthProviderUnreachable))
+		Expect(err).NotTo(MatchError(ErrAuthProviderCredentials))
+	})
+
+	It("should report an unreachable auth provider", func(ctx context.Context) {
+		server.Close()
+		_, err := NewAuthNClient(newFakeK8sClient(secret, grant)).CheckAuthProfile(ctx, passwordProfile(server.URL))
+		Expect(err).To(MatchError(ErrAuthProviderUnreachable))
+	})
+
+	It("should report an unreachable OAuth provider", func(ctx context.Context) {
+		server.Close()
+		profile := passwordProfile(server.URL)
+		profile.Spec.UsernamePassword.LoginConf = &authv1alpha1.AuthProfileLoginConf{ClientID: "AIStore"}
+		_, err := NewAuthNClient(newFakeK8sClient(secret, grant)).CheckAuthProfile(ctx, profile)
+		Expect(err).To(MatchError(ErrAuthProviderUnreachable))
+	})
+
+	It("should report a missing credentials Secret", func(ctx context.Context) {
+		_, err := NewAuthNClient(newFakeK8sClient(grant)).CheckAuthProfile(ctx, passwordProfile(server.URL))
+		Expect(err).To(MatchError(ErrAuthProviderCredentials))
+	})
+
+	It("should report a credentials Secret that is not granted", func(ctx context.Context) {
+		_, err := NewAuthNClient(newFakeK8sClient(secret)).CheckAuthProfile(ctx, passwordProfile(server.URL))
+		Expect(err).To(MatchError(ErrAuthProviderCredentials))
+		Expect(err).To(MatchError(ErrReferenceNotGranted))
+	})
+
+	It("should report a missing CA ConfigMap", func(ctx context.Context) {
+		profile := passwordProfile("https://auth-provider.ais.svc:52001")
+		profile.Spec.TLS = &authv1alpha1.AuthProfileTLSConfig{
+			CAConfigMapRef: &authv1alpha1.AuthProfileCAConfigMapRef{Name: "auth-ca", Namespace: "auth-config", Key: "ca.crt"},
+		}
+		_, err := NewAuthNClient(newFakeK8sClient(secret, grant)).CheckAuthProfile(ctx, profile)
+		Expect(err).To(MatchError(ErrAuthProviderTLS))
+	})
+})
+
 func newFakeK8sClient(objs ...client.Object) *aisclient.K8sClient {
 	GinkgoHelper()
 	return newFakeK8sClientWithInterceptors(nil, objs...)
@@ -270,6 +390,7 @@ func newFakeK8sClientWithInterceptors(funcs *interceptor.Funcs, objs ...client.O
 	scheme := runtime.NewScheme()
 	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
 	Expect(authv1alpha1.AddToScheme(scheme)).To(Succeed())
+	Expect(aisv1.AddToScheme(scheme)).To(Succeed())
 	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...)
 	if funcs != nil {
 		builder = builder.WithInterceptorFuncs(*funcs)
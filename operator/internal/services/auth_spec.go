@@ -9,7 +9,9 @@ import (
 	"context"
 	"crypto/tls"
 
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
 	"github.com/ais-operator/internal/truststore"
 )
 
@@ -25,7 +27,11 @@ const (
 // AuthSpecConfig wraps the CRD AuthSpec configuration
 type AuthSpecConfig struct {
 	spec      *aisv1.AuthSpec
+	name      string // cluster name, identifying it in AuthReferenceGrants
 	namespace string // cluster namespace for default secret lookup
+	// cluster whose trust bundle, if configured, verifies the auth service
+	cluster   *aisv1.AIStore
+	k8sClient *aisclient.K8sClient
 	tls       tlsCache
 }
 
@@ -84,6 +90,10 @@ func (*AuthSpecConfig) GetUserKey() string { return AuthNSecretRefName }
 
 func (*AuthSpecConfig) GetPassKey() string { return AuthNSecretRefPass }
 
+func (c *AuthSpecConfig) GetGrantSubject() authv1alpha1.ReferenceGrantFrom {
+	return authv1alpha1.ReferenceGrantFrom{Kind: authv1alpha1.ReferenceGrantFromAIStore, Namespace: c.namespace, Name: c.name}
+}
+
 func (c *AuthSpecConfig) GetCACertPath() string {
 	if c.spec.TLS != nil {
 		return c.spec.TLS.CACertPath
@@ -99,7 +109,15 @@ func (c *AuthSpecConfig) GetInsecureSkipVerify() bool {
 }
 
 func (c *AuthSpecConfig) GetTLSConfig(ctx context.Context) (*tls.Config, error) {
-	return c.tls.get(ctx, func(context.Context) (truststore.Config, error) {
-		return truststore.Config{CACertPaths: caCertPaths(c.GetCACertPath())}, nil
+	return c.tls.get(ctx, func(ctx context.Context) (truststore.Config, error) {
+		if c.cluster == nil || !c.cluster.UseTrustBundle() {
+			return truststore.Config{CACertPaths: caCertPaths(c.GetCACertPath())}, nil
+		}
+		trust, err := trustBundleConfig(ctx, c.k8sClient, c.cluster)
+		if err != nil {
+			return truststore.Config{}, err
+		}
+		trust.CACertPaths = caCertPaths(c.GetCACertPath())
+		return trust, nil
 	}, c.GetInsecureSkipVerify())
 }
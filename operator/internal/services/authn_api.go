@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/cmn"
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	aisclient "github.com/ais-operator/internal/client"
 	"github.com/ais-operator/internal/opinfo"
@@ -84,6 +85,8 @@ type (
 		GetUserKey() string
 		GetPassKey() string
 		GetTLSConfig(ctx context.Context) (*tls.Config, error)
+		// GetGrantSubject identifies the resource whose references must be allowed by AuthReferenceGrants
+		GetGrantSubject() authv1alpha1.ReferenceGrantFrom
 	}
 
 	// OAuthLoginConf holds the parameters for an OAuth 2.0 password grant
@@ -234,7 +237,7 @@ func (c *AuthNClient) ResolveAuthConfig(ctx context.Context, ais *aisv1.AIStore)
 		if spec.TokenExchange == nil && spec.UsernamePassword == nil { //nolint:staticcheck // deprecated inline auth fields
 			return nil, fmt.Errorf("invalid auth service configuration: exactly one of usernamePassword or tokenExchange must be specified")
 		}
-		config = &AuthSpecConfig{spec: spec, namespace: ais.Namespace}
+		config = &AuthSpecConfig{spec: spec, name: ais.Name, namespace: ais.Namespace, cluster: ais, k8sClient: c.k8sClient}
 	}
 	return config, nil
 }
@@ -258,23 +261,40 @@ func (c *AuthNClient) getTokenViaPassword(ctx context.Context, bp *api.BaseParam
 	if authConf.GetSecretName() == "" {
 		return nil, nil
 	}
-	secretData, err := c.getSecretData(ctx, authConf.GetSecretNamespace(), authConf.GetSecretName())
-	if err != nil || secretData == nil {
+	creds, err := c.getCredentials(ctx, authConf)
+	if err != nil {
 		return nil, err
 	}
+	return getTokenWithCredentials(ctx, bp, creds, authConf.GetOAuthLoginConf())
+}
+
+// getCredentials reads the username and password from the configured login Secret
+func (c *AuthNClient) getCredentials(ctx context.Context, authConf AuthConfig) (credentials, error) {
+	secretName := types.NamespacedName{Namespace: authConf.GetSecretNamespace(), Name: authConf.GetSecretName()}
+	err := checkReferenceGrant(ctx, c.k8sClient, authConf.GetGrantSubject(), authv1alpha1.ReferenceGrantToSecret, secretName)
+	if err != nil {
+		return credentials{}, err
+	}
+	secretData, err := c.getSecretData(ctx, authConf.GetSecretNamespace(), authConf.GetSecretName())
+	if err != nil {
+		return credentials{}, err
+	}
 	userBytes, ok := secretData[authConf.GetUserKey()]
 	if !ok || len(userBytes) == 0 {
-		return nil, fmt.Errorf("auth Secret %s/%s missing key %q", authConf.GetSecretNamespace(), authConf.GetSecretName(), authConf.GetUserKey())
+		return credentials{}, fmt.Errorf("auth Secret %s/%s missing key %q", authConf.GetSecretNamespace(), authConf.GetSecretName(), authConf.GetUserKey())
 	}
 	passBytes, ok := secretData[authConf.GetPassKey()]
 	if !ok || len(passBytes) == 0 {
-		return nil, fmt.Errorf("auth Secret %s/%s missing key %q", authConf.GetSecretNamespace(), authConf.GetSecretName(), authConf.GetPassKey())
+		return credentials{}, fmt.Errorf("auth Secret %s/%s missing key %q", authConf.GetSecretNamespace(), authConf.GetSecretName(), authConf.GetPassKey())
 	}
-	creds := credentials{
+	return credentials{
 		user: string(userBytes),
 		pass: string(passBytes),
-	}
-	oauthConf := authConf.GetOAuthLoginConf()
+	}, nil
+}
+
+// getTokenWithCredentials logs in with the given credentials, via OAuth when configured and AuthN otherwise
+func getTokenWithCredentials(ctx context.Context, bp *api.BaseParams, creds credentials, oauthConf *OAuthLoginConf) (*TokenInfo, error) {
 	if oauthConf == nil {
 		// Use AIS authN service if no OAuth configuration
 		return getTokenFromAuthN(ctx, bp, creds)
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	"github.com/ais-operator/internal/opinfo"
 	"github.com/ais-operator/internal/truststore"
@@ -431,6 +432,10 @@ func (m *mockAuthConfig) GetSecretNamespace() string {
 	return m.secretNamespace
 }
 
+func (m *mockAuthConfig) GetGrantSubject() authv1alpha1.ReferenceGrantFrom {
+	return authv1alpha1.ReferenceGrantFrom{Kind: authv1alpha1.ReferenceGrantFromAIStore, Namespace: m.secretNamespace}
+}
+
 func (m *mockAuthConfig) GetCACertPath() string {
 	return m.caCertPath
 }
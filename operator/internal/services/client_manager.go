@@ -5,11 +5,13 @@
 package services
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
 	"io/fs"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
@@ -18,8 +20,10 @@ import (
 	aiscos "github.com/NVIDIA/aistore/cmn/cos"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
 	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
 	"github.com/ais-operator/internal/resources/aistore/proxy"
 	"github.com/go-logr/logr"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -50,17 +54,25 @@ type (
 		k8sClient *aisclient.K8sClient
 		tlsOpts   AISClientTLSOpts
 		authN     AuthNClientInterface
-		clientMap map[string]AIStoreClientInterface
+		// tokenCache persists admin tokens across operator restarts; nil when disabled
+		tokenCache *adminTokenCache
+		clientMap  map[string]AIStoreClientInterface
 	}
 )
 
-func NewAISClientManager(k8sClient *aisclient.K8sClient, tlsOpts AISClientTLSOpts) *AISClientManager {
-	return &AISClientManager{
+// NewAISClientManager creates a client manager. With cacheTokens, admin tokens are also stored in
+// a Secret per AIStore and reused until they expire or are rejected by the cluster.
+func NewAISClientManager(k8sClient *aisclient.K8sClient, tlsOpts AISClientTLSOpts, cacheTokens bool) *AISClientManager {
+	m := &AISClientManager{
 		k8sClient: k8sClient,
 		tlsOpts:   tlsOpts,
 		authN:     NewAuthNClient(k8sClient),
 		clientMap: make(map[string]AIStoreClientInterface, 16),
 	}
+	if cacheTokens {
+		m.tokenCache = newAdminTokenCache(k8sClient)
+	}
+	return m
 }
 
 // GetClient gets an AIStoreClientInterface for making request to the given AIS cluster.
@@ -77,7 +89,7 @@ func (m *AISClientManager) GetClient(ctx context.Context,
 	// If client exists and token is expired, refresh it
 	if exists {
 		if concreteClient, ok := client.(*AIStoreClient); ok && concreteClient.isTokenExpired() {
-			tokenInfo, err := m.authN.getAdminToken(ctx, ais)
+			tokenInfo, err := m.getAdminToken(ctx, ais)
 			if err != nil {
 				logger.Error(err, "Failed to get admin token for refresh")
 				return nil, err
@@ -95,13 +107,23 @@ func (m *AISClientManager) GetClient(ctx context.Context,
 		return
 	}
 
+	caBundle, err := m.getSelfSignedCABundle(ctx, ais)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the client params are valid
-	if exists && client.HasValidBaseParams(ctx, ais, url) {
+	if exists && client.HasValidBaseParams(ctx, ais, url) && trustsSelfSignedCA(ctx, client, caBundle) {
 		return client, nil
 	}
 
+	// Do not reuse a cached token the cluster has already rejected
+	if concreteClient, ok := client.(*AIStoreClient); ok && concreteClient.authFailed {
+		m.invalidateCachedToken(ctx, ais)
+	}
+
 	// Attempt to get an authN token using the spec.auth field
-	tokenInfo, err := m.authN.getAdminToken(ctx, ais)
+	tokenInfo, err := m.getAdminToken(ctx, ais)
 	if err != nil {
 		logger.Error(err, "Failed to get admin token for AuthN")
 		return nil, err
@@ -111,6 +133,7 @@ func (m *AISClientManager) GetClient(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	addSelfSignedCA(tlsConf, caBundle)
 
 	hasToken := tokenInfo != nil && tokenInfo.Token != ""
 	hasExpiration := tokenInfo != nil && !tokenInfo.ExpiresAt.IsZero()
@@ -119,13 +142,57 @@ func (m *AISClientManager) GetClient(ctx context.Context,
 	} else {
 		logger.Info("Creating HTTPS AIS API client", "url", url, "authN", hasToken, "tokenExpires", hasExpiration, "tlsPath", m.getTLSPath(ais), "skipVerify", tlsConf.InsecureSkipVerify)
 	}
-	client = NewAIStoreClient(ctx, url, tokenInfo, ais.GetAPIMode(), tlsConf)
+	aisClient := NewAIStoreClient(ctx, url, tokenInfo, ais.GetAPIMode(), tlsConf)
+	aisClient.trustBundleHash = ais.TrustBundleHash()
+	aisClient.selfSignedCA = caBundle
+	client = aisClient
 	m.mu.Lock()
 	m.clientMap[ais.NamespacedName().String()] = client
 	m.mu.Unlock()
 	return
 }
 
+// getAdminToken returns a cached admin token for the cluster if one is still valid, or gets a new one
+// from the auth service and caches it
+func (m *AISClientManager) getAdminToken(ctx context.Context, ais *aisv1.AIStore) (*TokenInfo, error) {
+	if m.tokenCache == nil {
+		return m.authN.getAdminToken(ctx, ais)
+	}
+	logger := logf.FromContext(ctx)
+	if ais.Spec.Auth == nil {
+		if err := m.tokenCache.remove(ctx, ais); err != nil {
+			logger.Error(err, "Failed to remove cached admin token")
+		}
+		return nil, nil
+	}
+	cached, err := m.tokenCache.get(ctx, ais)
+	if err != nil {
+		// Not fatal, fall back to the auth service
+		logger.Error(err, "Failed to read cached admin token")
+	} else if cached != nil {
+		logger.Info("Using cached admin token", "tokenExpires", !cached.ExpiresAt.IsZero())
+		return cached, nil
+	}
+	tokenInfo, err := m.authN.getAdminToken(ctx, ais)
+	if err != nil || tokenInfo == nil || tokenInfo.Token == "" {
+		return tokenInfo, err
+	}
+	if err := m.tokenCache.store(ctx, ais, tokenInfo); err != nil {
+		logger.Error(err, "Failed to cache admin token")
+	}
+	return tokenInfo, nil
+}
+
+// invalidateCachedToken removes the cluster's cached admin token, if caching is enabled
+func (m *AISClientManager) invalidateCachedToken(ctx context.Context, ais *aisv1.AIStore) {
+	if m.tokenCache == nil {
+		return
+	}
+	if err := m.tokenCache.invalidate(ctx, ais); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to invalidate cached admin token")
+	}
+}
+
 func (m *AISClientManager) getAISAPIEndpoint(ctx context.Context,
 	ais *aisv1.AIStore,
 ) (string, error) {
@@ -144,7 +211,7 @@ func (m *AISClientManager) getAISAPIEndpoint(ctx context.Context,
 		}
 		port = ais.Spec.ProxySpec.PublicPort.String()
 	// If LoadBalancer is configured use the LB service to contact the API.
-	case ais.ProxyExternalAccessEnabled():
+	case ais.ProxyLoadBalancerEnabled():
 		proxyLBSVC, svcErr := m.k8sClient.GetService(ctx, proxy.LoadBalancerSVCNSName(ais))
 		if svcErr != nil {
 			return "", svcErr
@@ -183,7 +250,7 @@ func (m *AISClientManager) getPublicAISHostname(ctx context.Context, ais *aisv1.
 	if len(pods.Items) == 0 {
 		return "", fmt.Errorf("no ready pods found matching selector %v", selector)
 	}
-	return pods.Items[0].Status.HostIP, nil
+	return aisclient.PodHostIP(&pods.Items[0], ais.PreferredIPFamily()), nil
 }
 
 func (m *AISClientManager) getTLSConfig(ctx context.Context, ais *aisv1.AIStore) (*tls.Config, error) {
@@ -196,10 +263,61 @@ func (m *AISClientManager) getTLSConfig(ctx context.Context, ais *aisv1.AIStore)
 	if err != nil {
 		return nil, err
 	}
-	addClientCertIfRequested(ais, tlsConf, tlsDir)
+	// The cluster's trust bundle replaces the CAs mounted into the operator
+	if ais.UseTrustBundle() && !tlsConf.InsecureSkipVerify {
+		tlsConf.RootCAs, err = newTrustBundlePool(ctx, m.k8sClient, ais)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ais.UseOperatorClientCertificate() {
+		err = m.addClientCertFromSecret(ctx, ais, tlsConf)
+	} else {
+		addClientCertIfRequested(ais, tlsConf, tlsDir)
+	}
 	return tlsConf, err
 }
 
+// getSelfSignedCABundle returns the bundle of the CA the operator generates for the cluster with
+// spec.tls.selfSigned, including the previous CA after a rotation, or nil if not generated yet
+func (m *AISClientManager) getSelfSignedCABundle(ctx context.Context, ais *aisv1.AIStore) ([]byte, error) {
+	if !ais.UseHTTPS() || !ais.UseTLSSelfSigned() {
+		return nil, nil
+	}
+	nn := cmn.SelfSignedCASecretNSName(ais)
+	secret, err := m.k8sClient.GetSecret(ctx, nn)
+	if k8serrors.IsNotFound(err) {
+		logf.FromContext(ctx).Info("Self-signed CA not generated yet", "secret", nn.Name)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return secret.Data[cmn.TLSCAFileName], nil
+}
+
+// addSelfSignedCA trusts the operator-generated CA bundle, in addition to the CAs of the TLS config
+func addSelfSignedCA(tlsConf *tls.Config, caBundle []byte) {
+	if tlsConf == nil || tlsConf.InsecureSkipVerify || len(caBundle) == 0 {
+		return
+	}
+	if tlsConf.RootCAs == nil {
+		tlsConf.RootCAs = x509.NewCertPool()
+	}
+	tlsConf.RootCAs.AppendCertsFromPEM(caBundle)
+}
+
+// trustsSelfSignedCA returns false if the client was created with another bundle of the operator-generated
+// CA, so it is recreated to trust a rotated CA
+func trustsSelfSignedCA(ctx context.Context, client AIStoreClientInterface, caBundle []byte) bool {
+	concreteClient, ok := client.(*AIStoreClient)
+	if !ok || bytes.Equal(concreteClient.selfSignedCA, caBundle) {
+		return true
+	}
+	logf.FromContext(ctx).Info("Self-signed CA bundle changed, recreating client")
+	return false
+}
+
 func configureCAVerification(ctx context.Context, ais *aisv1.AIStore, tlsConf *tls.Config, tlsDir string) error {
 	logger := logf.FromContext(ctx)
 	var (
@@ -250,6 +368,34 @@ func addClientCertIfRequested(ais *aisv1.AIStore, tlsConf *tls.Config, tlsDir st
 	}
 }
 
+// addClientCertFromSecret presents the client certificate the operator issues for the cluster. The
+// Secret is read on every handshake, so renewed certificates are used without recreating the client.
+// Its CA is also trusted, since the operator's own CA signs the cluster's certificate as well.
+func (m *AISClientManager) addClientCertFromSecret(ctx context.Context, ais *aisv1.AIStore, tlsConf *tls.Config) error {
+	nn := cmn.OperatorClientCertSecretNSName(ais)
+	secret, err := m.k8sClient.GetSecret(ctx, nn)
+	switch {
+	case k8serrors.IsNotFound(err):
+		logf.FromContext(ctx).Info("Operator client certificate not issued yet", "secret", nn.Name)
+	case err != nil:
+		return err
+	case tlsConf.RootCAs != nil && len(secret.Data[cmn.TLSCAFileName]) > 0:
+		tlsConf.RootCAs.AppendCertsFromPEM(secret.Data[cmn.TLSCAFileName])
+	}
+	tlsConf.GetClientCertificate = func(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		secret, err := m.k8sClient.GetSecret(info.Context(), nn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get operator client certificate: %w", err)
+		}
+		cert, err := tls.X509KeyPair(secret.Data[cmn.TLSCertFileName], secret.Data[cmn.TLSKeyFileName])
+		if err != nil {
+			return nil, fmt.Errorf("invalid operator client certificate in Secret %s: %w", nn, err)
+		}
+		return &cert, nil
+	}
+	return nil
+}
+
 func (m *AISClientManager) getTLSPath(ais *aisv1.AIStore) string {
 	if m.tlsOpts.CertPerCluster {
 		return filepath.Join(m.tlsOpts.CertPath, ais.Namespace, ais.Name)
@@ -335,5 +481,5 @@ func createAPIURL(https bool, hostname, port string) string {
 	if https {
 		scheme = "https"
 	}
-	return fmt.Sprintf("%s://%s:%s", scheme, hostname, port)
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(hostname, port))
 }
@@ -5,12 +5,23 @@
 package services
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"os"
 	"testing"
+	"time"
 
+	aisapc "github.com/NVIDIA/aistore/api/apc"
 	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	certres "github.com/ais-operator/internal/resources/certificates"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestConfigureCAVerification_UsesSpecValueWhenSet(t *testing.T) {
@@ -100,3 +111,160 @@ func TestConfigureCAVerification_DefaultsToVerifyWhenUnset(t *testing.T) {
 		t.Fatal("expected InsecureSkipVerify=false when spec and env are unset")
 	}
 }
+
+func TestAddClientCertFromSecret_LoadsRenewedCertificate(t *testing.T) {
+	ctx := context.Background()
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"},
+		Spec: aisv1.AIStoreSpec{TLS: &aisv1.TLSSpec{
+			SelfSigned:                &aisv1.TLSSelfSignedConfig{},
+			OperatorClientCertificate: &aisv1.TLSOperatorClientCertificate{},
+		}},
+	}
+	ca, err := certres.NewSelfSignedCA("test-ca", 24*time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+	issue := func() *certres.KeyPair {
+		kp, err := certres.NewClientCertificate(ca, cmn.OperatorClientCommonName, time.Hour)
+		if err != nil {
+			t.Fatalf("failed to issue client certificate: %v", err)
+		}
+		return kp
+	}
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	secret := cmn.NewOperatorClientCertSecret(ais, issue(), ca.CertPEM)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	m := NewAISClientManager(aisclient.NewClient(c, scheme), AISClientTLSOpts{}, false)
+
+	tlsConf := &tls.Config{RootCAs: x509.NewCertPool()}
+	if err := m.addClientCertFromSecret(ctx, ais, tlsConf); err != nil {
+		t.Fatalf("addClientCertFromSecret returned error: %v", err)
+	}
+	trusted := x509.NewCertPool()
+	trusted.AppendCertsFromPEM(ca.CertPEM)
+	if !tlsConf.RootCAs.Equal(trusted) {
+		t.Fatal("expected the Secret's CA to be trusted")
+	}
+
+	// The Secret is read on every handshake, so a renewed certificate is presented
+	renewed := issue()
+	secret.Data[cmn.TLSCertFileName] = renewed.CertPEM
+	secret.Data[cmn.TLSKeyFileName] = renewed.KeyPEM
+	if err := c.Update(ctx, secret); err != nil {
+		t.Fatalf("failed to update Secret: %v", err)
+	}
+	cert, err := tlsConf.GetClientCertificate(&tls.CertificateRequestInfo{})
+	if err != nil {
+		t.Fatalf("GetClientCertificate returned error: %v", err)
+	}
+	want, err := renewed.Certificate()
+	if err != nil {
+		t.Fatalf("failed to parse renewed certificate: %v", err)
+	}
+	if !bytes.Equal(cert.Certificate[0], want.Raw) {
+		t.Fatal("expected the renewed client certificate")
+	}
+}
+
+func TestGetTLSConfig_UsesTrustBundle(t *testing.T) {
+	ctx := context.Background()
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"},
+		Spec: aisv1.AIStoreSpec{
+			ConfigToUpdate: &aisv1.ConfigToUpdate{
+				Net: &aisv1.NetConfToUpdate{HTTP: &aisv1.HTTPConfToUpdate{UseHTTPS: aisapc.Ptr(true)}},
+			},
+			OperatorSkipVerifyCrt: aisapc.Ptr(false),
+			Trust: &aisv1.TrustSpec{
+				Sources:      []aisv1.TrustSource{{ConfigMap: &aisv1.TrustSourceKeySelector{Name: "corp-ca"}}},
+				UseSystemCAs: aisapc.Ptr(false),
+			},
+		},
+	}
+	ca, err := certres.NewSelfSignedCA("test-ca", time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	bundle := cmn.NewTrustBundleConfigMap(ais, ca.CertPEM)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(bundle).Build()
+	m := NewAISClientManager(aisclient.NewClient(c, scheme), AISClientTLSOpts{CertPath: t.TempDir()}, false)
+
+	tlsConf, err := m.getTLSConfig(ctx, ais)
+	if err != nil {
+		t.Fatalf("getTLSConfig returned error: %v", err)
+	}
+	trusted := x509.NewCertPool()
+	trusted.AppendCertsFromPEM(ca.CertPEM)
+	if !tlsConf.RootCAs.Equal(trusted) {
+		t.Fatal("expected only the trust bundle to be trusted")
+	}
+}
+
+func TestGetClient_TrustsSelfSignedCA(t *testing.T) {
+	ctx := context.Background()
+	ais := &aisv1.AIStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns"},
+		Spec: aisv1.AIStoreSpec{
+			ConfigToUpdate: &aisv1.ConfigToUpdate{
+				Net: &aisv1.NetConfToUpdate{HTTP: &aisv1.HTTPConfToUpdate{UseHTTPS: aisapc.Ptr(true)}},
+			},
+			OperatorSkipVerifyCrt: aisapc.Ptr(false),
+			TLS:                   &aisv1.TLSSpec{SelfSigned: &aisv1.TLSSelfSignedConfig{}},
+		},
+	}
+	newCA := func() *certres.KeyPair {
+		ca, err := certres.NewSelfSignedCA("ais-ca.ais-ns", time.Hour)
+		if err != nil {
+			t.Fatalf("failed to create CA: %v", err)
+		}
+		return ca
+	}
+	ca := newCA()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	secret := cmn.NewSelfSignedCASecret(ais, ca, ca.CertPEM)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	m := NewAISClientManager(aisclient.NewClient(c, scheme), AISClientTLSOpts{CertPath: t.TempDir()}, false)
+
+	verify := func(client AIStoreClientInterface, ca *certres.KeyPair) {
+		t.Helper()
+		caCert, err := ca.Certificate()
+		if err != nil {
+			t.Fatalf("failed to parse CA: %v", err)
+		}
+		if _, err := caCert.Verify(x509.VerifyOptions{Roots: client.(*AIStoreClient).tlsCfg.RootCAs}); err != nil {
+			t.Fatalf("expected the self-signed CA to be trusted: %v", err)
+		}
+	}
+	client, err := m.GetClient(ctx, ais)
+	if err != nil {
+		t.Fatalf("GetClient returned error: %v", err)
+	}
+	verify(client, ca)
+	if cached, _ := m.GetClient(ctx, ais); cached != client {
+		t.Fatal("expected the client to be reused while the CA is unchanged")
+	}
+
+	// A rotated CA is trusted by a new client
+	rotated := newCA()
+	secret.Data[cmn.TLSCAFileName] = append(bytes.Clone(rotated.CertPEM), ca.CertPEM...)
+	if err := c.Update(ctx, secret); err != nil {
+		t.Fatalf("failed to update Secret: %v", err)
+	}
+	client, err = m.GetClient(ctx, ais)
+	if err != nil {
+		t.Fatalf("GetClient returned error: %v", err)
+	}
+	verify(client, rotated)
+	verify(client, ca)
+}
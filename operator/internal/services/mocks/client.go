@@ -73,6 +73,21 @@ func (mr *MockAIStoreClientInterfaceMockRecorder) DecommissionNode(actValue any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionNode", reflect.TypeOf((*MockAIStoreClientInterface)(nil).DecommissionNode), actValue)
 }
 
+// GetClusterConfig mocks base method.
+func (m *MockAIStoreClientInterface) GetClusterConfig() (*cmn.ClusterConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterConfig")
+	ret0, _ := ret[0].(*cmn.ClusterConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterConfig indicates an expected call of GetClusterConfig.
+func (mr *MockAIStoreClientInterfaceMockRecorder) GetClusterConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterConfig", reflect.TypeOf((*MockAIStoreClientInterface)(nil).GetClusterConfig))
+}
+
 // GetClusterMap mocks base method.
 func (m *MockAIStoreClientInterface) GetClusterMap() (*meta.Smap, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +131,24 @@ func (mr *MockAIStoreClientInterfaceMockRecorder) Health(readyToRebalance any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockAIStoreClientInterface)(nil).Health), readyToRebalance)
 }
 
+// LoadX509Cert mocks base method.
+func (m *MockAIStoreClientInterface) LoadX509Cert(nodeID ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range nodeID {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LoadX509Cert", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadX509Cert indicates an expected call of LoadX509Cert.
+func (mr *MockAIStoreClientInterfaceMockRecorder) LoadX509Cert(nodeID ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadX509Cert", reflect.TypeOf((*MockAIStoreClientInterface)(nil).LoadX509Cert), nodeID...)
+}
+
 // SetClusterConfigUsingMsg mocks base method.
 func (m *MockAIStoreClientInterface) SetClusterConfigUsingMsg(configToUpdate *cmn.ConfigToSet, transient bool) error {
 	m.ctrl.T.Helper()
@@ -172,3 +205,18 @@ func (mr *MockAIStoreClientInterfaceMockRecorder) StartMaintenance(actValue any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMaintenance", reflect.TypeOf((*MockAIStoreClientInterface)(nil).StartMaintenance), actValue)
 }
+
+// StopMaintenance mocks base method.
+func (m *MockAIStoreClientInterface) StopMaintenance(actValue *apc.ActValRmNode) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopMaintenance", actValue)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopMaintenance indicates an expected call of StopMaintenance.
+func (mr *MockAIStoreClientInterfaceMockRecorder) StopMaintenance(actValue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopMaintenance", reflect.TypeOf((*MockAIStoreClientInterface)(nil).StopMaintenance), actValue)
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/truststore"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// OIDCDiscoveryPath is the path of the OpenID Connect discovery document under an issuer URL
+	OIDCDiscoveryPath = "/.well-known/openid-configuration"
+	// OIDCIssuerCAKey is the ConfigMap key holding the OIDC issuer CA bundle
+	OIDCIssuerCAKey = "ca.crt"
+
+	// oidcDiscoveryTimeout bounds the check of all issuers together, which are fetched in parallel
+	oidcDiscoveryTimeout = 15 * time.Second
+)
+
+// CheckOIDCIssuers fetches the discovery document of every issuer in spec.auth.oidc in parallel, trusting the
+// issuer CA ConfigMap when set, and verifies each document names its issuer and a JWKS endpoint.
+// AIS looks up issuer signing keys through the same documents, so a failure here means tokens from
+// that issuer will be rejected.
+func CheckOIDCIssuers(ctx context.Context, k8sClient *aisclient.K8sClient, ais *aisv1.AIStore) error {
+	oidc := ais.GetOIDC()
+	if oidc == nil {
+		return nil
+	}
+	httpClient, err := newOIDCDiscoveryClient(ctx, k8sClient, ais)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, oidcDiscoveryTimeout)
+	defer cancel()
+	errs := make([]error, len(oidc.Issuers))
+	var wg sync.WaitGroup
+	for i, issuer := range oidc.Issuers {
+		wg.Go(func() {
+			if err := discoverOIDCIssuer(ctx, httpClient, issuer); err != nil {
+				errs[i] = fmt.Errorf("issuer %s: %w", issuer, err)
+			}
+		})
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func newOIDCDiscoveryClient(ctx context.Context, k8sClient *aisclient.K8sClient, ais *aisv1.AIStore) (*http.Client, error) {
+	var trust truststore.Config
+	if ais.UseTrustBundle() {
+		bundle, err := trustBundleConfig(ctx, k8sClient, ais)
+		if err != nil {
+			return nil, err
+		}
+		trust = bundle
+	}
+	if configMapName := ais.OIDCIssuerCAConfigMap(); configMapName != nil {
+		name := types.NamespacedName{Namespace: ais.Namespace, Name: *configMapName}
+		configMap, err := k8sClient.GetConfigMap(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get OIDC issuer CA ConfigMap %s: %w", name, err)
+		}
+		caCert, ok := configMap.Data[OIDCIssuerCAKey]
+		if !ok {
+			return nil, fmt.Errorf("OIDC issuer CA ConfigMap %s has no key %q", name, OIDCIssuerCAKey)
+		}
+		trust.CAPEMs = append(trust.CAPEMs, []byte(caCert))
+	}
+	return newTrustingHTTPClient(ctx, trust)
+}
+
+// newTrustingHTTPClient returns an HTTP client for the operator's requests to external servers, trusting the CAs of
+// the given config
+func newTrustingHTTPClient(ctx context.Context, trust truststore.Config) (*http.Client, error) {
+	tlsConfig, err := truststore.NewTLSConfig(logf.FromContext(ctx), trust)
+	if err != nil {
+		return nil, err
+	}
+	transportArgs := cmn.TransportArgs{
+		ClientTimeout:   10 * time.Second,
+		UseHTTPProxyEnv: true,
+	}
+	transport := cmn.NewTransport(transportArgs)
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport, Timeout: transportArgs.ClientTimeout}, nil
+}
+
+func discoverOIDCIssuer(ctx context.Context, httpClient *http.Client, issuer string) error {
+	discoveryURL := strings.TrimSuffix(issuer, "/") + OIDCDiscoveryPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("discovery document %s returned %s", discoveryURL, resp.Status)
+	}
+	conf := &authn.OIDCConfiguration{}
+	if err := json.NewDecoder(resp.Body).Decode(conf); err != nil {
+		return fmt.Errorf("failed to decode discovery document %s: %w", discoveryURL, err)
+	}
+	// Issuers must match exactly (OIDC Discovery 1.0, section 4.3), or AIS rejects the tokens
+	if conf.Issuer != issuer {
+		return fmt.Errorf("discovery document names issuer %q", conf.Issuer)
+	}
+	if conf.JWKSURI == "" {
+		return fmt.Errorf("discovery document %s has no jwks_uri", discoveryURL)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	aisapc "github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("CheckOIDCIssuers", func() {
+	var (
+		server    *httptest.Server
+		discovery *authn.OIDCConfiguration
+		caMap     *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		discovery = &authn.OIDCConfiguration{}
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/realms/aistore"+OIDCDiscoveryPath {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(discovery)
+		}))
+		DeferCleanup(server.Close)
+		discovery.Issuer = server.URL + "/realms/aistore"
+		discovery.JWKSURI = discovery.Issuer + "/protocol/openid-connect/certs"
+
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		caMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "oidc-ca", Namespace: "ais"},
+			Data:       map[string]string{OIDCIssuerCAKey: string(caPEM)},
+		}
+	})
+
+	oidcAIS := func(issuers ...string) *aisv1.AIStore {
+		return &aisv1.AIStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais"},
+			Spec: aisv1.AIStoreSpec{Auth: &aisv1.AuthSpec{
+				OIDC: &aisv1.OIDCAuthSpec{Issuers: issuers, CAConfigMap: aisapc.Ptr("oidc-ca")},
+			}},
+		}
+	}
+
+	It("should accept an issuer serving a matching discovery document", func(ctx context.Context) {
+		Expect(CheckOIDCIssuers(ctx, newFakeK8sClient(caMap), oidcAIS(discovery.Issuer))).To(Succeed())
+	})
+
+	It("should do nothing without spec.auth.oidc", func(ctx context.Context) {
+		Expect(CheckOIDCIssuers(ctx, newFakeK8sClient(), &aisv1.AIStore{})).To(Succeed())
+	})
+
+	It("should reject a discovery document naming another issuer", func(ctx context.Context) {
+		issuer := discovery.Issuer
+		discovery.Issuer = "https://other.example.com"
+		err := CheckOIDCIssuers(ctx, newFakeK8sClient(caMap), oidcAIS(issuer))
+		Expect(err).To(MatchError(ContainSubstring(`discovery document names issuer "https://other.example.com"`)))
+	})
+
+	It("should reject a discovery document without a JWKS endpoint", func(ctx context.Context) {
+		discovery.JWKSURI = ""
+		err := CheckOIDCIssuers(ctx, newFakeK8sClient(caMap), oidcAIS(discovery.Issuer))
+		Expect(err).To(MatchError(ContainSubstring("has no jwks_uri")))
+	})
+
+	It("should report every failing issuer", func(ctx context.Context) {
+		err := CheckOIDCIssuers(ctx, newFakeK8sClient(caMap), oidcAIS(discovery.Issuer, server.URL+"/realms/missing"))
+		Expect(err).To(MatchError(ContainSubstring("issuer " + server.URL + "/realms/missing")))
+		Expect(err).To(MatchError(ContainSubstring("404 Not Found")))
+	})
+
+	It("should check issuers in parallel", func(ctx context.Context) {
+		var inFlight atomic.Int32
+		release := make(chan struct{})
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			// Checked one after another, the first request would wait until the client timeout
+			if inFlight.Add(1) == 3 {
+				close(release)
+			}
+			<-release
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		DeferCleanup(slow.Close)
+		err := CheckOIDCIssuers(ctx, newFakeK8sClient(caMap), oidcAIS(slow.URL+"/a", slow.URL+"/b", slow.URL+"/c"))
+		Expect(err).To(MatchError(ContainSubstring("404 Not Found")))
+		Expect(inFlight.Load()).To(BeEquivalentTo(3))
+	})
+
+	It("should not trust the issuer without the CA ConfigMap", func(ctx context.Context) {
+		ais := oidcAIS(discovery.Issuer)
+		ais.Spec.Auth.OIDC.CAConfigMap = nil
+		err := CheckOIDCIssuers(ctx, newFakeK8sClient(), ais)
+		Expect(err).To(MatchError(ContainSubstring("certificate")))
+	})
+
+	It("should fail when the CA ConfigMap is missing", func(ctx context.Context) {
+		err := CheckOIDCIssuers(ctx, newFakeK8sClient(), oidcAIS(discovery.Issuer))
+		Expect(err).To(MatchError(ContainSubstring("failed to get OIDC issuer CA ConfigMap ais/oidc-ca")))
+	})
+})
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/truststore"
+)
+
+// PrometheusQueryPath is the path of instant queries under the URL of the Prometheus HTTP API
+const PrometheusQueryPath = "/api/v1/query"
+
+type (
+	prometheusResponse struct {
+		Status string `json:"status"`
+		Error  string `json:"error,omitempty"`
+		Data   struct {
+			ResultType string          `json:"resultType"`
+			Result     json.RawMessage `json:"result"`
+		} `json:"data"`
+	}
+	prometheusSample struct {
+		Value [2]any `json:"value"`
+	}
+)
+
+// QueryPrometheus runs the instant query of the metric and returns its value. The query must return a scalar or
+// a vector of one sample. HTTPS servers are trusted through the trust bundle of the cluster, if any.
+func QueryPrometheus(ctx context.Context, k8sClient *aisclient.K8sClient, ais *aisv1.AIStore, metric *aisv1.PrometheusMetricSpec) (float64, error) {
+	var trust truststore.Config
+	if ais.UseTrustBundle() {
+		bundle, err := trustBundleConfig(ctx, k8sClient, ais)
+		if err != nil {
+			return 0, err
+		}
+		trust = bundle
+	}
+	httpClient, err := newTrustingHTTPClient(ctx, trust)
+	if err != nil {
+		return 0, err
+	}
+	return queryPrometheus(ctx, httpClient, metric.URL, metric.Query)
+}
+
+func queryPrometheus(ctx context.Context, httpClient *http.Client, serverURL, query string) (float64, error) {
+	queryURL := strings.TrimSuffix(serverURL, "/") + PrometheusQueryPath + "?" + url.Values{"query": {query}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, queryURL, http.NoBody)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	body := &prometheusResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(body); err != nil {
+		return 0, fmt.Errorf("failed to decode Prometheus response (%s): %w", resp.Status, err)
+	}
+	if body.Status != "success" {
+		return 0, fmt.Errorf("prometheus query %q failed: %s", query, body.Error)
+	}
+	var sample prometheusSample
+	switch body.Data.ResultType {
+	case "scalar":
+		err = json.Unmarshal(body.Data.Result, &sample.Value)
+	case "vector":
+		var samples []prometheusSample
+		if err = json.Unmarshal(body.Data.Result, &samples); err == nil {
+			if len(samples) != 1 {
+				return 0, fmt.Errorf("prometheus query %q returned %d samples, expected 1", query, len(samples))
+			}
+			sample = samples[0]
+		}
+	default:
+		return 0, fmt.Errorf("prometheus query %q returned a %s, expected a scalar or vector", query, body.Data.ResultType)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode result of Prometheus query %q: %w", query, err)
+	}
+	value, ok := sample.Value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("prometheus query %q returned no value", query)
+	}
+	return strconv.ParseFloat(value, 64)
+}
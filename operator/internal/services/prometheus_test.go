@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("queryPrometheus", func() {
+	var (
+		server   *httptest.Server
+		response string
+		query    string
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal(PrometheusQueryPath))
+			query = r.URL.Query().Get("query")
+			_, _ = w.Write([]byte(response))
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("returns the sample of a vector", func() {
+		response = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000.1,"1250.5"]}]}}`
+		value, err := queryPrometheus(context.Background(), server.Client(), server.URL+"/", "sum(rate(ais_proxy_get_n[2m]))")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal(1250.5))
+		Expect(query).To(Equal("sum(rate(ais_proxy_get_n[2m]))"))
+	})
+
+	It("returns a scalar", func() {
+		response = `{"status":"success","data":{"resultType":"scalar","result":[1700000000.1,"3"]}}`
+		value, err := queryPrometheus(context.Background(), server.Client(), server.URL, "scalar(up)")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal(3.0))
+	})
+
+	It("rejects vectors of several samples", func() {
+		response = `{"status":"success","data":{"resultType":"vector","result":[{"value":[1,"1"]},{"value":[1,"2"]}]}}`
+		_, err := queryPrometheus(context.Background(), server.Client(), server.URL, "up")
+		Expect(err).To(MatchError(ContainSubstring("returned 2 samples")))
+	})
+
+	It("reports query errors", func() {
+		response = `{"status":"error","errorType":"bad_data","error":"parse error"}`
+		_, err := queryPrometheus(context.Background(), server.Client(), server.URL, "sum(")
+		Expect(err).To(MatchError(ContainSubstring("parse error")))
+	})
+})
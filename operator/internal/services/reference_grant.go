@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
+	aisclient "github.com/ais-operator/internal/client"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrReferenceNotGranted is returned when a Secret or ConfigMap in another namespace is referenced
+// without an AuthReferenceGrant allowing it.
+var ErrReferenceNotGranted = errors.New("reference not granted")
+
+// checkReferenceGrant returns an error unless an AuthReferenceGrant in the target's namespace allows
+// from to reference the target object. References within from's own namespace need no grant.
+func checkReferenceGrant(
+	ctx context.Context,
+	k8sClient *aisclient.K8sClient,
+	from authv1alpha1.ReferenceGrantFrom,
+	toKind authv1alpha1.ReferenceGrantToKind,
+	target types.NamespacedName,
+) error {
+	if !from.NeedsGrant(target.Namespace) {
+		return nil
+	}
+	grants := &authv1alpha1.AuthReferenceGrantList{}
+	if err := k8sClient.List(ctx, grants, client.InNamespace(target.Namespace)); err != nil {
+		return fmt.Errorf("failed to list AuthReferenceGrants in namespace %s: %w", target.Namespace, err)
+	}
+	if !grants.Allows(from, toKind, target.Name) {
+		return fmt.Errorf("%w: no AuthReferenceGrant in namespace %s allows %s to reference %s %s",
+			ErrReferenceNotGranted, target.Namespace, from, toKind, target.Name)
+	}
+	return nil
+}
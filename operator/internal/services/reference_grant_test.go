@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("checkReferenceGrant", func() {
+	var (
+		from   authv1alpha1.ReferenceGrantFrom
+		target types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		from = authv1alpha1.ReferenceGrantFrom{Kind: authv1alpha1.ReferenceGrantFromAIStore, Namespace: "ais", Name: "prod"}
+		target = types.NamespacedName{Namespace: "auth-config", Name: "admin"}
+	})
+
+	It("should allow references within the referencing namespace without a grant", func(ctx context.Context) {
+		target.Namespace = "ais"
+		Expect(checkReferenceGrant(ctx, newFakeK8sClient(), from, authv1alpha1.ReferenceGrantToSecret, target)).To(Succeed())
+	})
+
+	It("should reject a cross-namespace reference without a grant", func(ctx context.Context) {
+		err := checkReferenceGrant(ctx, newFakeK8sClient(), from, authv1alpha1.ReferenceGrantToSecret, target)
+		Expect(err).To(MatchError(ErrReferenceNotGranted))
+	})
+
+	It("should allow a cross-namespace reference granted in the target namespace", func(ctx context.Context) {
+		grant := newReferenceGrant("auth-config", from, authv1alpha1.ReferenceGrantTo{Kind: authv1alpha1.ReferenceGrantToSecret})
+		Expect(checkReferenceGrant(ctx, newFakeK8sClient(grant), from, authv1alpha1.ReferenceGrantToSecret, target)).To(Succeed())
+	})
+
+	It("should not apply a grant for another kind", func(ctx context.Context) {
+		grant := newReferenceGrant("auth-config", from, authv1alpha1.ReferenceGrantTo{Kind: authv1alpha1.ReferenceGrantToConfigMap})
+		err := checkReferenceGrant(ctx, newFakeK8sClient(grant), from, authv1alpha1.ReferenceGrantToSecret, target)
+		Expect(err).To(MatchError(ErrReferenceNotGranted))
+	})
+
+	It("should not apply a grant from another namespace", func(ctx context.Context) {
+		grant := newReferenceGrant("other", from, authv1alpha1.ReferenceGrantTo{Kind: authv1alpha1.ReferenceGrantToSecret})
+		err := checkReferenceGrant(ctx, newFakeK8sClient(grant), from, authv1alpha1.ReferenceGrantToSecret, target)
+		Expect(err).To(MatchError(ErrReferenceNotGranted))
+	})
+
+	It("should always require a grant for a cluster-scoped profile", func(ctx context.Context) {
+		profile := &authv1alpha1.AIStoreAuthProfile{ObjectMeta: metav1.ObjectMeta{Name: "prod-authn"}}
+		target.Namespace = "ais"
+		err := checkReferenceGrant(ctx, newFakeK8sClient(), profile.GrantSubject(), authv1alpha1.ReferenceGrantToSecret, target)
+		Expect(err).To(MatchError(ErrReferenceNotGranted))
+	})
+})
+
+// newReferenceGrant returns an AuthReferenceGrant in namespace allowing from to reference the given objects.
+func newReferenceGrant(
+	namespace string, from authv1alpha1.ReferenceGrantFrom, to ...authv1alpha1.ReferenceGrantTo,
+) *authv1alpha1.AuthReferenceGrant {
+	return &authv1alpha1.AuthReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: namespace},
+		Spec: authv1alpha1.AuthReferenceGrantSpec{
+			From: []authv1alpha1.ReferenceGrantFrom{from},
+			To:   to,
+		},
+	}
+}
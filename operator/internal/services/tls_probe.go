@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"time"
+)
+
+const tlsProbeTimeout = 5 * time.Second
+
+type (
+	// TLSProberInterface reports the certificate an endpoint serves
+	TLSProberInterface interface {
+		ServedCertificate(ctx context.Context, addr string) (*x509.Certificate, error)
+	}
+
+	// TLSProber performs a TLS handshake to read the certificate an endpoint serves
+	TLSProber struct {
+		timeout time.Duration
+	}
+)
+
+func NewTLSProber() *TLSProber {
+	return &TLSProber{timeout: tlsProbeTimeout}
+}
+
+// ServedCertificate returns the leaf certificate presented by the server at addr. The certificate is
+// read but not verified, and is returned even if the handshake fails afterwards, e.g. because the
+// server requires a client certificate.
+func (p *TLSProber) ServedCertificate(ctx context.Context, addr string) (*x509.Certificate, error) {
+	var served *x509.Certificate
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: p.timeout},
+		Config: &tls.Config{
+			InsecureSkipVerify: true, //nolint:gosec // only the served certificate is read
+			VerifyConnection: func(state tls.ConnectionState) error {
+				if len(state.PeerCertificates) > 0 {
+					served = state.PeerCertificates[0]
+				}
+				return nil
+			},
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if conn != nil {
+		conn.Close()
+	}
+	if served != nil {
+		return served, nil
+	}
+	if err == nil {
+		err = errors.New("no certificate presented")
+	}
+	return nil, err
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TLSProber", func() {
+	It("should return the certificate served by the endpoint", func(ctx context.Context) {
+		server := httptest.NewTLSServer(http.NotFoundHandler())
+		defer server.Close()
+
+		served, err := NewTLSProber().ServedCertificate(ctx, server.Listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(served.Equal(server.Certificate())).To(BeTrue())
+	})
+
+	It("should return the certificate when the server requires a client certificate", func(ctx context.Context) {
+		server := httptest.NewUnstartedServer(http.NotFoundHandler())
+		server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+		server.StartTLS()
+		defer server.Close()
+
+		served, err := NewTLSProber().ServedCertificate(ctx, server.Listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(served.Equal(server.Certificate())).To(BeTrue())
+	})
+
+	It("should fail when nothing serves TLS", func(ctx context.Context) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		defer server.Close()
+
+		_, err := NewTLSProber().ServedCertificate(ctx, server.Listener.Addr().String())
+		Expect(err).To(HaveOccurred())
+	})
+})
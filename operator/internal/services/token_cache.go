@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// TokenCacheTokenKey is the Secret key holding the cached admin token
+	TokenCacheTokenKey = "token"
+	// TokenCacheExpiresAtKey is the Secret key holding the token expiration in RFC 3339 format, if any
+	TokenCacheExpiresAtKey = "expiresAt"
+	// TokenCacheAuthHashAnnotation records a hash of the spec.auth the cached token was issued for
+	TokenCacheAuthHashAnnotation = "auth.aistore.nvidia.com/spec-hash"
+
+	tokenCacheComponent = "operator-token"
+)
+
+// adminTokenCache persists admin tokens in an operator-owned Secret per AIStore, so operator restarts and
+// leader changes reuse valid tokens instead of logging in to the auth service again for every cluster.
+type adminTokenCache struct {
+	k8sClient *aisclient.K8sClient
+}
+
+func newAdminTokenCache(k8sClient *aisclient.K8sClient) *adminTokenCache {
+	return &adminTokenCache{k8sClient: k8sClient}
+}
+
+// get returns the cached token for the cluster, or nil if there is none, it was issued for a different
+// spec.auth, or it expires within TokenExpiryBuffer.
+func (c *adminTokenCache) get(ctx context.Context, ais *aisv1.AIStore) (*TokenInfo, error) {
+	if ais.Spec.Auth == nil {
+		return nil, nil
+	}
+	secret, err := c.k8sClient.GetSecret(ctx, tokenCacheSecretNSName(ais))
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	authHash, err := hashAuthSpec(ais.Spec.Auth)
+	if err != nil {
+		return nil, err
+	}
+	if secret.Annotations[TokenCacheAuthHashAnnotation] != authHash || len(secret.Data[TokenCacheTokenKey]) == 0 {
+		return nil, nil
+	}
+	tokenInfo := &TokenInfo{Token: string(secret.Data[TokenCacheTokenKey])}
+	if expiresAt := secret.Data[TokenCacheExpiresAtKey]; len(expiresAt) != 0 {
+		tokenInfo.ExpiresAt, err = time.Parse(time.RFC3339, string(expiresAt))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s in Secret %s: %w", TokenCacheExpiresAtKey, secret.Name, err)
+		}
+		if time.Now().Add(TokenExpiryBuffer).After(tokenInfo.ExpiresAt) {
+			return nil, nil
+		}
+	}
+	return tokenInfo, nil
+}
+
+// store writes the token to the cluster's cache Secret, owned by the AIStore so it is removed along with it
+func (c *adminTokenCache) store(ctx context.Context, ais *aisv1.AIStore, tokenInfo *TokenInfo) error {
+	authHash, err := hashAuthSpec(ais.Spec.Auth)
+	if err != nil {
+		return err
+	}
+	data := map[string][]byte{TokenCacheTokenKey: []byte(tokenInfo.Token)}
+	if !tokenInfo.ExpiresAt.IsZero() {
+		data[TokenCacheExpiresAtKey] = []byte(tokenInfo.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ais.AdminTokenSecretName(),
+			Namespace:   ais.Namespace,
+			Labels:      cmn.SelectorLabels(ais.Name, tokenCacheComponent),
+			Annotations: map[string]string{TokenCacheAuthHashAnnotation: authHash},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+	_, err = c.k8sClient.CreateOrUpdateResource(ctx, ais, secret)
+	return err
+}
+
+// invalidate deletes the cluster's cache Secret, e.g. after AIS rejected the cached token
+func (c *adminTokenCache) invalidate(ctx context.Context, ais *aisv1.AIStore) error {
+	_, err := aisclient.DeleteResourceIfExists[*corev1.Secret](c.k8sClient, ctx, tokenCacheSecretNSName(ais))
+	return err
+}
+
+// remove deletes the cluster's cache Secret only if the informer cache has it, so clusters without spec.auth
+// do not send a delete request to the API server on every reconcile
+func (c *adminTokenCache) remove(ctx context.Context, ais *aisv1.AIStore) error {
+	_, err := c.k8sClient.GetSecret(ctx, tokenCacheSecretNSName(ais))
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return c.invalidate(ctx, ais)
+}
+
+func tokenCacheSecretNSName(ais *aisv1.AIStore) types.NamespacedName {
+	return types.NamespacedName{Namespace: ais.Namespace, Name: ais.AdminTokenSecretName()}
+}
+
+func hashAuthSpec(auth *aisv1.AuthSpec) (string, error) {
+	data, err := json.Marshal(auth)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
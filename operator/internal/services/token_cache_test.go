@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// countingAuthN returns a new token on every call and counts the logins
+type countingAuthN struct {
+	logins    int
+	expiresIn time.Duration
+}
+
+func (a *countingAuthN) getAdminToken(context.Context, *aisv1.AIStore) (*TokenInfo, error) {
+	a.logins++
+	return &TokenInfo{Token: fmt.Sprintf("token-%d", a.logins), ExpiresAt: time.Now().Add(a.expiresIn).Truncate(time.Second)}, nil
+}
+
+var _ = Describe("Admin token cache", func() {
+	var (
+		ais       *aisv1.AIStore
+		k8sClient *aisclient.K8sClient
+		authN     *countingAuthN
+	)
+
+	newManager := func() *AISClientManager {
+		m := NewAISClientManager(k8sClient, AISClientTLSOpts{}, true)
+		m.authN = authN
+		return m
+	}
+
+	BeforeEach(func() {
+		ais = &aisv1.AIStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "ais", Namespace: "ais-ns", UID: "ais-uid"},
+			Spec: aisv1.AIStoreSpec{
+				Auth: &aisv1.AuthSpec{ProfileRef: &aisv1.AuthProfileRef{Name: "profile"}},
+			},
+		}
+		k8sClient = newFakeK8sClient()
+		authN = &countingAuthN{expiresIn: time.Hour}
+	})
+
+	It("should reuse a cached token across client managers", func(ctx context.Context) {
+		first, err := newManager().getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret, err := k8sClient.GetSecret(ctx, tokenCacheSecretNSName(ais))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.OwnerReferences).To(HaveLen(1))
+		Expect(secret.Data).To(HaveKeyWithValue(TokenCacheTokenKey, []byte(first.Token)))
+
+		second, err := newManager().getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second.Token).To(Equal(first.Token))
+		Expect(second.ExpiresAt).To(BeTemporally("==", first.ExpiresAt))
+		Expect(authN.logins).To(Equal(1))
+	})
+
+	It("should not reuse a token expiring within the expiry buffer", func(ctx context.Context) {
+		authN.expiresIn = TokenExpiryBuffer / 2
+		_, err := newManager().getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = newManager().getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authN.logins).To(Equal(2))
+	})
+
+	It("should not reuse a token issued for a different auth spec", func(ctx context.Context) {
+		_, err := newManager().getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		ais.Spec.Auth.ProfileRef.Name = "other-profile"
+		_, err = newManager().getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authN.logins).To(Equal(2))
+	})
+
+	It("should drop the cached token after the cluster rejects it", func(ctx context.Context) {
+		m := newManager()
+		first, err := m.getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		m.clientMap[ais.NamespacedName().String()] = &AIStoreClient{
+			ctx:        ctx,
+			params:     buildBaseParams("http://ais-proxy.ais-ns:51080", first.Token, nil),
+			authFailed: true,
+		}
+
+		_, err = m.GetClient(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authN.logins).To(Equal(2))
+		secret, err := k8sClient.GetSecret(ctx, tokenCacheSecretNSName(ais))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Data[TokenCacheTokenKey]).NotTo(Equal([]byte(first.Token)))
+	})
+
+	It("should remove the cached token when auth is disabled", func(ctx context.Context) {
+		_, err := newManager().getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		ais.Spec.Auth = nil
+		tokenInfo, err := newManager().getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokenInfo).To(BeNil())
+		_, err = k8sClient.GetSecret(ctx, tokenCacheSecretNSName(ais))
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not delete the cache Secret of clusters without auth on every call", func(ctx context.Context) {
+		var deletes int
+		k8sClient = newFakeK8sClientWithInterceptors(&interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				deletes++
+				return c.Delete(ctx, obj, opts...)
+			},
+		})
+		ais.Spec.Auth = nil
+		m := newManager()
+		for range 3 {
+			tokenInfo, err := m.getAdminToken(ctx, ais)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokenInfo).To(BeNil())
+		}
+		Expect(deletes).To(BeZero())
+	})
+
+	It("should not cache tokens when disabled", func(ctx context.Context) {
+		m := NewAISClientManager(k8sClient, AISClientTLSOpts{}, false)
+		m.authN = authN
+		_, err := m.getAdminToken(ctx, ais)
+		Expect(err).NotTo(HaveOccurred())
+		err = k8sClient.Get(ctx, tokenCacheSecretNSName(ais), &corev1.Secret{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
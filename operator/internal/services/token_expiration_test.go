@@ -255,6 +255,22 @@ func TestAuthFailedInvalidatesClient(t *testing.T) {
 		}
 	})
 
+	t.Run("trust bundle change invalidates client", func(t *testing.T) {
+		client := &AIStoreClient{
+			ctx:             ctx,
+			params:          buildBaseParams(testURL, "", nil),
+			mode:            "",
+			trustBundleHash: "old-hash",
+		}
+
+		trusted := ais.DeepCopy()
+		trusted.Status.TrustBundle = &aisv1.TrustBundleStatus{Hash: "new-hash"}
+
+		if client.HasValidBaseParams(ctx, trusted, testURL) {
+			t.Error("Expected client to be invalid after the trust bundle changed")
+		}
+	})
+
 	t.Run("403 Forbidden invalidates client", func(t *testing.T) {
 		client := &AIStoreClient{
 			ctx:    ctx,
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package services
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	aisv1 "github.com/ais-operator/api/aistore/v1beta1"
+	aisclient "github.com/ais-operator/internal/client"
+	"github.com/ais-operator/internal/resources/aistore/cmn"
+	"github.com/ais-operator/internal/truststore"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// trustBundleConfig returns the trust store config for the CA bundle the operator merges from the
+// cluster's spec.trust, read from the trust bundle ConfigMap
+func trustBundleConfig(ctx context.Context, k8sClient *aisclient.K8sClient, ais *aisv1.AIStore) (truststore.Config, error) {
+	nn := types.NamespacedName{Namespace: ais.Namespace, Name: ais.TrustBundleConfigMapName()}
+	configMap, err := k8sClient.GetConfigMap(ctx, nn)
+	if err != nil {
+		return truststore.Config{}, fmt.Errorf("failed to get trust bundle ConfigMap %s: %w", nn, err)
+	}
+	bundle, ok := configMap.Data[cmn.TrustBundleKey]
+	if !ok {
+		return truststore.Config{}, fmt.Errorf("trust bundle ConfigMap %s has no key %q", nn, cmn.TrustBundleKey)
+	}
+	return truststore.Config{
+		CAPEMs:           [][]byte{[]byte(bundle)},
+		ExcludeSystemCAs: !ais.TrustUsesSystemCAs(),
+	}, nil
+}
+
+// newTrustBundlePool returns the pool of CAs trusted for the cluster with spec.trust
+func newTrustBundlePool(ctx context.Context, k8sClient *aisclient.K8sClient, ais *aisv1.AIStore) (*x509.CertPool, error) {
+	trust, err := trustBundleConfig(ctx, k8sClient, ais)
+	if err != nil {
+		return nil, err
+	}
+	return truststore.NewCertPool(logf.FromContext(ctx).WithName("truststore"), trust)
+}
@@ -23,11 +23,15 @@ type Config struct {
 	// CAPEMs is a list of PEM-encoded CA certificates held in memory, for trust that is read
 	// from the Kubernetes API rather than mounted into the operator.
 	CAPEMs [][]byte
+
+	// ExcludeSystemCAs starts from an empty pool instead of the system CA certificates, so only the
+	// configured certificates are trusted.
+	ExcludeSystemCAs bool
 }
 
 // NewCertPool creates a new x509.CertPool with system CAs and custom CA certificates
 // It loads certificates in the following order:
-// 1. System CA certificates (as the base)
+// 1. System CA certificates (as the base), unless excluded
 // 2. Custom CA certificates from configured paths
 //
 // Missing or invalid certificate files from local paths are logged as warnings but don't cause errors.
@@ -36,8 +40,9 @@ type Config struct {
 func NewCertPool(logger logr.Logger, config Config) (*x509.CertPool, error) {
 	// Load system CA certificates as the base
 	var certPool *x509.CertPool
-	systemCAs, err := x509.SystemCertPool()
-	if err != nil {
+	if config.ExcludeSystemCAs {
+		certPool = x509.NewCertPool()
+	} else if systemCAs, err := x509.SystemCertPool(); err != nil {
 		logger.V(1).Info("Unable to load system CA certificates, using empty pool", "error", err)
 		certPool = x509.NewCertPool()
 	} else {
@@ -104,6 +104,21 @@ func TestNewCertPool(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:       "exclude system CA certificates",
+			setupCerts: func(_ *testing.T) []string { return nil },
+			config: Config{
+				CAPEMs:           [][]byte{createTestCACertPEM(t, "test-ca-1")},
+				ExcludeSystemCAs: true,
+			},
+			expectError: false,
+			validate: func(t *testing.T, pool *x509.CertPool) {
+				//nolint:staticcheck // Subjects is fine for pools built from PEMs
+				if subjects := pool.Subjects(); len(subjects) != 1 {
+					t.Errorf("expected only the configured CA, got %d subjects", len(subjects))
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
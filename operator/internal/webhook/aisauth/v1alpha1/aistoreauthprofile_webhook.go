@@ -30,6 +30,7 @@ type AIStoreAuthProfileWebhook struct {
 
 // +kubebuilder:webhook:path=/validate-auth-ais-nvidia-com-v1alpha1-aistoreauthprofile,mutating=false,failurePolicy=fail,sideEffects=None,groups=auth.ais.nvidia.com,resources=aistoreauthprofiles,verbs=create;update,versions=v1alpha1,name=vaistoreauthprofile.kb.io,admissionReviewVersions={v1,v1beta1}
 // +kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=get
+// +kubebuilder:rbac:groups=auth.ais.nvidia.com,resources=authreferencegrants,verbs=get;list;watch
 // +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 
 var _ admission.Validator[*authv1.AIStoreAuthProfile] = &AIStoreAuthProfileWebhook{}
@@ -63,14 +64,14 @@ func (w *AIStoreAuthProfileWebhook) validate(
 
 	var allErrs field.ErrorList
 	if profile.Spec.UsernamePassword != nil {
-		fieldErrs, err := w.validateSecretRef(ctx, &profile.Spec.UsernamePassword.Secret, warnings)
+		fieldErrs, err := w.validateSecretRef(ctx, profile, &profile.Spec.UsernamePassword.Secret, warnings)
 		if err != nil {
 			return err
 		}
 		allErrs = append(allErrs, fieldErrs...)
 	}
 	if profile.Spec.TLS != nil && profile.Spec.TLS.CAConfigMapRef != nil {
-		fieldErrs, err := w.validateCAConfigMap(ctx, profile.Spec.TLS.CAConfigMapRef, warnings)
+		fieldErrs, err := w.validateCAConfigMap(ctx, profile, profile.Spec.TLS.CAConfigMapRef, warnings)
 		if err != nil {
 			return err
 		}
@@ -86,9 +87,11 @@ func (w *AIStoreAuthProfileWebhook) validate(
 	)
 }
 
-// validateSecretRef validates access to the credentials secret, then checks existence and internal fields
+// validateSecretRef validates access and the reference grant for the credentials secret, then checks
+// existence and internal fields
 func (w *AIStoreAuthProfileWebhook) validateSecretRef(
 	ctx context.Context,
+	profile *authv1.AIStoreAuthProfile,
 	secretSpec *authv1.AuthProfileSecret,
 	warnings *admission.Warnings,
 ) (field.ErrorList, error) {
@@ -106,6 +109,14 @@ func (w *AIStoreAuthProfileWebhook) validateSecretRef(
 	if fieldErr != nil {
 		return field.ErrorList{fieldErr}, nil
 	}
+	fieldErr, err = webhookcmn.AuthorizeReference(ctx, w.Client, path, profile.GrantSubject(),
+		authv1.ReferenceGrantToSecret, secretRef)
+	if err != nil {
+		return nil, err
+	}
+	if fieldErr != nil {
+		return field.ErrorList{fieldErr}, nil
+	}
 
 	secret := &corev1.Secret{}
 	if getErr := w.APIReader.Get(ctx, secretRef, secret); getErr != nil {
@@ -164,6 +175,7 @@ func validateSecretFields(secretSpec *authv1.AuthProfileSecret, secret *corev1.S
 
 func (w *AIStoreAuthProfileWebhook) validateCAConfigMap(
 	ctx context.Context,
+	profile *authv1.AIStoreAuthProfile,
 	cmRef *authv1.AuthProfileCAConfigMapRef,
 	warnings *admission.Warnings,
 ) (field.ErrorList, error) {
@@ -181,6 +193,14 @@ func (w *AIStoreAuthProfileWebhook) validateCAConfigMap(
 	if fieldErr != nil {
 		return field.ErrorList{fieldErr}, nil
 	}
+	fieldErr, err = webhookcmn.AuthorizeReference(ctx, w.Client, path, profile.GrantSubject(),
+		authv1.ReferenceGrantToConfigMap, configMapRef)
+	if err != nil {
+		return nil, err
+	}
+	if fieldErr != nil {
+		return field.ErrorList{fieldErr}, nil
+	}
 
 	configMap := &corev1.ConfigMap{}
 	if getErr := w.APIReader.Get(ctx, configMapRef, configMap); getErr != nil {
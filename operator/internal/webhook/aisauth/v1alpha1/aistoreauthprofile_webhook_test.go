@@ -156,6 +156,7 @@ func newFakeWebhook(t *testing.T, allowed bool, reader client.Reader, objects ..
 	scheme := runtime.NewScheme()
 	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
 	g.Expect(authorizationv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(authv1.AddToScheme(scheme)).To(Succeed())
 	fakeClient := fake.NewClientBuilder().
 		WithScheme(scheme).
 		WithObjects(objects...).
@@ -194,6 +195,8 @@ type validationCase struct {
 	unauthorized bool
 	// operatorUnauthorized makes content GETs return Forbidden so contents are skipped with a warning.
 	operatorUnauthorized bool
+	// ungranted omits the AuthReferenceGrant that otherwise allows the profile's references.
+	ungranted bool
 	// ctx overrides the default context carrying the admission request
 	ctx      context.Context
 	previous *authv1.AIStoreAuthProfile
@@ -217,7 +220,11 @@ func runValidationCases(t *testing.T, cases []validationCase) {
 			if tc.operatorUnauthorized {
 				reader = forbiddenReader{}
 			}
-			webhook := newFakeWebhook(t, !tc.unauthorized, reader, tc.objects...)
+			objects := tc.objects
+			if !tc.ungranted {
+				objects = append(objects, profileReferenceGrant())
+			}
+			webhook := newFakeWebhook(t, !tc.unauthorized, reader, objects...)
 
 			var (
 				warnings admission.Warnings
@@ -270,6 +277,20 @@ func caConfigMapProfile(configMapName, key string) *authv1.AIStoreAuthProfile {
 	}
 }
 
+// profileReferenceGrant allows every profile to reference Secrets and ConfigMaps in profileNamespace.
+func profileReferenceGrant() *authv1.AuthReferenceGrant {
+	return &authv1.AuthReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "profiles", Namespace: profileNamespace},
+		Spec: authv1.AuthReferenceGrantSpec{
+			From: []authv1.ReferenceGrantFrom{{Kind: authv1.ReferenceGrantFromAIStoreAuthProfile}},
+			To: []authv1.ReferenceGrantTo{
+				{Kind: authv1.ReferenceGrantToSecret},
+				{Kind: authv1.ReferenceGrantToConfigMap},
+			},
+		},
+	}
+}
+
 func credentialsSecret(data map[string][]byte) *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: profileNamespace},
@@ -306,6 +327,15 @@ func TestAIStoreAuthProfileWebhookSecretReferences(t *testing.T) {
 			objects: []client.Object{populatedSecret},
 			profile: usernamePasswordProfile("credentials"),
 		},
+		{
+			name:      "rejects Secret reference without a grant",
+			objects:   []client.Object{populatedSecret},
+			ungranted: true,
+			profile:   usernamePasswordProfile("credentials"),
+			wantErr: ContainSubstring(
+				`no AuthReferenceGrant in namespace "ais-authn" allows AIStoreAuthProfile profile to reference Secret "credentials"`,
+			),
+		},
 		{
 			name:    "rejects missing Secret",
 			profile: usernamePasswordProfile("missing"),
@@ -383,6 +413,13 @@ func TestAIStoreAuthProfileWebhookCAConfigMapReferences(t *testing.T) {
 			objects: []client.Object{caConfigMap(map[string]string{"ca.crt": "certificate"})},
 			profile: caConfigMapProfile("ca", "ca.crt"),
 		},
+		{
+			name:      "rejects ConfigMap reference without a grant",
+			objects:   []client.Object{caConfigMap(map[string]string{"ca.crt": "certificate"})},
+			ungranted: true,
+			profile:   caConfigMapProfile("ca", "ca.crt"),
+			wantErr:   ContainSubstring(`allows AIStoreAuthProfile profile to reference ConfigMap "ca"`),
+		},
 		{
 			name:    "rejects missing ConfigMap",
 			profile: caConfigMapProfile("missing-ca", "ca.crt"),
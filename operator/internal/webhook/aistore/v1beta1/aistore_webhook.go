@@ -8,6 +8,7 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	aisapc "github.com/NVIDIA/aistore/api/apc"
@@ -41,6 +42,7 @@ type AIStoreWebhook struct {
 // change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 // +kubebuilder:webhook:path=/validate-ais-nvidia-com-v1beta1-aistore,mutating=false,failurePolicy=fail,sideEffects=None,groups=ais.nvidia.com,resources=aistores,verbs=create;update,versions=v1beta1,name=vaistore.kb.io,admissionReviewVersions={v1,v1beta1}
 // +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+// +kubebuilder:rbac:groups=auth.ais.nvidia.com,resources=authreferencegrants,verbs=get;list;watch
 
 var _ admission.Validator[*aisv1.AIStore] = &AIStoreWebhook{}
 
@@ -68,11 +70,16 @@ func (aisw *AIStoreWebhook) ValidateUpdate(ctx context.Context, prev, ais *aisv1
 	if err != nil {
 		return warnings, err
 	}
+	if err = validateTargetPoolsUpdate(prev, ais); err != nil {
+		return warnings, err
+	}
 
 	if ais.Spec.EnableExternalLB != prev.Spec.EnableExternalLB { //nolint:staticcheck // deprecated EnableExternalLB field
 		return warnings, errCannotUpdateSpec("enableExternalLB")
 	}
-	if storageErr := validateStateStorageUpdate(prev, ais); storageErr != nil {
+	storageWarnings, storageErr := validateStateStorageUpdate(prev, ais)
+	warnings = append(warnings, storageWarnings...)
+	if storageErr != nil {
 		return warnings, storageErr
 	}
 	return warnings, nil
@@ -121,7 +128,8 @@ func authSecretNamespace(ais *aisv1.AIStore, up *aisv1.UsernamePasswordAuth) str
 }
 
 // validateAuthSecret checks user access to spec.auth.usernamePassword:
-// requires "get" on the referenced credentials Secret, checked on every create and update when changed
+// requires "get" on the referenced credentials Secret, and an AuthReferenceGrant when the Secret is in
+// another namespace, checked on every create and update when changed
 func (aisw *AIStoreWebhook) validateAuthSecret(ctx context.Context, prev, ais *aisv1.AIStore) error {
 	if ais.Spec.Auth == nil || ais.Spec.Auth.UsernamePassword == nil { //nolint:staticcheck // deprecated UsernamePassword field
 		return nil
@@ -135,12 +143,28 @@ func (aisw *AIStoreWebhook) validateAuthSecret(ctx context.Context, prev, ais *a
 			return nil
 		}
 	}
-	return aisw.authorize(ctx, ais, "get", field.NewPath("spec", "auth", "usernamePassword"),
+	path := field.NewPath("spec", "auth", "usernamePassword")
+	err := aisw.authorize(ctx, ais, "get", path,
 		&authorizationv1.ResourceAttributes{
 			Resource:  "secrets",
 			Namespace: authSecretNamespace(ais, up),
 			Name:      up.SecretName,
 		})
+	if err != nil {
+		return err
+	}
+	fieldErr, err := webhookcmn.AuthorizeReference(ctx, aisw.Client, path.Child("secretNamespace"),
+		authv1alpha1.ReferenceGrantFrom{Kind: authv1alpha1.ReferenceGrantFromAIStore, Namespace: ais.Namespace, Name: ais.Name},
+		authv1alpha1.ReferenceGrantToSecret,
+		client.ObjectKey{Namespace: authSecretNamespace(ais, up), Name: up.SecretName})
+	if err != nil || fieldErr == nil {
+		return err
+	}
+	return apierrors.NewInvalid(
+		aisv1.GroupVersion.WithKind("AIStore").GroupKind(),
+		ais.Name,
+		field.ErrorList{fieldErr},
+	)
 }
 
 // validateAuthProfile checks user access to spec.auth.profileRef:
@@ -253,6 +277,26 @@ func validateTargetUpdate(prev, ais *aisv1.AIStore) error {
 	return nil
 }
 
+// validateTargetPoolsUpdate allows the same updates to target pools as to targetSpec, and the removal of pools
+// scaled to 0, so their targets are decommissioned before their StatefulSet is deleted.
+func validateTargetPoolsUpdate(prev, ais *aisv1.AIStore) error {
+	for i := range prev.Spec.TargetPools {
+		prevPool := &prev.Spec.TargetPools[i]
+		idx := slices.IndexFunc(ais.Spec.TargetPools, func(pool aisv1.TargetPoolSpec) bool { return pool.Name == prevPool.Name })
+		if idx < 0 {
+			if prevPool.Size > 0 {
+				return fmt.Errorf("target pool %q must be scaled to 0 before it is removed", prevPool.Name)
+			}
+			continue
+		}
+		pool := &ais.Spec.TargetPools[idx]
+		if !equality.Semantic.DeepEqual(pool.Mounts, prevPool.Mounts) || !equality.Semantic.DeepEqual(pool.NodeSelector, prevPool.NodeSelector) {
+			return errCannotUpdateSpec("targetPools[" + pool.Name + "]")
+		}
+	}
+	return nil
+}
+
 func (aisw *AIStoreWebhook) verifyNodesAvailable(ctx context.Context, ais *aisv1.AIStore, daeType string) (admission.Warnings, error) {
 	var (
 		requiredSize int
@@ -309,13 +353,17 @@ func (aisw *AIStoreWebhook) verifyRequiredStorageClasses(ctx context.Context, ai
 	return nil
 }
 
-func validateStateStorageUpdate(prev, ais *aisv1.AIStore) error {
-	// We can't change volumeClaimTemplates in the statefulset, and therefore can't migrate to a state storage PVC
-	// or change the storage class of an existing PVC. However, we can migrate to and from other storage methods.
-	if !equality.Semantic.DeepEqual(ais.Spec.StateStoragePVCStorageClass(), prev.Spec.StateStoragePVCStorageClass()) && ais.Spec.StateStoragePVCStorageClass() != nil {
-		return errCannotUpdateSpec("stateStorage.pvc.storageClass")
+// validateStateStorageUpdate allows moving to another state storage, as the operator migrates the state of each pod.
+// State PVCs keep their names across the migration, so their storage class cannot be changed.
+func validateStateStorageUpdate(prev, ais *aisv1.AIStore) (admission.Warnings, error) {
+	prevClass, class := prev.Spec.StateStoragePVCStorageClass(), ais.Spec.StateStoragePVCStorageClass()
+	if prevClass != nil && class != nil && *prevClass != *class {
+		return nil, errCannotUpdateSpec("stateStorage.pvc.storageClass")
 	}
-	return nil
+	if prev.Spec.UsesStateEmptyDir() != ais.Spec.UsesStateEmptyDir() {
+		return admission.Warnings{"state is not copied to or from emptyDir state storage, so migrated pods restart with empty state"}, nil
+	}
+	return nil, nil
 }
 
 // SetupAIStoreWebhookWithManager registers the AIStore validating webhook with the manager.
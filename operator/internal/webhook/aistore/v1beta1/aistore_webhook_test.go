@@ -86,6 +86,31 @@ func TestValidateTargetUpdateToScaleDownMode(t *testing.T) {
 	g.Expect(validateTargetUpdate(prev, ais)).To(Succeed())
 }
 
+func TestValidateTargetPoolsUpdate(t *testing.T) {
+	g := NewWithT(t)
+	prev := &aisv1.AIStore{}
+	prev.Spec.TargetPools = []aisv1.TargetPoolSpec{
+		{Name: "nvme", Size: 2, Mounts: []aisv1.Mount{{Path: "/ais/nvme"}}},
+		{Name: "hdd"},
+	}
+
+	// Pools can be resized, added, and removed once scaled to 0
+	ais := prev.DeepCopy()
+	ais.Spec.TargetPools = []aisv1.TargetPoolSpec{
+		{Name: "nvme", Size: 4, Mounts: []aisv1.Mount{{Path: "/ais/nvme"}}, Tolerations: []corev1.Toleration{{Key: "disk"}}},
+		{Name: "ssd", Size: 1},
+	}
+	g.Expect(validateTargetPoolsUpdate(prev, ais)).To(Succeed())
+
+	ais = prev.DeepCopy()
+	ais.Spec.TargetPools[0].Mounts = []aisv1.Mount{{Path: "/ais/nvme1"}}
+	g.Expect(validateTargetPoolsUpdate(prev, ais)).To(MatchError(ContainSubstring(`cannot update spec "targetPools[nvme]"`)))
+
+	ais = prev.DeepCopy()
+	ais.Spec.TargetPools = ais.Spec.TargetPools[1:]
+	g.Expect(validateTargetPoolsUpdate(prev, ais)).To(MatchError(ContainSubstring("must be scaled to 0 before it is removed")))
+}
+
 func sarInterceptor(allowed bool, reviews *[]*authorizationv1.SubjectAccessReview) interceptor.Funcs {
 	return interceptor.Funcs{
 		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
@@ -173,6 +198,7 @@ func TestValidateAuthSecret(t *testing.T) {
 
 	for _, tt := range []struct {
 		name       string
+		objs       []client.Object
 		prev       *aisv1.AIStore
 		ais        *aisv1.AIStore
 		wantReview *authorizationv1.ResourceAttributes
@@ -223,6 +249,7 @@ func TestValidateAuthSecret(t *testing.T) {
 		},
 		{
 			name: "changed secret namespace on update",
+			objs: []client.Object{secretGrant("other", "cluster")},
 			prev: secretAIS("creds", aisapc.Ptr(tenantNS)),
 			ais:  secretAIS("creds", aisapc.Ptr("other")),
 			wantReview: &authorizationv1.ResourceAttributes{
@@ -232,7 +259,7 @@ func TestValidateAuthSecret(t *testing.T) {
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
-			webhook, reviews := newSARWebhook(t, true)
+			webhook, reviews := newSARWebhook(t, true, tt.objs...)
 			g.Expect(webhook.validateAuthSecret(ctx, tt.prev, tt.ais)).To(Succeed())
 			if tt.wantReview == nil {
 				g.Expect(*reviews).To(BeEmpty())
@@ -244,6 +271,65 @@ func TestValidateAuthSecret(t *testing.T) {
 	}
 }
 
+func TestValidateAuthSecretReferenceGrant(t *testing.T) {
+	ctx := admissionCtx()
+
+	for _, tt := range []struct {
+		name    string
+		objs    []client.Object
+		wantErr string
+	}{
+		{
+			name:    "no grant",
+			wantErr: `no AuthReferenceGrant in namespace "other" allows AIStore tenant/cluster to reference Secret "creds"`,
+		},
+		{
+			name:    "grant for another cluster",
+			objs:    []client.Object{secretGrant("other", "other-cluster")},
+			wantErr: "no AuthReferenceGrant",
+		},
+		{
+			name:    "grant in another namespace",
+			objs:    []client.Object{secretGrant(tenantNS, "cluster")},
+			wantErr: "no AuthReferenceGrant",
+		},
+		{
+			name: "grant for the cluster",
+			objs: []client.Object{secretGrant("other", "cluster")},
+		},
+		{
+			name: "grant for every cluster in the namespace",
+			objs: []client.Object{secretGrant("other", "")},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			webhook, _ := newSARWebhook(t, true, tt.objs...)
+			err := webhook.validateAuthSecret(ctx, nil, secretAIS("creds", aisapc.Ptr("other")))
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			g.Expect(err.Error()).To(ContainSubstring(tt.wantErr))
+		})
+	}
+}
+
+// secretGrant returns an AuthReferenceGrant in namespace allowing AIStore clusterName in tenantNS,
+// or every AIStore there when clusterName is empty, to reference the Secret "creds".
+func secretGrant(namespace, clusterName string) *authv1alpha1.AuthReferenceGrant {
+	return &authv1alpha1.AuthReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-creds", Namespace: namespace},
+		Spec: authv1alpha1.AuthReferenceGrantSpec{
+			From: []authv1alpha1.ReferenceGrantFrom{{
+				Kind: authv1alpha1.ReferenceGrantFromAIStore, Namespace: tenantNS, Name: clusterName,
+			}},
+			To: []authv1alpha1.ReferenceGrantTo{{Kind: authv1alpha1.ReferenceGrantToSecret, Name: "creds"}},
+		},
+	}
+}
+
 func TestValidateAuthProfile(t *testing.T) {
 	ctx := admissionCtx()
 
@@ -414,3 +500,58 @@ func TestValidateAuthAccess(t *testing.T) {
 		g.Expect((*reviews)[0].Spec.ResourceAttributes.Resource).To(Equal("aistoreauthprofiles"))
 	})
 }
+
+func TestValidateStateStorageUpdate(t *testing.T) {
+	stateAIS := func(storage *aisv1.StateStorage) *aisv1.AIStore {
+		return &aisv1.AIStore{Spec: aisv1.AIStoreSpec{StateStorage: storage}}
+	}
+	hostPath := func(prefix string) *aisv1.StateStorage {
+		return &aisv1.StateStorage{HostPath: &aisv1.StateHostPathConfig{Prefix: prefix}}
+	}
+	pvc := func(storageClass string) *aisv1.StateStorage {
+		return &aisv1.StateStorage{PVC: &aisv1.StatePVCConfig{StorageClass: storageClass}}
+	}
+	emptyDir := &aisv1.StateStorage{EmptyDir: &aisv1.StateEmptyDirConfig{}}
+
+	for _, tt := range []struct {
+		name        string
+		prev        *aisv1.AIStore
+		ais         *aisv1.AIStore
+		wantErr     bool
+		wantWarning bool
+	}{
+		{name: "unchanged", prev: stateAIS(pvc("local-path")), ais: stateAIS(pvc("local-path"))},
+		{name: "hostPath to PVC", prev: stateAIS(hostPath("/etc/ais")), ais: stateAIS(pvc("local-path"))},
+		{name: "PVC to hostPath", prev: stateAIS(pvc("local-path")), ais: stateAIS(hostPath("/etc/ais"))},
+		{name: "hostPath prefix", prev: stateAIS(hostPath("/etc/ais")), ais: stateAIS(hostPath("/var/ais"))},
+		{
+			name: "deprecated hostpathPrefix to PVC",
+			prev: &aisv1.AIStore{Spec: aisv1.AIStoreSpec{HostpathPrefix: aisapc.Ptr("/etc/ais")}},
+			ais:  stateAIS(pvc("local-path")),
+		},
+		{name: "PVC storage class", prev: stateAIS(pvc("local-path")), ais: stateAIS(pvc("ssd")), wantErr: true},
+		{
+			name:    "deprecated stateStorageClass to another storage class",
+			prev:    &aisv1.AIStore{Spec: aisv1.AIStoreSpec{StateStorageClass: aisapc.Ptr("local-path")}},
+			ais:     stateAIS(pvc("ssd")),
+			wantErr: true,
+		},
+		{name: "hostPath to emptyDir", prev: stateAIS(hostPath("/etc/ais")), ais: stateAIS(emptyDir), wantWarning: true},
+		{name: "emptyDir to PVC", prev: stateAIS(emptyDir), ais: stateAIS(pvc("local-path")), wantWarning: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			warnings, err := validateStateStorageUpdate(tt.prev, tt.ais)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tt.wantWarning {
+				g.Expect(warnings).To(HaveLen(1))
+			} else {
+				g.Expect(warnings).To(BeEmpty())
+			}
+		})
+	}
+}
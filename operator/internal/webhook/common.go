@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 
+	authv1alpha1 "github.com/ais-operator/api/aisauth/v1alpha1"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -77,6 +78,36 @@ func Authorize(
 	return nil, nil
 }
 
+// AuthorizeReference verifies that an AuthReferenceGrant in the target's namespace allows from to
+// reference the target object. References within from's own namespace need no grant.
+func AuthorizeReference(
+	ctx context.Context,
+	reader client.Reader,
+	path *field.Path,
+	from authv1alpha1.ReferenceGrantFrom,
+	toKind authv1alpha1.ReferenceGrantToKind,
+	target client.ObjectKey,
+) (*field.Error, error) {
+	if !from.NeedsGrant(target.Namespace) {
+		return nil, nil
+	}
+	grants := &authv1alpha1.AuthReferenceGrantList{}
+	if err := reader.List(ctx, grants, client.InNamespace(target.Namespace)); err != nil {
+		return nil, apierrors.NewInternalError(
+			fmt.Errorf("listing AuthReferenceGrants in namespace %q: %w", target.Namespace, err),
+		)
+	}
+	if grants.Allows(from, toKind, target.Name) {
+		return nil, nil
+	}
+	logf.FromContext(ctx).V(1).Info("Denied reference without a grant", "from", from.String(),
+		"kind", toKind, "name", target.Name, "namespace", target.Namespace)
+	return field.Forbidden(path, fmt.Sprintf(
+		"no AuthReferenceGrant in namespace %q allows %s to reference %s %q",
+		target.Namespace, from, toKind, target.Name,
+	)), nil
+}
+
 // describeResource names the reviewed object, omitting the namespace for cluster-scoped resources.
 func describeResource(attrs *authorizationv1.ResourceAttributes) string {
 	if attrs.Namespace == "" {
@@ -291,7 +291,7 @@ func (cc *clientCluster) initClientAccess(ctx context.Context) {
 
 func (cc *clientCluster) getProxyURL(ctx context.Context) (proxyURL string) {
 	var ip string
-	if cc.cluster.ProxyExternalAccessEnabled() {
+	if cc.cluster.ProxyLoadBalancerEnabled() {
 		ip = tutils.GetLoadBalancerIP(ctx, cc.k8sClient, proxy.LoadBalancerSVCNSName(cc.cluster))
 	} else {
 		ip = tutils.GetRandomProxyIP(ctx, cc.k8sClient, cc.cluster)
@@ -302,7 +302,7 @@ func (cc *clientCluster) getProxyURL(ctx context.Context) (proxyURL string) {
 
 func (cc *clientCluster) getAllProxyURLs(ctx context.Context) (proxyURLs []*string) {
 	var proxyIPs []string
-	if cc.cluster.ProxyExternalAccessEnabled() {
+	if cc.cluster.ProxyLoadBalancerEnabled() {
 		proxyIPs = []string{tutils.GetLoadBalancerIP(ctx, cc.k8sClient, proxy.LoadBalancerSVCNSName(cc.cluster))}
 	} else {
 		proxyIPs = tutils.GetAllProxyIPs(ctx, cc.k8sClient, cc.cluster)
@@ -145,6 +145,7 @@ var _ = SynchronizedBeforeSuite(
 		Expect(aiscontroller.NewReconcilerFromMgr(
 			mgr,
 			tlsOpts,
+			false,
 			ctrl.Log.WithName("controllers").WithName("AIStore"),
 		).SetupWithManager(mgr)).To(Succeed())
 